@@ -3,6 +3,7 @@ package main
 import (
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -10,6 +11,7 @@ import (
 	"github.com/your-org/project-budget-tracker/backend/internal/database"
 	"github.com/your-org/project-budget-tracker/backend/internal/handler"
 	custommiddleware "github.com/your-org/project-budget-tracker/backend/internal/middleware"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
 	"github.com/your-org/project-budget-tracker/backend/internal/repository"
 	"github.com/your-org/project-budget-tracker/backend/internal/service"
 )
@@ -18,6 +20,27 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	// Configure rounding of money amounts
+	minorUnits, err := money.ParseMinorUnits(cfg.MoneyMinorUnits)
+	if err != nil {
+		log.Fatalf("Invalid MONEY_MINOR_UNITS: %v", err)
+	}
+	if err := money.Configure(money.RoundingMode(cfg.MoneyRoundingMode), minorUnits); err != nil {
+		log.Fatalf("Invalid money rounding configuration: %v", err)
+	}
+
+	// Configure the limit on the hours members record per day
+	dailyHoursLimit, err := service.ParseDailyHoursLimit(cfg.TimeEntryMaxDailyHours, cfg.TimeEntryOverAllocation)
+	if err != nil {
+		log.Fatalf("Invalid daily hours configuration: %v", err)
+	}
+
+	// Configure how timers are recorded as time entries
+	timerSettings, err := service.ParseTimerSettings(cfg.TimerRoundingMinutes, cfg.TimerRoundingMode, cfg.TimerMaxDuration, cfg.TimerTimezone)
+	if err != nil {
+		log.Fatalf("Invalid timer configuration: %v", err)
+	}
+
 	// Connect to database
 	if err := database.Connect(cfg.DatabaseURL); err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
@@ -48,7 +71,24 @@ func main() {
 	projectService := service.NewProjectService(projectRepo)
 	taskService := service.NewTaskService(database.GetDB())
 	memberService := service.NewMemberService(database.GetDB())
-	budgetService := service.NewBudgetService(database.GetDB())
+	budgetService := service.NewBudgetService(database.GetDB(), dailyHoursLimit)
+	expenseService := service.NewExpenseService(database.GetDB(), dailyHoursLimit)
+	evmService := service.NewEVMService(database.GetDB())
+	exchangeRateService := service.NewExchangeRateService(database.GetDB())
+	alertService := service.NewAlertService(database.GetDB())
+	revenueItemService := service.NewRevenueItemService(database.GetDB(), dailyHoursLimit)
+	budgetLineService := service.NewBudgetLineService(database.GetDB())
+	invoiceService := service.NewInvoiceService(database.GetDB())
+	memberRateService := service.NewMemberRateService(database.GetDB(), dailyHoursLimit)
+	departmentRateService := service.NewDepartmentRateService(database.GetDB())
+	holidayService := service.NewHolidayService(database.GetDB())
+	departmentOverheadService := service.NewDepartmentOverheadService(database.GetDB())
+	portfolioService := service.NewPortfolioService(database.GetDB())
+	changeOrderService := service.NewChangeOrderService(database.GetDB(), dailyHoursLimit)
+	accountingPeriodService := service.NewAccountingPeriodService(database.GetDB())
+	timerService := service.NewTimerService(database.GetDB(), timerSettings, dailyHoursLimit)
+	timesheetService := service.NewTimesheetService(database.GetDB(), dailyHoursLimit)
+	timeEntryImportService := service.NewTimeEntryImportService(database.GetDB(), dailyHoursLimit)
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authService)
@@ -56,6 +96,23 @@ func main() {
 	taskHandler := handler.NewTaskHandler(taskService)
 	memberHandler := handler.NewMemberHandler(memberService)
 	budgetHandler := handler.NewBudgetHandler(budgetService)
+	expenseHandler := handler.NewExpenseHandler(expenseService)
+	evmHandler := handler.NewEVMHandler(evmService)
+	exchangeRateHandler := handler.NewExchangeRateHandler(exchangeRateService)
+	alertHandler := handler.NewAlertHandler(alertService)
+	revenueItemHandler := handler.NewRevenueItemHandler(revenueItemService)
+	budgetLineHandler := handler.NewBudgetLineHandler(budgetLineService)
+	invoiceHandler := handler.NewInvoiceHandler(invoiceService)
+	memberRateHandler := handler.NewMemberRateHandler(memberRateService)
+	departmentRateHandler := handler.NewDepartmentRateHandler(departmentRateService)
+	holidayHandler := handler.NewHolidayHandler(holidayService)
+	departmentOverheadHandler := handler.NewDepartmentOverheadHandler(departmentOverheadService)
+	portfolioHandler := handler.NewPortfolioHandler(portfolioService)
+	changeOrderHandler := handler.NewChangeOrderHandler(changeOrderService)
+	accountingPeriodHandler := handler.NewAccountingPeriodHandler(accountingPeriodService)
+	timerHandler := handler.NewTimerHandler(timerService)
+	timesheetHandler := handler.NewTimesheetHandler(timesheetService)
+	timeEntryImportHandler := handler.NewTimeEntryImportHandler(timeEntryImportService)
 
 	// API v1 routes
 	v1 := e.Group("/api/v1")
@@ -76,6 +133,9 @@ func main() {
 	protected.PUT("/projects/:id", projectHandler.UpdateProject)
 	protected.DELETE("/projects/:id", projectHandler.DeleteProject)
 
+	// Portfolio routes
+	protected.GET("/portfolio/summary", portfolioHandler.GetPortfolioSummary)
+
 	// Task routes
 	protected.POST("/projects/:projectId/tasks", taskHandler.CreateTask)
 	protected.GET("/projects/:projectId/tasks", taskHandler.ListTasks)
@@ -91,6 +151,30 @@ func main() {
 	protected.PUT("/members/:id", memberHandler.UpdateMember)
 	protected.DELETE("/members/:id", memberHandler.DeleteMember)
 
+	// Member rate routes
+	protected.GET("/members/:id/rates", memberRateHandler.ListMemberRates)
+	protected.POST("/members/:id/rates", memberRateHandler.CreateMemberRate)
+
+	// Department rate routes
+	protected.POST("/department-rates", departmentRateHandler.CreateDepartmentRate)
+	protected.GET("/department-rates", departmentRateHandler.ListDepartmentRates)
+	protected.PUT("/department-rates/:id", departmentRateHandler.UpdateDepartmentRate)
+	protected.DELETE("/department-rates/:id", departmentRateHandler.DeleteDepartmentRate)
+
+	// Department overhead routes
+	protected.POST("/department-overheads", departmentOverheadHandler.CreateDepartmentOverhead)
+	protected.GET("/department-overheads", departmentOverheadHandler.ListDepartmentOverheads)
+	protected.PUT("/department-overheads/:id", departmentOverheadHandler.UpdateDepartmentOverhead)
+	protected.DELETE("/department-overheads/:id", departmentOverheadHandler.DeleteDepartmentOverhead)
+
+	// Holiday calendar routes
+	protected.POST("/holidays", holidayHandler.CreateHoliday)
+	protected.GET("/holidays", holidayHandler.ListHolidays)
+	protected.DELETE("/holidays/:id", holidayHandler.DeleteHoliday)
+
+	// Accounting period routes (closing and reopening are for admins)
+	protected.GET("/accounting-periods", accountingPeriodHandler.ListAccountingPeriods)
+
 	// Project member routes
 	protected.GET("/projects/:id/members", memberHandler.GetProjectMembers)
 	protected.POST("/projects/:id/members", memberHandler.AssignMemberToProject)
@@ -98,18 +182,131 @@ func main() {
 
 	// Budget routes
 	protected.GET("/projects/:id/budget", budgetHandler.GetBudget)
+	protected.GET("/projects/:id/budget/comparison", budgetHandler.GetBudgetComparison)
+	protected.GET("/projects/:id/budget/history", budgetHandler.GetBudgetHistory)
+	protected.GET("/projects/:id/budget/forecast", budgetHandler.GetCostForecast)
 	protected.PUT("/projects/:id/budget/revenue", budgetHandler.UpdateRevenue)
+	protected.PUT("/projects/:id/budget/hours-basis", budgetHandler.UpdateHoursBasis)
+
+	// Revenue item routes
+	protected.POST("/projects/:id/revenue-items", revenueItemHandler.CreateRevenueItem)
+	protected.GET("/projects/:id/revenue-items", revenueItemHandler.ListRevenueItems)
+	protected.GET("/projects/:id/revenue-items/:itemId", revenueItemHandler.GetRevenueItem)
+	protected.PUT("/projects/:id/revenue-items/:itemId", revenueItemHandler.UpdateRevenueItem)
+	protected.DELETE("/projects/:id/revenue-items/:itemId", revenueItemHandler.DeleteRevenueItem)
+
+	// Budget line routes
+	protected.POST("/projects/:id/budget-lines", budgetLineHandler.CreateBudgetLine)
+	protected.GET("/projects/:id/budget-lines", budgetLineHandler.ListBudgetLines)
+	protected.GET("/projects/:id/budget-lines/:lineId", budgetLineHandler.GetBudgetLine)
+	protected.PUT("/projects/:id/budget-lines/:lineId", budgetLineHandler.UpdateBudgetLine)
+	protected.DELETE("/projects/:id/budget-lines/:lineId", budgetLineHandler.DeleteBudgetLine)
+
+	// Change order routes (approval is for admins)
+	protected.POST("/projects/:id/change-orders", changeOrderHandler.CreateChangeOrder)
+	protected.GET("/projects/:id/change-orders", changeOrderHandler.ListChangeOrders)
+	protected.GET("/projects/:id/change-orders/:orderId", changeOrderHandler.GetChangeOrder)
+	protected.POST("/projects/:id/change-orders/:orderId/approve", changeOrderHandler.ApproveChangeOrder, custommiddleware.RequireRole("admin"))
+	protected.POST("/projects/:id/change-orders/:orderId/reject", changeOrderHandler.RejectChangeOrder, custommiddleware.RequireRole("admin"))
+
+	// EVM routes
+	protected.GET("/projects/:id/evm", evmHandler.GetEVM)
+
+	// Expense routes
+	protected.POST("/projects/:id/expenses", expenseHandler.CreateExpense)
+	protected.GET("/projects/:id/expenses", expenseHandler.ListExpenses)
+	protected.GET("/projects/:id/expenses/:expenseId", expenseHandler.GetExpense)
+	protected.PUT("/projects/:id/expenses/:expenseId", expenseHandler.UpdateExpense)
+	protected.DELETE("/projects/:id/expenses/:expenseId", expenseHandler.DeleteExpense)
+
+	// Invoice routes
+	protected.POST("/projects/:id/invoices", invoiceHandler.CreateInvoice)
+	protected.GET("/projects/:id/invoices", invoiceHandler.ListInvoices)
+	protected.GET("/projects/:id/invoices/:invoiceId", invoiceHandler.GetInvoice)
+	protected.PUT("/projects/:id/invoices/:invoiceId/issue", invoiceHandler.IssueInvoice)
+	protected.PUT("/projects/:id/invoices/:invoiceId/pay", invoiceHandler.PayInvoice)
+	protected.DELETE("/projects/:id/invoices/:invoiceId", invoiceHandler.DeleteInvoice)
+
+	// Exchange rate routes
+	protected.POST("/exchange-rates", exchangeRateHandler.CreateExchangeRate)
+	protected.POST("/exchange-rates/import", exchangeRateHandler.ImportExchangeRates)
+	protected.GET("/exchange-rates", exchangeRateHandler.ListExchangeRates)
+	protected.GET("/exchange-rates/:id", exchangeRateHandler.GetExchangeRate)
+	protected.PUT("/exchange-rates/:id", exchangeRateHandler.UpdateExchangeRate)
+	protected.DELETE("/exchange-rates/:id", exchangeRateHandler.DeleteExchangeRate)
+
+	// Alert routes
+	protected.POST("/projects/:id/alert-rules", alertHandler.CreateAlertRule)
+	protected.GET("/projects/:id/alert-rules", alertHandler.ListAlertRules)
+	protected.PUT("/projects/:id/alert-rules/:ruleId", alertHandler.UpdateAlertRule)
+	protected.DELETE("/projects/:id/alert-rules/:ruleId", alertHandler.DeleteAlertRule)
+	protected.GET("/projects/:id/alerts", alertHandler.ListAlerts)
+	protected.PUT("/projects/:id/alerts/:alertId/acknowledge", alertHandler.AcknowledgeAlert)
+	protected.PUT("/projects/:id/alerts/:alertId/resolve", alertHandler.ResolveAlert)
 
 	// Time entry routes
 	protected.POST("/time-entries", budgetHandler.CreateTimeEntry)
+	protected.POST("/time-entries/import", timeEntryImportHandler.ImportTimeEntries)
 	protected.GET("/time-entries", budgetHandler.ListTimeEntries)
 	protected.GET("/time-entries/:id", budgetHandler.GetTimeEntry)
 	protected.PUT("/time-entries/:id", budgetHandler.UpdateTimeEntry)
 	protected.DELETE("/time-entries/:id", budgetHandler.DeleteTimeEntry)
 
+	// Timer routes
+	protected.GET("/timers", timerHandler.ListRunningTimers)
+	protected.POST("/timers/start", timerHandler.StartTimer)
+	protected.POST("/timers/stop", timerHandler.StopTimer)
+
+	// Timesheet routes
+	protected.GET("/timesheets", timesheetHandler.ListTimesheets)
+	protected.GET("/members/:id/timesheets/:week", timesheetHandler.GetTimesheet)
+	protected.POST("/members/:id/timesheets/:week/submit", timesheetHandler.SubmitTimesheet)
+	protected.POST("/members/:id/timesheets/:week/approve", timesheetHandler.ApproveTimesheet, custommiddleware.RequireRole("admin"))
+	protected.POST("/members/:id/timesheets/:week/reject", timesheetHandler.RejectTimesheet, custommiddleware.RequireRole("admin"))
+
+	// Admin routes
+	admin := protected.Group("/admin", custommiddleware.RequireRole("admin"))
+	admin.POST("/time-entries/recompute-rates", memberRateHandler.RecomputeRateSnapshots)
+	admin.POST("/accounting-periods/:month/close", accountingPeriodHandler.CloseAccountingPeriod)
+	admin.POST("/accounting-periods/:month/reopen", accountingPeriodHandler.ReopenAccountingPeriod)
+
+	// Record budget snapshots of all projects once a day
+	go runDailyBudgetSnapshots(budgetService)
+	go runTimerAutoStop(timerService)
+
 	// Start server
 	log.Printf("Starting server on %s", cfg.ServerAddress)
 	if err := e.Start(cfg.ServerAddress); err != nil && err != http.ErrServerClosed {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// runDailyBudgetSnapshots captures budget snapshots at startup and then just after every midnight (UTC)
+func runDailyBudgetSnapshots(budgetService *service.BudgetService) {
+	for {
+		captured, err := budgetService.CaptureDailySnapshots()
+		if err != nil {
+			log.Printf("Failed to capture some budget snapshots: %v", err)
+		}
+		log.Printf("Captured %d budget snapshots", captured)
+
+		now := time.Now().UTC()
+		nextDay := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+		time.Sleep(time.Until(nextDay))
+	}
+}
+
+// runTimerAutoStop stops the timers that have run past the maximum duration every few minutes
+func runTimerAutoStop(timerService *service.TimerService) {
+	for {
+		stopped, err := timerService.AutoStopExpiredTimers(time.Now())
+		if err != nil {
+			log.Printf("Failed to stop some expired timers: %v", err)
+		}
+		if stopped > 0 {
+			log.Printf("Stopped %d expired timers", stopped)
+		}
+
+		time.Sleep(5 * time.Minute)
+	}
+}
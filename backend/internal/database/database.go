@@ -40,6 +40,25 @@ func AutoMigrate() error {
 		&models.TimeEntry{},
 		&models.ProjectMember{},
 		&models.Budget{},
+		&models.Expense{},
+		&models.ExchangeRate{},
+		&models.BudgetSnapshot{},
+		&models.AlertRule{},
+		&models.Alert{},
+		&models.RevenueItem{},
+		&models.Invoice{},
+		&models.InvoiceLine{},
+		&models.InvoiceSequence{},
+		&models.MemberRate{},
+		&models.DepartmentRate{},
+		&models.Holiday{},
+		&models.DepartmentOverhead{},
+		&models.BudgetLine{},
+		&models.BudgetLineLink{},
+		&models.ChangeOrder{},
+		&models.AccountingPeriod{},
+		&models.Timer{},
+		&models.Timesheet{},
 	)
 	
 	if err != nil {
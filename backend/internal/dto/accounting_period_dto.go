@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountingPeriodResponse represents a monthly accounting period response.
+// Period is the month as YYYY-MM.
+type AccountingPeriodResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Period      string     `json:"period"`
+	PeriodStart string     `json:"period_start"`
+	PeriodEnd   string     `json:"period_end"`
+	Status      string     `json:"status"`
+	ClosedBy    *uuid.UUID `json:"closed_by,omitempty"`
+	ClosedAt    *time.Time `json:"closed_at,omitempty"`
+	ReopenedBy  *uuid.UUID `json:"reopened_by,omitempty"`
+	ReopenedAt  *time.Time `json:"reopened_at,omitempty"`
+}
+
+// AccountingPeriodListResponse represents the accounting periods that have been closed at least once
+type AccountingPeriodListResponse struct {
+	Periods []AccountingPeriodResponse `json:"periods"`
+}
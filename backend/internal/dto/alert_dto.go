@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateAlertRuleRequest represents a request to create a budget alert rule.
+// The threshold is a percentage for cost_ratio and profit_rate, and an amount
+// in the budget currency for weekly_burn_rate.
+type CreateAlertRuleRequest struct {
+	Metric    string          `json:"metric" validate:"required,oneof=cost_ratio profit_rate weekly_burn_rate"`
+	Threshold decimal.Decimal `json:"threshold"`
+	IsEnabled *bool           `json:"is_enabled,omitempty"`
+}
+
+// UpdateAlertRuleRequest represents a request to update a budget alert rule
+type UpdateAlertRuleRequest struct {
+	Metric    *string          `json:"metric,omitempty" validate:"omitempty,oneof=cost_ratio profit_rate weekly_burn_rate"`
+	Threshold *decimal.Decimal `json:"threshold,omitempty"`
+	IsEnabled *bool            `json:"is_enabled,omitempty"`
+}
+
+// AlertRuleResponse represents a budget alert rule response
+type AlertRuleResponse struct {
+	ID        uuid.UUID       `json:"id"`
+	ProjectID uuid.UUID       `json:"project_id"`
+	Metric    string          `json:"metric"`
+	Threshold decimal.Decimal `json:"threshold"`
+	IsEnabled bool            `json:"is_enabled"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// AlertResponse represents a triggered budget alert response
+type AlertResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	ProjectID      uuid.UUID       `json:"project_id"`
+	RuleID         *uuid.UUID      `json:"rule_id,omitempty"`
+	Metric         string          `json:"metric"`
+	Threshold      decimal.Decimal `json:"threshold"`
+	Value          decimal.Decimal `json:"value"`
+	Currency       string          `json:"currency"`
+	Message        string          `json:"message"`
+	Status         string          `json:"status"`
+	TriggeredAt    time.Time       `json:"triggered_at"`
+	AcknowledgedAt *time.Time      `json:"acknowledged_at,omitempty"`
+	ResolvedAt     *time.Time      `json:"resolved_at,omitempty"`
+}
+
+// AlertListResponse represents a paginated list of alerts
+type AlertListResponse struct {
+	Alerts     []AlertResponse `json:"alerts"`
+	Pagination Pagination      `json:"pagination"`
+}
@@ -2,68 +2,255 @@ package dto
 
 import (
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // UpdateRevenueRequest represents a request to update project revenue
 type UpdateRevenueRequest struct {
-	Revenue  float64 `json:"revenue" validate:"min=0"`
-	Currency *string `json:"currency,omitempty" validate:"omitempty,len=3"`
+	Revenue  decimal.Decimal `json:"revenue" validate:"min=0"`
+	Currency *string         `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
 }
 
-// BudgetResponse represents a budget response
+// UpdateHoursBasisRequest represents a request to choose the hours counted in the budget summary:
+// all time entries, or only those of approved timesheets
+type UpdateHoursBasisRequest struct {
+	HoursBasis string `json:"hours_basis" validate:"required,oneof=all approved"`
+}
+
+// BudgetResponse represents a budget response. The revenue is derived according to the contract
+// type: the agreed amount of a fixed-price contract, the billable hours at bill rates of a
+// time-and-materials contract or the monthly fees and overage of a retainer.
 type BudgetResponse struct {
-	ID         uuid.UUID `json:"id"`
-	ProjectID  uuid.UUID `json:"project_id"`
-	Revenue    float64   `json:"revenue"`
-	TotalCost  float64   `json:"total_cost"`
-	Profit     float64   `json:"profit"`
-	ProfitRate float64   `json:"profit_rate"`
-	Currency   string    `json:"currency"`
-	IsDeficit  bool      `json:"is_deficit"`
+	ID                uuid.UUID              `json:"id"`
+	ProjectID         uuid.UUID              `json:"project_id"`
+	Revenue           decimal.Decimal        `json:"revenue"`
+	RecognizedRevenue decimal.Decimal        `json:"recognized_revenue"`
+	PlannedRevenue    decimal.Decimal        `json:"planned_revenue"`
+	TotalCost         decimal.Decimal        `json:"total_cost"`
+	Profit            decimal.Decimal        `json:"profit"`
+	ProfitRate        decimal.Decimal        `json:"profit_rate"`
+	Currency          string                 `json:"currency"`
+	IsDeficit         bool                   `json:"is_deficit"`
+	ContractType      string                 `json:"contract_type"`
+	HoursBasis        string                 `json:"hours_basis"`
+	Retainer          *RetainerUsageResponse `json:"retainer,omitempty"`
+}
+
+// RetainerUsageResponse represents the hour bank of a retainer contract. The fee of every elapsed
+// month is recognized, together with the billable hours beyond the bank valued at their bill rates.
+// The fee of the months remaining until the end date is planned revenue.
+type RetainerUsageResponse struct {
+	MonthlyFee     decimal.Decimal         `json:"monthly_fee"`
+	MonthlyHours   decimal.Decimal         `json:"monthly_hours"`
+	RolloverMonths int                     `json:"rollover_months"`
+	ContractMonths int                     `json:"contract_months"`
+	ElapsedMonths  int                     `json:"elapsed_months"`
+	FeeRevenue     decimal.Decimal         `json:"fee_revenue"`
+	UsedHours      decimal.Decimal         `json:"used_hours"`
+	OverageHours   decimal.Decimal         `json:"overage_hours"`
+	OverageRevenue decimal.Decimal         `json:"overage_revenue"`
+	AvailableHours decimal.Decimal         `json:"available_hours"`
+	Months         []RetainerMonthResponse `json:"months"`
+}
+
+// RetainerMonthResponse represents the use of the hour bank in one month. The available hours
+// include the unused hours rolled over from earlier months.
+type RetainerMonthResponse struct {
+	Month          string          `json:"month"`
+	AvailableHours decimal.Decimal `json:"available_hours"`
+	UsedHours      decimal.Decimal `json:"used_hours"`
+	OverageHours   decimal.Decimal `json:"overage_hours"`
+	OverageRevenue decimal.Decimal `json:"overage_revenue"`
+	UnusedHours    decimal.Decimal `json:"unused_hours"`
 }
 
 // BudgetSummaryResponse represents a comprehensive budget summary
 type BudgetSummaryResponse struct {
-	ProjectID      uuid.UUID               `json:"project_id"`
-	ProjectName    string                  `json:"project_name"`
-	Budget         BudgetResponse          `json:"budget"`
-	CostBreakdown  CostBreakdownResponse   `json:"cost_breakdown"`
-	MemberCosts    []MemberCostResponse    `json:"member_costs"`
-	TaskCosts      []TaskCostResponse      `json:"task_costs,omitempty"`
-	WarningMessage *string                 `json:"warning_message,omitempty"`
+	ProjectID      uuid.UUID                  `json:"project_id"`
+	ProjectName    string                     `json:"project_name"`
+	Budget         BudgetResponse             `json:"budget"`
+	CostBreakdown  CostBreakdownResponse      `json:"cost_breakdown"`
+	LoadedCost     LoadedCostResponse         `json:"loaded_cost"`
+	Billing        BillingSummaryResponse     `json:"billing"`
+	BudgetLines    BudgetLinesSummaryResponse `json:"budget_lines"`
+	MemberCosts    []MemberCostResponse       `json:"member_costs"`
+	TaskCosts      []TaskCostResponse         `json:"task_costs,omitempty"`
+	From           *string                    `json:"from,omitempty"`
+	To             *string                    `json:"to,omitempty"`
+	WarningMessage *string                    `json:"warning_message,omitempty"`
 }
 
 // CostBreakdownResponse represents cost breakdown by category
 type CostBreakdownResponse struct {
-	LaborCost     float64 `json:"labor_cost"`
-	TotalHours    float64 `json:"total_hours"`
-	AverageRate   float64 `json:"average_rate"`
+	LaborCost    decimal.Decimal       `json:"labor_cost"`
+	ExpenseCost  decimal.Decimal       `json:"expense_cost"`
+	TotalCost    decimal.Decimal       `json:"total_cost"`
+	TotalHours   decimal.Decimal       `json:"total_hours"`
+	AverageRate  decimal.Decimal       `json:"average_rate"`
+	ExpenseCosts []ExpenseCostResponse `json:"expense_costs"`
+}
+
+// LoadedCostResponse compares the direct cost with the fully loaded cost, in which the labor cost
+// of each member is multiplied by the overhead multiplier of their department. Expenses are not loaded.
+// The costs follow the period of the summary, while the profits set the revenue of the whole
+// project against its whole cost.
+type LoadedCostResponse struct {
+	DirectCost       decimal.Decimal `json:"direct_cost"`
+	LoadedLaborCost  decimal.Decimal `json:"loaded_labor_cost"`
+	OverheadCost     decimal.Decimal `json:"overhead_cost"`
+	LoadedCost       decimal.Decimal `json:"loaded_cost"`
+	DirectProfit     decimal.Decimal `json:"direct_profit"`
+	DirectProfitRate decimal.Decimal `json:"direct_profit_rate"`
+	LoadedProfit     decimal.Decimal `json:"loaded_profit"`
+	LoadedProfitRate decimal.Decimal `json:"loaded_profit_rate"`
+}
+
+// BillingSummaryResponse represents billable and non-billable time. The realization
+// rate is the billable value as a percentage of all recorded hours valued at bill rates.
+type BillingSummaryResponse struct {
+	BillableHours    decimal.Decimal `json:"billable_hours"`
+	NonBillableHours decimal.Decimal `json:"non_billable_hours"`
+	BillableValue    decimal.Decimal `json:"billable_value"`
+	NonBillableValue decimal.Decimal `json:"non_billable_value"`
+	RealizationRate  float64         `json:"realization_rate"`
+}
+
+// ExpenseCostResponse represents expense cost breakdown by category
+type ExpenseCostResponse struct {
+	Category   string          `json:"category"`
+	Count      int             `json:"count"`
+	Amount     decimal.Decimal `json:"amount"`
+	Percentage float64         `json:"percentage"`
 }
 
 // MemberCostResponse represents cost breakdown by member
 type MemberCostResponse struct {
-	MemberID   uuid.UUID `json:"member_id"`
-	MemberName string    `json:"member_name"`
-	Hours      float64   `json:"hours"`
-	HourlyRate float64   `json:"hourly_rate"`
-	Cost       float64   `json:"cost"`
-	Percentage float64   `json:"percentage"`
+	MemberID   uuid.UUID       `json:"member_id"`
+	MemberName string          `json:"member_name"`
+	Hours      decimal.Decimal `json:"hours"`
+	HourlyRate decimal.Decimal `json:"hourly_rate"`
+	Cost       decimal.Decimal `json:"cost"`
+	LoadedCost decimal.Decimal `json:"loaded_cost"`
+	Percentage float64         `json:"percentage"`
 }
 
 // TaskCostResponse represents cost breakdown by task
 type TaskCostResponse struct {
-	TaskID   uuid.UUID `json:"task_id"`
-	TaskName string    `json:"task_name"`
-	Hours    float64   `json:"hours"`
-	Cost     float64   `json:"cost"`
+	TaskID        uuid.UUID       `json:"task_id"`
+	TaskName      string          `json:"task_name"`
+	Hours         decimal.Decimal `json:"hours"`
+	Cost          decimal.Decimal `json:"cost"`
+	PlannedHours  decimal.Decimal `json:"planned_hours"`
+	VarianceHours decimal.Decimal `json:"variance_hours"`
+	PlannedCost   decimal.Decimal `json:"planned_cost"`
+	CostVariance  decimal.Decimal `json:"cost_variance"`
+	Percentage    float64         `json:"percentage"`
 }
 
 // BudgetComparisonResponse represents budget comparison between planned and actual
 type BudgetComparisonResponse struct {
-	ProjectID     uuid.UUID `json:"project_id"`
-	PlannedBudget float64   `json:"planned_budget"`
-	ActualCost    float64   `json:"actual_cost"`
-	Variance      float64   `json:"variance"`
-	VarianceRate  float64   `json:"variance_rate"`
-	IsOverBudget  bool      `json:"is_over_budget"`
+	ProjectID     uuid.UUID                `json:"project_id"`
+	AsOf          string                   `json:"as_of"`
+	Currency      string                   `json:"currency"`
+	PlannedBudget decimal.Decimal          `json:"planned_budget"`
+	ActualCost    decimal.Decimal          `json:"actual_cost"`
+	LaborCost     decimal.Decimal          `json:"labor_cost"`
+	ExpenseCost   decimal.Decimal          `json:"expense_cost"`
+	Variance      decimal.Decimal          `json:"variance"`
+	VarianceRate  float64                  `json:"variance_rate"`
+	IsOverBudget  bool                     `json:"is_over_budget"`
+	BurnRate      BurnRateResponse         `json:"burn_rate"`
+	Projection    BudgetProjectionResponse `json:"projection"`
+}
+
+// BurnRateResponse represents the labor cost burn rate over a trailing window
+type BurnRateResponse struct {
+	WindowStart string          `json:"window_start"`
+	WindowDays  int             `json:"window_days"`
+	WindowCost  decimal.Decimal `json:"window_cost"`
+	Daily       decimal.Decimal `json:"daily"`
+	Weekly      decimal.Decimal `json:"weekly"`
+}
+
+// BudgetProjectionResponse represents the projection of the budget based on the current burn rate
+type BudgetProjectionResponse struct {
+	EndDate               *string          `json:"end_date,omitempty"`
+	DaysUntilEndDate      *int             `json:"days_until_end_date,omitempty"`
+	ProjectedCostAtEnd    *decimal.Decimal `json:"projected_cost_at_end,omitempty"`
+	ExhaustionDate        *string          `json:"exhaustion_date,omitempty"`
+	ExhaustsBeforeEndDate bool             `json:"exhausts_before_end_date"`
+}
+
+// CostForecastResponse represents the labor cost projected from the allocation of
+// the assigned members until the project end date, combined with the actual cost
+type CostForecastResponse struct {
+	ProjectID           uuid.UUID                `json:"project_id"`
+	AsOf                string                   `json:"as_of"`
+	EndDate             string                   `json:"end_date"`
+	Currency            string                   `json:"currency"`
+	HoursPerDay         decimal.Decimal          `json:"hours_per_day"`
+	RemainingWorkDays   int                      `json:"remaining_work_days"`
+	ActualCost          decimal.Decimal          `json:"actual_cost"`
+	LaborCost           decimal.Decimal          `json:"labor_cost"`
+	ExpenseCost         decimal.Decimal          `json:"expense_cost"`
+	ForecastLaborCost   decimal.Decimal          `json:"forecast_labor_cost"`
+	EstimatedTotalCost  decimal.Decimal          `json:"estimated_total_cost"`
+	Revenue             decimal.Decimal          `json:"revenue"`
+	EstimatedProfit     decimal.Decimal          `json:"estimated_profit"`
+	EstimatedProfitRate decimal.Decimal          `json:"estimated_profit_rate"`
+	PlannedBudget       *decimal.Decimal         `json:"planned_budget,omitempty"`
+	EstimatedVariance   *decimal.Decimal         `json:"estimated_variance,omitempty"`
+	Members             []MemberForecastResponse `json:"members"`
+}
+
+// MemberForecastResponse represents the projected labor cost of one assigned member
+type MemberForecastResponse struct {
+	MemberID       uuid.UUID       `json:"member_id"`
+	MemberName     string          `json:"member_name"`
+	AllocationRate float64         `json:"allocation_rate"`
+	From           string          `json:"from"`
+	To             string          `json:"to"`
+	WorkDays       int             `json:"work_days"`
+	Hours          decimal.Decimal `json:"hours"`
+	HourlyRate     decimal.Decimal `json:"hourly_rate"`
+	RateCurrency   string          `json:"rate_currency"`
+	RateSource     string          `json:"rate_source"`
+	Cost           decimal.Decimal `json:"cost"`
+}
+
+// BudgetHistoryResponse represents the trend of a project budget over time
+type BudgetHistoryResponse struct {
+	ProjectID   uuid.UUID                    `json:"project_id"`
+	Granularity string                       `json:"granularity"`
+	Currency    string                       `json:"currency"`
+	Points      []BudgetHistoryPointResponse `json:"points"`
+	Baseline    BudgetBaselineResponse       `json:"baseline"`
+}
+
+// BudgetBaselineResponse compares the budget before any approved change order with the current one.
+// CostImpact is the total cost impact of the approved change orders in the budget currency.
+type BudgetBaselineResponse struct {
+	Baseline         BudgetFiguresResponse `json:"baseline"`
+	Current          BudgetFiguresResponse `json:"current"`
+	ChangeOrderCount int                   `json:"change_order_count"`
+	CostImpact       decimal.Decimal       `json:"cost_impact"`
+}
+
+// BudgetFiguresResponse represents the figures of a project that change orders revise
+type BudgetFiguresResponse struct {
+	BudgetAmount *decimal.Decimal `json:"budget_amount,omitempty"`
+	Revenue      decimal.Decimal  `json:"revenue"`
+	EndDate      *string          `json:"end_date,omitempty"`
+}
+
+// BudgetHistoryPointResponse represents the budget at the close of a day, week or month
+type BudgetHistoryPointResponse struct {
+	PeriodStart  string          `json:"period_start"`
+	SnapshotDate string          `json:"snapshot_date"`
+	Revenue      decimal.Decimal `json:"revenue"`
+	TotalCost    decimal.Decimal `json:"total_cost"`
+	Profit       decimal.Decimal `json:"profit"`
+	ProfitRate   decimal.Decimal `json:"profit_rate"`
+	Currency     string          `json:"currency"`
+	IsDeficit    bool            `json:"is_deficit"`
 }
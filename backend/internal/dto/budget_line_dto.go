@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateBudgetLineRequest represents a request to create a budget line item of a project.
+// The planned amount is in the budget currency.
+type CreateBudgetLineRequest struct {
+	Name              string          `json:"name" validate:"required,min=1,max=200"`
+	Category          string          `json:"category" validate:"required,min=1,max=100"`
+	PlannedAmount     decimal.Decimal `json:"planned_amount" validate:"min=0"`
+	TaskIDs           []uuid.UUID     `json:"task_ids,omitempty"`
+	ExpenseCategories []string        `json:"expense_categories,omitempty" validate:"omitempty,dive,oneof=subcontractor license travel hardware other"`
+}
+
+// UpdateBudgetLineRequest represents a request to update a budget line item.
+// Task IDs and expense categories, when given, replace the current links.
+type UpdateBudgetLineRequest struct {
+	Name              *string          `json:"name,omitempty" validate:"omitempty,min=1,max=200"`
+	Category          *string          `json:"category,omitempty" validate:"omitempty,min=1,max=100"`
+	PlannedAmount     *decimal.Decimal `json:"planned_amount,omitempty" validate:"omitempty,min=0"`
+	TaskIDs           *[]uuid.UUID     `json:"task_ids,omitempty"`
+	ExpenseCategories *[]string        `json:"expense_categories,omitempty" validate:"omitempty,dive,oneof=subcontractor license travel hardware other"`
+}
+
+// BudgetLineResponse represents a budget line item response
+type BudgetLineResponse struct {
+	ID                uuid.UUID       `json:"id"`
+	ProjectID         uuid.UUID       `json:"project_id"`
+	Name              string          `json:"name"`
+	Category          string          `json:"category"`
+	PlannedAmount     decimal.Decimal `json:"planned_amount"`
+	TaskIDs           []uuid.UUID     `json:"task_ids"`
+	ExpenseCategories []string        `json:"expense_categories"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+}
+
+// BudgetLineListResponse represents the budget line items of a project
+type BudgetLineListResponse struct {
+	Lines []BudgetLineResponse `json:"lines"`
+}
+
+// BudgetLinesSummaryResponse compares the planned amount of each budget line with the actual cost
+// rolled up from its tasks and expense categories over the whole project. Costs not linked to any
+// line are reported as unallocated.
+type BudgetLinesSummaryResponse struct {
+	Lines           []BudgetLineVarianceResponse `json:"lines"`
+	PlannedAmount   decimal.Decimal              `json:"planned_amount"`
+	ActualCost      decimal.Decimal              `json:"actual_cost"`
+	Variance        decimal.Decimal              `json:"variance"`
+	UnallocatedCost decimal.Decimal              `json:"unallocated_cost"`
+}
+
+// BudgetLineVarianceResponse represents the planned amount and actual cost of a budget line.
+// The variance is the planned amount minus the actual cost, negative when over budget.
+type BudgetLineVarianceResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	Name          string          `json:"name"`
+	Category      string          `json:"category"`
+	PlannedAmount decimal.Decimal `json:"planned_amount"`
+	LaborCost     decimal.Decimal `json:"labor_cost"`
+	ExpenseCost   decimal.Decimal `json:"expense_cost"`
+	ActualCost    decimal.Decimal `json:"actual_cost"`
+	Variance      decimal.Decimal `json:"variance"`
+	VarianceRate  float64         `json:"variance_rate"`
+	IsOverBudget  bool            `json:"is_over_budget"`
+}
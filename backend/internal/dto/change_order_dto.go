@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateChangeOrderRequest represents a request to change the budget amount, revenue or end date
+// of a project. At least one of them is required; revenue and cost impact are in the budget currency.
+type CreateChangeOrderRequest struct {
+	Title        string           `json:"title" validate:"required,min=1,max=200"`
+	Reason       string           `json:"reason" validate:"required,min=1"`
+	BudgetAmount *decimal.Decimal `json:"budget_amount,omitempty" validate:"omitempty,min=0"`
+	Revenue      *decimal.Decimal `json:"revenue,omitempty" validate:"omitempty,min=0"`
+	EndDate      *string          `json:"end_date,omitempty"`
+	CostImpact   decimal.Decimal  `json:"cost_impact"`
+}
+
+// ReviewChangeOrderRequest represents a request to approve or reject a change order
+type ReviewChangeOrderRequest struct {
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ChangeOrderResponse represents a change order response
+type ChangeOrderResponse struct {
+	ID                   uuid.UUID        `json:"id"`
+	ProjectID            uuid.UUID        `json:"project_id"`
+	Title                string           `json:"title"`
+	Reason               string           `json:"reason"`
+	BudgetAmount         *decimal.Decimal `json:"budget_amount,omitempty"`
+	Revenue              *decimal.Decimal `json:"revenue,omitempty"`
+	EndDate              *string          `json:"end_date,omitempty"`
+	CostImpact           decimal.Decimal  `json:"cost_impact"`
+	Currency             string           `json:"currency"`
+	Status               string           `json:"status"`
+	RequestedBy          uuid.UUID        `json:"requested_by"`
+	ReviewedBy           *uuid.UUID       `json:"reviewed_by,omitempty"`
+	ReviewedAt           *time.Time       `json:"reviewed_at,omitempty"`
+	ReviewComment        *string          `json:"review_comment,omitempty"`
+	PreviousBudgetAmount *decimal.Decimal `json:"previous_budget_amount,omitempty"`
+	PreviousRevenue      *decimal.Decimal `json:"previous_revenue,omitempty"`
+	PreviousEndDate      *string          `json:"previous_end_date,omitempty"`
+	CreatedAt            time.Time        `json:"created_at"`
+	UpdatedAt            time.Time        `json:"updated_at"`
+}
+
+// ChangeOrderListResponse represents the change orders of a project
+type ChangeOrderListResponse struct {
+	ChangeOrders []ChangeOrderResponse `json:"change_orders"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateDepartmentOverheadRequest represents a request to set the overhead multiplier of a
+// department from a date
+type CreateDepartmentOverheadRequest struct {
+	Department    string          `json:"department" validate:"required,min=1,max=100"`
+	Multiplier    decimal.Decimal `json:"multiplier" validate:"gt=0,max=99"`
+	EffectiveFrom string          `json:"effective_from" validate:"required"`
+}
+
+// UpdateDepartmentOverheadRequest represents a request to update a department overhead multiplier
+type UpdateDepartmentOverheadRequest struct {
+	Multiplier *decimal.Decimal `json:"multiplier,omitempty" validate:"omitempty,gt=0,max=99"`
+}
+
+// DepartmentOverheadResponse represents a department overhead response
+type DepartmentOverheadResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	Department    string          `json:"department"`
+	Multiplier    decimal.Decimal `json:"multiplier"`
+	EffectiveFrom string          `json:"effective_from"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// DepartmentOverheadListResponse represents the list of department overheads
+type DepartmentOverheadListResponse struct {
+	Overheads []DepartmentOverheadResponse `json:"overheads"`
+}
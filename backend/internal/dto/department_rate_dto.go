@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateDepartmentRateRequest represents a request to set the default rate of a department
+type CreateDepartmentRateRequest struct {
+	Department string          `json:"department" validate:"required,min=1,max=100"`
+	HourlyRate decimal.Decimal `json:"hourly_rate" validate:"min=0"`
+	Currency   string          `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+}
+
+// UpdateDepartmentRateRequest represents a request to update the default rate of a department
+type UpdateDepartmentRateRequest struct {
+	HourlyRate *decimal.Decimal `json:"hourly_rate,omitempty" validate:"omitempty,min=0"`
+	Currency   *string          `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+}
+
+// DepartmentRateResponse represents a department rate response
+type DepartmentRateResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	Department string          `json:"department"`
+	HourlyRate decimal.Decimal `json:"hourly_rate"`
+	Currency   string          `json:"currency"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// DepartmentRateListResponse represents the list of department rates
+type DepartmentRateListResponse struct {
+	Rates []DepartmentRateResponse `json:"rates"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// EVMResponse represents earned value management metrics of a project as of a date
+type EVMResponse struct {
+	ProjectID            uuid.UUID          `json:"project_id"`
+	AsOf                 string             `json:"as_of"`
+	Currency             string             `json:"currency"`
+	BudgetAtCompletion   decimal.Decimal    `json:"budget_at_completion"`
+	PlannedValue         decimal.Decimal    `json:"planned_value"`
+	EarnedValue          decimal.Decimal    `json:"earned_value"`
+	ActualCost           decimal.Decimal    `json:"actual_cost"`
+	CostVariance         decimal.Decimal    `json:"cost_variance"`
+	ScheduleVariance     decimal.Decimal    `json:"schedule_variance"`
+	CPI                  *float64           `json:"cpi"`
+	SPI                  *float64           `json:"spi"`
+	EstimateAtCompletion decimal.Decimal    `json:"estimate_at_completion"`
+	EstimateToComplete   decimal.Decimal    `json:"estimate_to_complete"`
+	VarianceAtCompletion decimal.Decimal    `json:"variance_at_completion"`
+	PercentComplete      float64            `json:"percent_complete"`
+	Series               []EVMPointResponse `json:"series"`
+}
+
+// EVMPointResponse represents cumulative PV, EV and AC on a single day (S-curve point)
+type EVMPointResponse struct {
+	Date         string          `json:"date"`
+	PlannedValue decimal.Decimal `json:"planned_value"`
+	EarnedValue  decimal.Decimal `json:"earned_value"`
+	ActualCost   decimal.Decimal `json:"actual_cost"`
+}
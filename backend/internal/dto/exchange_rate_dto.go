@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateExchangeRateRequest represents a request to create an exchange rate
+type CreateExchangeRateRequest struct {
+	RateDate     string          `json:"rate_date" validate:"required"`
+	FromCurrency string          `json:"from_currency" validate:"required,len=3,uppercase"`
+	ToCurrency   string          `json:"to_currency" validate:"required,len=3,uppercase,nefield=FromCurrency"`
+	Rate         decimal.Decimal `json:"rate" validate:"required,gt=0"`
+}
+
+// UpdateExchangeRateRequest represents a request to update an exchange rate
+type UpdateExchangeRateRequest struct {
+	RateDate *string          `json:"rate_date,omitempty"`
+	Rate     *decimal.Decimal `json:"rate,omitempty" validate:"omitempty,gt=0"`
+}
+
+// ImportExchangeRatesRequest represents a request to import exchange rates in bulk.
+// Rates of an existing date and currency pair are overwritten.
+type ImportExchangeRatesRequest struct {
+	Rates []CreateExchangeRateRequest `json:"rates" validate:"required,min=1,max=1000,dive"`
+}
+
+// ImportExchangeRatesResponse represents the result of an exchange rate import
+type ImportExchangeRatesResponse struct {
+	Imported int `json:"imported"`
+}
+
+// ExchangeRateResponse represents an exchange rate response
+type ExchangeRateResponse struct {
+	ID           uuid.UUID       `json:"id"`
+	RateDate     string          `json:"rate_date"`
+	FromCurrency string          `json:"from_currency"`
+	ToCurrency   string          `json:"to_currency"`
+	Rate         decimal.Decimal `json:"rate"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// ExchangeRateListResponse represents a paginated list of exchange rates
+type ExchangeRateListResponse struct {
+	ExchangeRates []ExchangeRateResponse `json:"exchange_rates"`
+	Pagination    Pagination             `json:"pagination"`
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateExpenseRequest represents a request to create an expense
+type CreateExpenseRequest struct {
+	Category     string          `json:"category" validate:"required,oneof=subcontractor license travel hardware other"`
+	Amount       decimal.Decimal `json:"amount" validate:"min=0"`
+	Currency     string          `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+	Vendor       *string         `json:"vendor,omitempty" validate:"omitempty,max=200"`
+	IncurredDate string          `json:"incurred_date" validate:"required"`
+	Note         *string         `json:"note,omitempty"`
+}
+
+// UpdateExpenseRequest represents a request to update an expense
+type UpdateExpenseRequest struct {
+	Category     *string          `json:"category,omitempty" validate:"omitempty,oneof=subcontractor license travel hardware other"`
+	Amount       *decimal.Decimal `json:"amount,omitempty" validate:"omitempty,min=0"`
+	Currency     *string          `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+	Vendor       *string          `json:"vendor,omitempty" validate:"omitempty,max=200"`
+	IncurredDate *string          `json:"incurred_date,omitempty"`
+	Note         *string          `json:"note,omitempty"`
+}
+
+// ExpenseResponse represents an expense response
+type ExpenseResponse struct {
+	ID           uuid.UUID       `json:"id"`
+	ProjectID    uuid.UUID       `json:"project_id"`
+	UserID       uuid.UUID       `json:"user_id"`
+	Category     string          `json:"category"`
+	Amount       decimal.Decimal `json:"amount"`
+	Currency     string          `json:"currency"`
+	Vendor       *string         `json:"vendor,omitempty"`
+	IncurredDate string          `json:"incurred_date"`
+	Note         *string         `json:"note,omitempty"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// ExpenseListResponse represents a paginated list of expenses
+type ExpenseListResponse struct {
+	Expenses   []ExpenseResponse `json:"expenses"`
+	Pagination Pagination        `json:"pagination"`
+	Summary    *ExpenseSummary   `json:"summary,omitempty"`
+}
+
+// ExpenseSummary represents the summary of expenses, with the amounts converted into the
+// budget currency of the project
+type ExpenseSummary struct {
+	TotalAmount decimal.Decimal `json:"total_amount"`
+	Currency    string          `json:"currency"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateHolidayRequest represents a request to register a holiday
+type CreateHolidayRequest struct {
+	Date string `json:"date" validate:"required"`
+	Name string `json:"name" validate:"required,min=1,max=100"`
+}
+
+// HolidayResponse represents a holiday response
+type HolidayResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Date      string    `json:"date"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// HolidayListResponse represents the list of holidays
+type HolidayListResponse struct {
+	Holidays []HolidayResponse `json:"holidays"`
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateInvoiceRequest represents a request to create a draft invoice from the
+// unbilled time entries of a project within a date range
+type CreateInvoiceRequest struct {
+	From    string  `json:"from" validate:"required"`
+	To      string  `json:"to" validate:"required"`
+	GroupBy string  `json:"group_by,omitempty" validate:"omitempty,oneof=member task"`
+	Note    *string `json:"note,omitempty"`
+}
+
+// InvoiceResponse represents an invoice response
+type InvoiceResponse struct {
+	ID            uuid.UUID             `json:"id"`
+	ProjectID     uuid.UUID             `json:"project_id"`
+	InvoiceNumber *string               `json:"invoice_number,omitempty"`
+	Status        string                `json:"status"`
+	PeriodStart   string                `json:"period_start"`
+	PeriodEnd     string                `json:"period_end"`
+	GroupBy       string                `json:"group_by"`
+	Currency      string                `json:"currency"`
+	TotalHours    decimal.Decimal       `json:"total_hours"`
+	TotalAmount   decimal.Decimal       `json:"total_amount"`
+	Note          *string               `json:"note,omitempty"`
+	IssuedAt      *time.Time            `json:"issued_at,omitempty"`
+	PaidAt        *time.Time            `json:"paid_at,omitempty"`
+	CreatedAt     time.Time             `json:"created_at"`
+	UpdatedAt     time.Time             `json:"updated_at"`
+	Lines         []InvoiceLineResponse `json:"lines,omitempty"`
+}
+
+// InvoiceLineResponse represents an invoice line response
+type InvoiceLineResponse struct {
+	MemberID    *uuid.UUID      `json:"member_id,omitempty"`
+	TaskID      *uuid.UUID      `json:"task_id,omitempty"`
+	Description string          `json:"description"`
+	Hours       decimal.Decimal `json:"hours"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	Amount      decimal.Decimal `json:"amount"`
+}
+
+// InvoiceListResponse represents a paginated list of invoices
+type InvoiceListResponse struct {
+	Invoices   []InvoiceResponse `json:"invoices"`
+	Pagination Pagination        `json:"pagination"`
+}
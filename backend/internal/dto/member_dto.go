@@ -4,38 +4,45 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // CreateMemberRequest represents a request to create a member
 type CreateMemberRequest struct {
-	Name       string     `json:"name" validate:"required,min=1,max=100"`
-	Email      string     `json:"email" validate:"required,email,max=255"`
-	Role       *string    `json:"role,omitempty" validate:"omitempty,max=50"`
-	HourlyRate float64    `json:"hourly_rate" validate:"min=0"`
-	Department *string    `json:"department,omitempty" validate:"omitempty,max=100"`
-	UserID     *uuid.UUID `json:"user_id,omitempty"`
+	Name       string           `json:"name" validate:"required,min=1,max=100"`
+	Email      string           `json:"email" validate:"required,email,max=255"`
+	Role       *string          `json:"role,omitempty" validate:"omitempty,max=50"`
+	HourlyRate decimal.Decimal  `json:"hourly_rate" validate:"min=0"`
+	BillRate   *decimal.Decimal `json:"bill_rate,omitempty" validate:"omitempty,min=0"`
+	Currency   string           `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+	Department *string          `json:"department,omitempty" validate:"omitempty,max=100"`
+	UserID     *uuid.UUID       `json:"user_id,omitempty"`
 }
 
 // UpdateMemberRequest represents a request to update a member
 type UpdateMemberRequest struct {
-	Name       *string  `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
-	Email      *string  `json:"email,omitempty" validate:"omitempty,email,max=255"`
-	Role       *string  `json:"role,omitempty" validate:"omitempty,max=50"`
-	HourlyRate *float64 `json:"hourly_rate,omitempty" validate:"omitempty,min=0"`
-	Department *string  `json:"department,omitempty" validate:"omitempty,max=100"`
+	Name       *string          `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Email      *string          `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	Role       *string          `json:"role,omitempty" validate:"omitempty,max=50"`
+	HourlyRate *decimal.Decimal `json:"hourly_rate,omitempty" validate:"omitempty,min=0"`
+	BillRate   *decimal.Decimal `json:"bill_rate,omitempty" validate:"omitempty,min=0"`
+	Currency   *string          `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+	Department *string          `json:"department,omitempty" validate:"omitempty,max=100"`
 }
 
 // MemberResponse represents a member response
 type MemberResponse struct {
-	ID         uuid.UUID  `json:"id"`
-	UserID     *uuid.UUID `json:"user_id,omitempty"`
-	Name       string     `json:"name"`
-	Email      string     `json:"email"`
-	Role       *string    `json:"role,omitempty"`
-	HourlyRate float64    `json:"hourly_rate"`
-	Department *string    `json:"department,omitempty"`
-	CreatedAt  time.Time  `json:"created_at"`
-	UpdatedAt  time.Time  `json:"updated_at"`
+	ID         uuid.UUID        `json:"id"`
+	UserID     *uuid.UUID       `json:"user_id,omitempty"`
+	Name       string           `json:"name"`
+	Email      string           `json:"email"`
+	Role       *string          `json:"role,omitempty"`
+	HourlyRate decimal.Decimal  `json:"hourly_rate"`
+	BillRate   *decimal.Decimal `json:"bill_rate,omitempty"`
+	Currency   string           `json:"currency"`
+	Department *string          `json:"department,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
 }
 
 // MemberListResponse represents a paginated list of members
@@ -46,37 +53,50 @@ type MemberListResponse struct {
 
 // AssignMemberRequest represents a request to assign a member to a project
 type AssignMemberRequest struct {
-	MemberID           uuid.UUID `json:"member_id" validate:"required"`
-	AllocationRate     *float64  `json:"allocation_rate,omitempty" validate:"omitempty,min=0,max=1"`
-	HourlyRateSnapshot *float64  `json:"hourly_rate_snapshot,omitempty" validate:"omitempty,min=0"`
+	MemberID           uuid.UUID        `json:"member_id" validate:"required"`
+	AllocationRate     *float64         `json:"allocation_rate,omitempty" validate:"omitempty,min=0,max=1"`
+	HourlyRateSnapshot *decimal.Decimal `json:"hourly_rate_snapshot,omitempty" validate:"omitempty,min=0"`
+	BillRate           *decimal.Decimal `json:"bill_rate,omitempty" validate:"omitempty,min=0"`
 }
 
 // ProjectMemberResponse represents a project member assignment response
 type ProjectMemberResponse struct {
-	ID                 uuid.UUID       `json:"id"`
-	ProjectID          uuid.UUID       `json:"project_id"`
-	MemberID           uuid.UUID       `json:"member_id"`
-	JoinedAt           string          `json:"joined_at"`
-	LeftAt             *string         `json:"left_at,omitempty"`
-	AllocationRate     float64         `json:"allocation_rate"`
-	HourlyRateSnapshot *float64        `json:"hourly_rate_snapshot,omitempty"`
-	Member             *MemberResponse `json:"member,omitempty"`
+	ID                 uuid.UUID        `json:"id"`
+	ProjectID          uuid.UUID        `json:"project_id"`
+	MemberID           uuid.UUID        `json:"member_id"`
+	JoinedAt           string           `json:"joined_at"`
+	LeftAt             *string          `json:"left_at,omitempty"`
+	AllocationRate     float64          `json:"allocation_rate"`
+	HourlyRateSnapshot *decimal.Decimal `json:"hourly_rate_snapshot,omitempty"`
+	BillRate           *decimal.Decimal `json:"bill_rate,omitempty"`
+	Member             *MemberResponse  `json:"member,omitempty"`
 }
 
-// CreateTimeEntryRequest represents a request to create a time entry
+// CreateTimeEntryRequest represents a request to create a time entry. With a start and end time,
+// the hours and the work date follow from them in the given timezone, e.g. Asia/Tokyo.
 type CreateTimeEntryRequest struct {
-	TaskID   uuid.UUID `json:"task_id" validate:"required"`
-	MemberID uuid.UUID `json:"member_id" validate:"required"`
-	WorkDate string    `json:"work_date" validate:"required"`
-	Hours    float64   `json:"hours" validate:"required,min=0,max=24"`
-	Comment  *string   `json:"comment,omitempty"`
+	TaskID     uuid.UUID        `json:"task_id" validate:"required"`
+	MemberID   uuid.UUID        `json:"member_id" validate:"required"`
+	WorkDate   string           `json:"work_date" validate:"required_without=StartedAt"`
+	Hours      decimal.Decimal  `json:"hours" validate:"required_without=StartedAt,min=0,max=24"`
+	StartedAt  *time.Time       `json:"started_at,omitempty" validate:"required_with=EndedAt"`
+	EndedAt    *time.Time       `json:"ended_at,omitempty" validate:"required_with=StartedAt"`
+	Timezone   *string          `json:"timezone,omitempty" validate:"required_with=StartedAt"`
+	Comment    *string          `json:"comment,omitempty"`
+	IsBillable *bool            `json:"is_billable,omitempty"`
+	HourlyRate *decimal.Decimal `json:"hourly_rate,omitempty" validate:"omitempty,min=0"`
 }
 
 // UpdateTimeEntryRequest represents a request to update a time entry
 type UpdateTimeEntryRequest struct {
-	WorkDate *string  `json:"work_date,omitempty"`
-	Hours    *float64 `json:"hours,omitempty" validate:"omitempty,min=0,max=24"`
-	Comment  *string  `json:"comment,omitempty"`
+	WorkDate   *string          `json:"work_date,omitempty"`
+	Hours      *decimal.Decimal `json:"hours,omitempty" validate:"omitempty,min=0,max=24"`
+	StartedAt  *time.Time       `json:"started_at,omitempty"`
+	EndedAt    *time.Time       `json:"ended_at,omitempty"`
+	Timezone   *string          `json:"timezone,omitempty"`
+	Comment    *string          `json:"comment,omitempty"`
+	IsBillable *bool            `json:"is_billable,omitempty"`
+	HourlyRate *decimal.Decimal `json:"hourly_rate,omitempty" validate:"omitempty,min=0"`
 }
 
 // TimeEntryResponse represents a time entry response
@@ -86,13 +106,45 @@ type TimeEntryResponse struct {
 	MemberID           uuid.UUID            `json:"member_id"`
 	UserID             uuid.UUID            `json:"user_id"`
 	WorkDate           string               `json:"work_date"`
-	Hours              float64              `json:"hours"`
-	HourlyRateSnapshot *float64             `json:"hourly_rate_snapshot,omitempty"`
-	Cost               float64              `json:"cost"`
+	Hours              decimal.Decimal      `json:"hours"`
+	HourlyRateSnapshot *decimal.Decimal     `json:"hourly_rate_snapshot,omitempty"`
+	RateSource         string               `json:"rate_source"`
+	BillRateSnapshot   *decimal.Decimal     `json:"bill_rate_snapshot,omitempty"`
+	IsBillable         bool                 `json:"is_billable"`
+	Currency           string               `json:"currency"`
+	Cost               decimal.Decimal      `json:"cost"`
+	BillValue          decimal.Decimal      `json:"bill_value"`
 	Comment            *string              `json:"comment,omitempty"`
+	InvoiceID          *uuid.UUID           `json:"invoice_id,omitempty"`
+	StartedAt          *time.Time           `json:"started_at,omitempty"`
+	EndedAt            *time.Time           `json:"ended_at,omitempty"`
+	Timezone           *string              `json:"timezone,omitempty"`
 	CreatedAt          time.Time            `json:"created_at"`
 	UpdatedAt          time.Time            `json:"updated_at"`
 	Member             *MemberBriefResponse `json:"member,omitempty"`
+	// Warnings lists the daily limits the member's time on the work date goes beyond
+	Warnings []DailyHoursViolation `json:"warnings,omitempty"`
+}
+
+// DailyHoursViolation represents the time of a member on one work date going beyond a daily
+// limit: the maximum hours per day, or the member's allocation across projects
+type DailyHoursViolation struct {
+	MemberID       uuid.UUID       `json:"member_id"`
+	WorkDate       string          `json:"work_date"`
+	Limit          string          `json:"limit"`
+	LimitHours     decimal.Decimal `json:"limit_hours"`
+	RecordedHours  decimal.Decimal `json:"recorded_hours"`
+	RequestedHours decimal.Decimal `json:"requested_hours"`
+	TotalHours     decimal.Decimal `json:"total_hours"`
+	ExcessHours    decimal.Decimal `json:"excess_hours"`
+}
+
+// TimeEntryOverlap represents a time entry of the member whose time range overlaps the one requested
+type TimeEntryOverlap struct {
+	ID        uuid.UUID `json:"id"`
+	WorkDate  string    `json:"work_date"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
 }
 
 // TimeEntryListResponse represents a paginated list of time entries
@@ -102,8 +154,18 @@ type TimeEntryListResponse struct {
 	Summary     *TimeEntrySummary   `json:"summary,omitempty"`
 }
 
-// TimeEntrySummary represents the summary of time entries
+// TimeEntrySummary represents the summary of time entries. The costs of entries listed for a
+// project are converted into its budget currency and totalled in TotalCost; otherwise they are
+// totalled per currency in Costs.
 type TimeEntrySummary struct {
-	TotalHours float64 `json:"total_hours"`
-	TotalCost  float64 `json:"total_cost"`
+	TotalHours decimal.Decimal      `json:"total_hours"`
+	TotalCost  *decimal.Decimal     `json:"total_cost,omitempty"`
+	Currency   string               `json:"currency,omitempty"`
+	Costs      []TimeEntryCostTotal `json:"costs,omitempty"`
+}
+
+// TimeEntryCostTotal represents the total cost of time entries in one currency
+type TimeEntryCostTotal struct {
+	Currency string          `json:"currency"`
+	Cost     decimal.Decimal `json:"cost"`
 }
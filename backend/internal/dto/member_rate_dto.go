@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateMemberRateRequest represents a request to change a member's hourly rate from a date
+type CreateMemberRateRequest struct {
+	HourlyRate    decimal.Decimal `json:"hourly_rate" validate:"min=0"`
+	Currency      string          `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+	EffectiveFrom string          `json:"effective_from" validate:"required"`
+}
+
+// MemberRateResponse represents a member rate response
+type MemberRateResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	MemberID      uuid.UUID       `json:"member_id"`
+	HourlyRate    decimal.Decimal `json:"hourly_rate"`
+	Currency      string          `json:"currency"`
+	EffectiveFrom string          `json:"effective_from"`
+	EffectiveTo   *string         `json:"effective_to,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// MemberRateListResponse represents the rate history of a member
+type MemberRateListResponse struct {
+	Rates []MemberRateResponse `json:"rates"`
+}
+
+// RecomputeRatesRequest represents a request to recompute the cost rate snapshots of
+// time entries from the member rate history
+type RecomputeRatesRequest struct {
+	From     string     `json:"from" validate:"required"`
+	To       string     `json:"to" validate:"required"`
+	MemberID *uuid.UUID `json:"member_id,omitempty"`
+	DryRun   bool       `json:"dry_run"`
+}
+
+// RecomputeRatesResponse represents the result of recomputing rate snapshots.
+// Time entries on issued invoices or in closed accounting periods are left unchanged
+// and only counted as skipped.
+type RecomputeRatesResponse struct {
+	DryRun       bool                  `json:"dry_run"`
+	CheckedCount int                   `json:"checked_count"`
+	ChangedCount int                   `json:"changed_count"`
+	SkippedCount int                   `json:"skipped_count"`
+	Changes      []TimeEntryRateChange `json:"changes"`
+}
+
+// TimeEntryRateChange represents the difference of one time entry's cost rate snapshot
+type TimeEntryRateChange struct {
+	TimeEntryID uuid.UUID        `json:"time_entry_id"`
+	ProjectID   uuid.UUID        `json:"project_id"`
+	MemberID    uuid.UUID        `json:"member_id"`
+	MemberName  string           `json:"member_name"`
+	WorkDate    string           `json:"work_date"`
+	Hours       decimal.Decimal  `json:"hours"`
+	OldRate     *decimal.Decimal `json:"old_rate,omitempty"`
+	NewRate     decimal.Decimal  `json:"new_rate"`
+	OldCurrency string           `json:"old_currency"`
+	NewCurrency string           `json:"new_currency"`
+	OldSource   string           `json:"old_source"`
+	NewSource   string           `json:"new_source"`
+	OldCost     decimal.Decimal  `json:"old_cost"`
+	NewCost     decimal.Decimal  `json:"new_cost"`
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PortfolioSummaryResponse represents the budget figures across the projects of an owner.
+// Amounts are in each project's budget currency, so the totals are given per currency.
+// ReportingTotal totals every project in the requested reporting currency, if any.
+type PortfolioSummaryResponse struct {
+	OwnerID         uuid.UUID                  `json:"owner_id"`
+	ProjectCount    int                        `json:"project_count"`
+	StatusCounts    map[string]int             `json:"status_counts"`
+	Totals          []PortfolioTotalResponse   `json:"totals"`
+	ReportingTotal  *PortfolioTotalResponse    `json:"reporting_total,omitempty"`
+	DeficitProjects []PortfolioProjectResponse `json:"deficit_projects"`
+	AtRiskProjects  []PortfolioProjectResponse `json:"at_risk_projects"`
+}
+
+// PortfolioTotalResponse represents the total figures of the projects in one currency
+type PortfolioTotalResponse struct {
+	Currency     string          `json:"currency"`
+	ProjectCount int             `json:"project_count"`
+	Revenue      decimal.Decimal `json:"revenue"`
+	TotalCost    decimal.Decimal `json:"total_cost"`
+	Profit       decimal.Decimal `json:"profit"`
+	ProfitRate   decimal.Decimal `json:"profit_rate"`
+}
+
+// PortfolioProjectResponse represents a project in a portfolio.
+// CostRatio is the total cost as a percentage of BudgetAmount, nil when no budget amount is set.
+// ReportingProfit is the profit converted into the reporting currency, if one was requested.
+type PortfolioProjectResponse struct {
+	ID              uuid.UUID        `json:"id"`
+	Name            string           `json:"name"`
+	Status          string           `json:"status"`
+	StartDate       *time.Time       `json:"start_date,omitempty"`
+	EndDate         *time.Time       `json:"end_date,omitempty"`
+	Currency        string           `json:"currency"`
+	BudgetAmount    *decimal.Decimal `json:"budget_amount,omitempty"`
+	Revenue         decimal.Decimal  `json:"revenue"`
+	TotalCost       decimal.Decimal  `json:"total_cost"`
+	Profit          decimal.Decimal  `json:"profit"`
+	ProfitRate      decimal.Decimal  `json:"profit_rate"`
+	ReportingProfit *decimal.Decimal `json:"reporting_profit,omitempty"`
+	CostRatio       *decimal.Decimal `json:"cost_ratio,omitempty"`
+	ActiveAlerts    int              `json:"active_alerts"`
+	RiskReasons     []string         `json:"risk_reasons,omitempty"`
+}
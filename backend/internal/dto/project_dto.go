@@ -4,40 +4,56 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // CreateProjectRequest represents a request to create a project
 type CreateProjectRequest struct {
-	Name         string  `json:"name" validate:"required,min=1,max=200"`
-	Description  *string `json:"description,omitempty"`
-	Status       string  `json:"status,omitempty" validate:"omitempty,oneof=planning in_progress completed on_hold"`
-	BudgetAmount *float64 `json:"budget_amount,omitempty" validate:"omitempty,min=0"`
-	StartDate    *string `json:"start_date,omitempty"`
-	EndDate      *string `json:"end_date,omitempty"`
+	Name                   string           `json:"name" validate:"required,min=1,max=200"`
+	Description            *string          `json:"description,omitempty"`
+	Status                 string           `json:"status,omitempty" validate:"omitempty,oneof=planning in_progress completed on_hold"`
+	BudgetAmount           *decimal.Decimal `json:"budget_amount,omitempty" validate:"omitempty,min=0"`
+	StartDate              *string          `json:"start_date,omitempty"`
+	EndDate                *string          `json:"end_date,omitempty"`
+	DefaultBillable        *bool            `json:"default_billable,omitempty"`
+	ContractType           string           `json:"contract_type,omitempty" validate:"omitempty,oneof=fixed_price time_and_materials retainer"`
+	RetainerFee            *decimal.Decimal `json:"retainer_fee,omitempty" validate:"omitempty,min=0"`
+	RetainerHours          *decimal.Decimal `json:"retainer_hours,omitempty" validate:"omitempty,min=0"`
+	RetainerRolloverMonths *int             `json:"retainer_rollover_months,omitempty" validate:"omitempty,min=0"`
 }
 
 // UpdateProjectRequest represents a request to update a project
 type UpdateProjectRequest struct {
-	Name         *string  `json:"name,omitempty" validate:"omitempty,min=1,max=200"`
-	Description  *string  `json:"description,omitempty"`
-	Status       *string  `json:"status,omitempty" validate:"omitempty,oneof=planning in_progress completed on_hold"`
-	BudgetAmount *float64 `json:"budget_amount,omitempty" validate:"omitempty,min=0"`
-	StartDate    *string  `json:"start_date,omitempty"`
-	EndDate      *string  `json:"end_date,omitempty"`
+	Name                   *string          `json:"name,omitempty" validate:"omitempty,min=1,max=200"`
+	Description            *string          `json:"description,omitempty"`
+	Status                 *string          `json:"status,omitempty" validate:"omitempty,oneof=planning in_progress completed on_hold"`
+	BudgetAmount           *decimal.Decimal `json:"budget_amount,omitempty" validate:"omitempty,min=0"`
+	StartDate              *string          `json:"start_date,omitempty"`
+	EndDate                *string          `json:"end_date,omitempty"`
+	DefaultBillable        *bool            `json:"default_billable,omitempty"`
+	ContractType           *string          `json:"contract_type,omitempty" validate:"omitempty,oneof=fixed_price time_and_materials retainer"`
+	RetainerFee            *decimal.Decimal `json:"retainer_fee,omitempty" validate:"omitempty,min=0"`
+	RetainerHours          *decimal.Decimal `json:"retainer_hours,omitempty" validate:"omitempty,min=0"`
+	RetainerRolloverMonths *int             `json:"retainer_rollover_months,omitempty" validate:"omitempty,min=0"`
 }
 
 // ProjectResponse represents a project response
 type ProjectResponse struct {
-	ID           uuid.UUID `json:"id"`
-	UserID       uuid.UUID `json:"user_id"`
-	Name         string    `json:"name"`
-	Description  *string   `json:"description,omitempty"`
-	Status       string    `json:"status"`
-	BudgetAmount *float64  `json:"budget_amount,omitempty"`
-	StartDate    *string   `json:"start_date,omitempty"`
-	EndDate      *string   `json:"end_date,omitempty"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID                     uuid.UUID        `json:"id"`
+	UserID                 uuid.UUID        `json:"user_id"`
+	Name                   string           `json:"name"`
+	Description            *string          `json:"description,omitempty"`
+	Status                 string           `json:"status"`
+	BudgetAmount           *decimal.Decimal `json:"budget_amount,omitempty"`
+	StartDate              *string          `json:"start_date,omitempty"`
+	EndDate                *string          `json:"end_date,omitempty"`
+	DefaultBillable        bool             `json:"default_billable"`
+	ContractType           string           `json:"contract_type"`
+	RetainerFee            *decimal.Decimal `json:"retainer_fee,omitempty"`
+	RetainerHours          *decimal.Decimal `json:"retainer_hours,omitempty"`
+	RetainerRolloverMonths int              `json:"retainer_rollover_months"`
+	CreatedAt              time.Time        `json:"created_at"`
+	UpdatedAt              time.Time        `json:"updated_at"`
 }
 
 // ProjectDetailResponse represents a detailed project response
@@ -48,11 +64,11 @@ type ProjectDetailResponse struct {
 
 // ProjectStatsResponse represents project statistics
 type ProjectStatsResponse struct {
-	TotalTasks        int     `json:"total_tasks"`
-	CompletedTasks    int     `json:"completed_tasks"`
-	TotalPlannedHours float64 `json:"total_planned_hours"`
-	TotalActualHours  float64 `json:"total_actual_hours"`
-	CompletionRate    float64 `json:"completion_rate"`
+	TotalTasks        int             `json:"total_tasks"`
+	CompletedTasks    int             `json:"completed_tasks"`
+	TotalPlannedHours decimal.Decimal `json:"total_planned_hours"`
+	TotalActualHours  decimal.Decimal `json:"total_actual_hours"`
+	CompletionRate    float64         `json:"completion_rate"`
 }
 
 // ProjectListResponse represents a paginated list of projects
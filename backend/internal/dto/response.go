@@ -36,10 +36,10 @@ func ErrorResponse(code, message string, details interface{}) Response {
 
 // Pagination represents pagination metadata
 type Pagination struct {
-	Page       int `json:"page"`
-	PerPage    int `json:"per_page"`
+	Page       int   `json:"page"`
+	PerPage    int   `json:"per_page"`
 	Total      int64 `json:"total"`
-	TotalPages int `json:"total_pages"`
+	TotalPages int   `json:"total_pages"`
 }
 
 // ListResponse represents a paginated list response
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateRevenueItemRequest represents a request to create a revenue item
+type CreateRevenueItemRequest struct {
+	Name        string          `json:"name" validate:"required,max=255"`
+	PlannedDate string          `json:"planned_date" validate:"required"`
+	Amount      decimal.Decimal `json:"amount"`
+	Currency    string          `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+	Status      string          `json:"status,omitempty" validate:"omitempty,oneof=planned invoiced received"`
+	TaskID      *uuid.UUID      `json:"task_id,omitempty"`
+	Description *string         `json:"description,omitempty"`
+}
+
+// UpdateRevenueItemRequest represents a request to update a revenue item.
+// A nil task_id (all zeros) unlinks the item from its task.
+type UpdateRevenueItemRequest struct {
+	Name        *string          `json:"name,omitempty" validate:"omitempty,max=255"`
+	PlannedDate *string          `json:"planned_date,omitempty"`
+	Amount      *decimal.Decimal `json:"amount,omitempty"`
+	Currency    *string          `json:"currency,omitempty" validate:"omitempty,len=3,uppercase"`
+	Status      *string          `json:"status,omitempty" validate:"omitempty,oneof=planned invoiced received"`
+	TaskID      *uuid.UUID       `json:"task_id,omitempty"`
+	Description *string          `json:"description,omitempty"`
+}
+
+// RevenueItemResponse represents a revenue item response
+type RevenueItemResponse struct {
+	ID          uuid.UUID       `json:"id"`
+	ProjectID   uuid.UUID       `json:"project_id"`
+	TaskID      *uuid.UUID      `json:"task_id,omitempty"`
+	TaskName    *string         `json:"task_name,omitempty"`
+	Name        string          `json:"name"`
+	PlannedDate string          `json:"planned_date"`
+	Amount      decimal.Decimal `json:"amount"`
+	Currency    string          `json:"currency"`
+	Status      string          `json:"status"`
+	Description *string         `json:"description,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// RevenueItemListResponse represents the revenue items of a project with their totals
+// in the budget currency
+type RevenueItemListResponse struct {
+	Items             []RevenueItemResponse `json:"items"`
+	Currency          string                `json:"currency"`
+	TotalRevenue      decimal.Decimal       `json:"total_revenue"`
+	RecognizedRevenue decimal.Decimal       `json:"recognized_revenue"`
+	PlannedRevenue    decimal.Decimal       `json:"planned_revenue"`
+}
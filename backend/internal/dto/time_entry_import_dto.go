@@ -0,0 +1,25 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// TimeEntryImportRowError represents a problem with one row of an imported CSV file.
+// Row is the line number in the file, counting the header as line 1.
+type TimeEntryImportRowError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// TimeEntryImportResponse represents the result of importing time entries from CSV.
+// A dry run checks every row without saving anything. A real run saves either every row
+// or, when any row has errors, none of them.
+type TimeEntryImportResponse struct {
+	DryRun       bool                      `json:"dry_run"`
+	TotalRows    int                       `json:"total_rows"`
+	ValidRows    int                       `json:"valid_rows"`
+	ImportedRows int                       `json:"imported_rows"`
+	TotalHours   decimal.Decimal           `json:"total_hours"`
+	Errors       []TimeEntryImportRowError `json:"errors"`
+	// Warnings lists the rows that go beyond the allocation of their member but are still imported
+	Warnings []TimeEntryImportRowError `json:"warnings,omitempty"`
+}
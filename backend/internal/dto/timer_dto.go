@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// StartTimerRequest represents a request to start a timer for a member on a task
+type StartTimerRequest struct {
+	TaskID     uuid.UUID `json:"task_id" validate:"required"`
+	MemberID   uuid.UUID `json:"member_id" validate:"required"`
+	Comment    *string   `json:"comment,omitempty"`
+	IsBillable *bool     `json:"is_billable,omitempty"`
+}
+
+// StopTimerRequest represents a request to stop the running timer of a member.
+// A comment given here replaces the one given when the timer was started.
+type StopTimerRequest struct {
+	MemberID uuid.UUID `json:"member_id" validate:"required"`
+	Comment  *string   `json:"comment,omitempty"`
+}
+
+// TimerResponse represents a timer response. ElapsedHours is the unrounded time measured so far,
+// or until the timer was stopped. TimeEntries are the entries created when the timer was stopped,
+// and SkippedEntries the work dates whose entry was rejected, e.g. because the period is closed.
+type TimerResponse struct {
+	ID             uuid.UUID                   `json:"id"`
+	TaskID         uuid.UUID                   `json:"task_id"`
+	TaskName       string                      `json:"task_name,omitempty"`
+	MemberID       uuid.UUID                   `json:"member_id"`
+	UserID         uuid.UUID                   `json:"user_id"`
+	StartedAt      time.Time                   `json:"started_at"`
+	StoppedAt      *time.Time                  `json:"stopped_at,omitempty"`
+	AutoStopped    bool                        `json:"auto_stopped"`
+	ElapsedHours   decimal.Decimal             `json:"elapsed_hours"`
+	Comment        *string                     `json:"comment,omitempty"`
+	IsBillable     *bool                       `json:"is_billable,omitempty"`
+	TimeEntries    []TimeEntryResponse         `json:"time_entries,omitempty"`
+	SkippedEntries []TimerSkippedEntryResponse `json:"skipped_entries,omitempty"`
+}
+
+// TimerSkippedEntryResponse represents the time of a work date that could not be recorded when
+// the timer was stopped, with the error code and message of the rejection
+type TimerSkippedEntryResponse struct {
+	WorkDate string          `json:"work_date"`
+	Hours    decimal.Decimal `json:"hours"`
+	Code     string          `json:"code"`
+	Message  string          `json:"message"`
+}
+
+// TimerListResponse represents a list of running timers
+type TimerListResponse struct {
+	Timers []TimerResponse `json:"timers"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReviewTimesheetRequest represents an approver's decision on a submitted timesheet.
+// A comment is required to reject a timesheet.
+type ReviewTimesheetRequest struct {
+	Comment *string `json:"comment,omitempty"`
+}
+
+// TimesheetResponse represents the timesheet of a member for an ISO week. Week is the week as
+// YYYY-Www. A week that has never been submitted is a draft without an ID.
+// TotalHours and TimeEntries are only returned for a single timesheet.
+type TimesheetResponse struct {
+	ID            *uuid.UUID          `json:"id,omitempty"`
+	MemberID      uuid.UUID           `json:"member_id"`
+	MemberName    string              `json:"member_name,omitempty"`
+	Week          string              `json:"week"`
+	WeekStart     string              `json:"week_start"`
+	WeekEnd       string              `json:"week_end"`
+	Status        string              `json:"status"`
+	SubmittedBy   *uuid.UUID          `json:"submitted_by,omitempty"`
+	SubmittedAt   *time.Time          `json:"submitted_at,omitempty"`
+	ReviewedBy    *uuid.UUID          `json:"reviewed_by,omitempty"`
+	ReviewedAt    *time.Time          `json:"reviewed_at,omitempty"`
+	ReviewComment *string             `json:"review_comment,omitempty"`
+	TotalHours    *decimal.Decimal    `json:"total_hours,omitempty"`
+	TimeEntries   []TimeEntryResponse `json:"time_entries,omitempty"`
+}
+
+// TimesheetListResponse represents a list of timesheets
+type TimesheetListResponse struct {
+	Timesheets []TimesheetResponse `json:"timesheets"`
+}
@@ -11,6 +11,8 @@ type AppError struct {
 	Message    string `json:"message"`
 	StatusCode int    `json:"-"`
 	Err        error  `json:"-"`
+	// Details describes the error in a structured form for the response, if any
+	Details interface{} `json:"details,omitempty"`
 }
 
 // Error implements the error interface
@@ -74,6 +76,31 @@ var (
 		return NewAppError("CONFLICT", message, http.StatusConflict, nil)
 	}
 
+	ErrPeriodClosed = func(period string) *AppError {
+		return NewAppError("PERIOD_CLOSED", fmt.Sprintf("Accounting period %s is closed", period), http.StatusConflict, nil)
+	}
+
+	ErrTimesheetLocked = func(week string) *AppError {
+		return NewAppError("TIMESHEET_LOCKED", fmt.Sprintf("Timesheet of week %s has been submitted", week), http.StatusConflict, nil)
+	}
+
+	ErrTimeEntryOverlap = func(details interface{}) *AppError {
+		appErr := NewAppError("TIME_ENTRY_OVERLAP", "Time entry overlaps another time entry of the member", http.StatusConflict, nil)
+		appErr.Details = details
+		return appErr
+	}
+
+	// Unprocessable errors
+	ErrDailyHoursExceeded = func(details interface{}) *AppError {
+		appErr := NewAppError("DAILY_HOURS_EXCEEDED", "Time entries of the member exceed the daily limit", http.StatusUnprocessableEntity, nil)
+		appErr.Details = details
+		return appErr
+	}
+
+	ErrExchangeRateNotFound = func(from, to string) *AppError {
+		return NewAppError("EXCHANGE_RATE_NOT_FOUND", fmt.Sprintf("Exchange rate from %s to %s not found", from, to), http.StatusUnprocessableEntity, nil)
+	}
+
 	// Internal errors
 	ErrInternal = func(err error) *AppError {
 		return NewAppError("INTERNAL_ERROR", "An internal error occurred", http.StatusInternalServerError, err)
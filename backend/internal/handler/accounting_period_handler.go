@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// AccountingPeriodHandler handles HTTP requests for monthly accounting periods
+type AccountingPeriodHandler struct {
+	periodService *service.AccountingPeriodService
+}
+
+// NewAccountingPeriodHandler creates a new AccountingPeriodHandler
+func NewAccountingPeriodHandler(periodService *service.AccountingPeriodService) *AccountingPeriodHandler {
+	return &AccountingPeriodHandler{periodService: periodService}
+}
+
+// ListAccountingPeriods handles GET /api/v1/accounting-periods
+func (h *AccountingPeriodHandler) ListAccountingPeriods(c echo.Context) error {
+	periods, err := h.periodService.ListAccountingPeriods()
+	if err != nil {
+		return handleAccountingPeriodError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(periods))
+}
+
+// CloseAccountingPeriod handles POST /api/v1/admin/accounting-periods/:month/close
+func (h *AccountingPeriodHandler) CloseAccountingPeriod(c echo.Context) error {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	period, err := h.periodService.CloseAccountingPeriod(c.Param("month"), userID)
+	if err != nil {
+		return handleAccountingPeriodError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(period))
+}
+
+// ReopenAccountingPeriod handles POST /api/v1/admin/accounting-periods/:month/reopen
+func (h *AccountingPeriodHandler) ReopenAccountingPeriod(c echo.Context) error {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	period, err := h.periodService.ReopenAccountingPeriod(c.Param("month"), userID)
+	if err != nil {
+		return handleAccountingPeriodError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(period))
+}
+
+// handleAccountingPeriodError converts AppError to HTTP response
+func handleAccountingPeriodError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// AlertHandler handles HTTP requests for budget alert rules and alerts
+type AlertHandler struct {
+	alertService *service.AlertService
+}
+
+// NewAlertHandler creates a new AlertHandler
+func NewAlertHandler(alertService *service.AlertService) *AlertHandler {
+	return &AlertHandler{alertService: alertService}
+}
+
+// CreateAlertRule handles POST /api/v1/projects/:id/alert-rules
+func (h *AlertHandler) CreateAlertRule(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	var req dto.CreateAlertRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	rule, err := h.alertService.CreateAlertRule(projectID, &req)
+	if err != nil {
+		return handleAlertError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(rule))
+}
+
+// ListAlertRules handles GET /api/v1/projects/:id/alert-rules
+func (h *AlertHandler) ListAlertRules(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	rules, err := h.alertService.ListAlertRules(projectID)
+	if err != nil {
+		return handleAlertError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(rules))
+}
+
+// UpdateAlertRule handles PUT /api/v1/projects/:id/alert-rules/:ruleId
+func (h *AlertHandler) UpdateAlertRule(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	ruleID, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid alert rule ID", nil))
+	}
+
+	var req dto.UpdateAlertRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	rule, err := h.alertService.UpdateAlertRule(projectID, ruleID, &req)
+	if err != nil {
+		return handleAlertError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(rule))
+}
+
+// DeleteAlertRule handles DELETE /api/v1/projects/:id/alert-rules/:ruleId
+func (h *AlertHandler) DeleteAlertRule(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	ruleID, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid alert rule ID", nil))
+	}
+
+	if err := h.alertService.DeleteAlertRule(projectID, ruleID); err != nil {
+		return handleAlertError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Alert rule deleted successfully"}))
+}
+
+// ListAlerts handles GET /api/v1/projects/:id/alerts
+func (h *AlertHandler) ListAlerts(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	status := c.QueryParam("status")
+	switch status {
+	case "", models.AlertStatusOpen, models.AlertStatusAcknowledged, models.AlertStatusResolved:
+	default:
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "status must be one of open, acknowledged, resolved", nil))
+	}
+
+	// Parse pagination params
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(c.QueryParam("per_page"))
+	if perPage < 1 {
+		perPage = 20
+	}
+
+	alerts, err := h.alertService.ListAlerts(repository.AlertListParams{
+		ProjectID: projectID,
+		Status:    status,
+		Page:      page,
+		PerPage:   perPage,
+	})
+	if err != nil {
+		return handleAlertError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(alerts))
+}
+
+// AcknowledgeAlert handles PUT /api/v1/projects/:id/alerts/:alertId/acknowledge
+func (h *AlertHandler) AcknowledgeAlert(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	alertID, err := uuid.Parse(c.Param("alertId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid alert ID", nil))
+	}
+
+	alert, err := h.alertService.AcknowledgeAlert(projectID, alertID)
+	if err != nil {
+		return handleAlertError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(alert))
+}
+
+// ResolveAlert handles PUT /api/v1/projects/:id/alerts/:alertId/resolve
+func (h *AlertHandler) ResolveAlert(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	alertID, err := uuid.Parse(c.Param("alertId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid alert ID", nil))
+	}
+
+	alert, err := h.alertService.ResolveAlert(projectID, alertID)
+	if err != nil {
+		return handleAlertError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(alert))
+}
+
+// handleAlertError converts AppError to HTTP response
+func handleAlertError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
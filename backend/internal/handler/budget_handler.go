@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
@@ -31,7 +32,13 @@ func (h *BudgetHandler) GetBudget(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
 	}
 
-	budget, err := h.budgetService.GetBudgetSummary(projectID)
+	// Parse optional period filters
+	period, err := parsePeriod(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", err.Error(), nil))
+	}
+
+	budget, err := h.budgetService.GetBudgetSummary(projectID, period)
 	if err != nil {
 		return handleBudgetError(c, err)
 	}
@@ -39,6 +46,72 @@ func (h *BudgetHandler) GetBudget(c echo.Context) error {
 	return c.JSON(http.StatusOK, dto.SuccessResponse(budget))
 }
 
+// GetBudgetComparison handles GET /api/v1/projects/:id/budget/comparison
+func (h *BudgetHandler) GetBudgetComparison(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	asOf := time.Now()
+	if asOfStr := c.QueryParam("as_of"); asOfStr != "" {
+		asOf, err = time.Parse("2006-01-02", asOfStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid as_of date", nil))
+		}
+	}
+
+	comparison, err := h.budgetService.GetBudgetComparison(projectID, asOf)
+	if err != nil {
+		return handleBudgetError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(comparison))
+}
+
+// GetCostForecast handles GET /api/v1/projects/:id/budget/forecast
+func (h *BudgetHandler) GetCostForecast(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	asOf := time.Now()
+	if asOfStr := c.QueryParam("as_of"); asOfStr != "" {
+		asOf, err = time.Parse("2006-01-02", asOfStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid as_of date", nil))
+		}
+	}
+
+	forecast, err := h.budgetService.GetCostForecast(projectID, asOf)
+	if err != nil {
+		return handleBudgetError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(forecast))
+}
+
+// GetBudgetHistory handles GET /api/v1/projects/:id/budget/history
+func (h *BudgetHandler) GetBudgetHistory(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	period, err := parsePeriod(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", err.Error(), nil))
+	}
+
+	history, err := h.budgetService.GetBudgetHistory(projectID, c.QueryParam("granularity"), period)
+	if err != nil {
+		return handleBudgetError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(history))
+}
+
 // UpdateRevenue handles PUT /api/v1/projects/:id/budget/revenue
 func (h *BudgetHandler) UpdateRevenue(c echo.Context) error {
 	projectID, err := uuid.Parse(c.Param("id"))
@@ -63,6 +136,30 @@ func (h *BudgetHandler) UpdateRevenue(c echo.Context) error {
 	return c.JSON(http.StatusOK, dto.SuccessResponse(budget))
 }
 
+// UpdateHoursBasis handles PUT /api/v1/projects/:id/budget/hours-basis
+func (h *BudgetHandler) UpdateHoursBasis(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	var req dto.UpdateHoursBasisRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	budget, err := h.budgetService.UpdateHoursBasis(projectID, &req)
+	if err != nil {
+		return handleBudgetError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(budget))
+}
+
 // CreateTimeEntry handles POST /api/v1/time-entries
 func (h *BudgetHandler) CreateTimeEntry(c echo.Context) error {
 	// Get user ID from context
@@ -152,6 +249,12 @@ func (h *BudgetHandler) ListTimeEntries(c echo.Context) error {
 		}
 	}
 
+	timeOfDay, err := parseTimeOfDay(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", err.Error(), nil))
+	}
+	params.TimeOfDay = timeOfDay
+
 	entries, err := h.budgetService.ListTimeEntries(params)
 	if err != nil {
 		return handleBudgetError(c, err)
@@ -194,10 +297,59 @@ func (h *BudgetHandler) DeleteTimeEntry(c echo.Context) error {
 	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Time entry deleted successfully"}))
 }
 
+// parsePeriod parses the optional from/to query parameters into a date range
+func parsePeriod(c echo.Context) (repository.DateRange, error) {
+	var period repository.DateRange
+	if fromStr := c.QueryParam("from"); fromStr != "" {
+		from, err := time.Parse("2006-01-02", fromStr)
+		if err != nil {
+			return period, errors.New("Invalid from date")
+		}
+		period.From = &from
+	}
+	if toStr := c.QueryParam("to"); toStr != "" {
+		to, err := time.Parse("2006-01-02", toStr)
+		if err != nil {
+			return period, errors.New("Invalid to date")
+		}
+		period.To = &to
+	}
+	if period.From != nil && period.To != nil && period.To.Before(*period.From) {
+		return period, errors.New("from must be on or before to")
+	}
+	return period, nil
+}
+
+// parseTimeOfDay parses the optional time_from/time_to query parameters into a range of clock
+// times. A range ending before it starts wraps past midnight.
+func parseTimeOfDay(c echo.Context) (*repository.TimeOfDayRange, error) {
+	fromStr, toStr := c.QueryParam("time_from"), c.QueryParam("time_to")
+	if fromStr == "" && toStr == "" {
+		return nil, nil
+	}
+	if fromStr == "" || toStr == "" {
+		return nil, errors.New("time_from and time_to must be given together")
+	}
+
+	from, err := time.Parse("15:04", fromStr)
+	if err != nil {
+		return nil, errors.New("Invalid time_from, expected HH:MM")
+	}
+	to, err := time.Parse("15:04", toStr)
+	if err != nil {
+		return nil, errors.New("Invalid time_to, expected HH:MM")
+	}
+	if from.Equal(to) {
+		return nil, errors.New("time_from and time_to must differ")
+	}
+
+	return &repository.TimeOfDayRange{From: from.Format("15:04"), To: to.Format("15:04")}, nil
+}
+
 // handleBudgetError converts AppError to HTTP response
 func handleBudgetError(c echo.Context, err error) error {
 	if appErr, ok := err.(*apperrors.AppError); ok {
-		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, appErr.Details))
 	}
 	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
 }
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// BudgetLineHandler handles HTTP requests for the budget line items of projects
+type BudgetLineHandler struct {
+	budgetLineService *service.BudgetLineService
+}
+
+// NewBudgetLineHandler creates a new BudgetLineHandler
+func NewBudgetLineHandler(budgetLineService *service.BudgetLineService) *BudgetLineHandler {
+	return &BudgetLineHandler{budgetLineService: budgetLineService}
+}
+
+// CreateBudgetLine handles POST /api/v1/projects/:id/budget-lines
+func (h *BudgetLineHandler) CreateBudgetLine(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	var req dto.CreateBudgetLineRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	line, err := h.budgetLineService.CreateBudgetLine(projectID, &req)
+	if err != nil {
+		return handleBudgetLineError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(line))
+}
+
+// ListBudgetLines handles GET /api/v1/projects/:id/budget-lines
+func (h *BudgetLineHandler) ListBudgetLines(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	lines, err := h.budgetLineService.ListBudgetLines(projectID)
+	if err != nil {
+		return handleBudgetLineError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(lines))
+}
+
+// GetBudgetLine handles GET /api/v1/projects/:id/budget-lines/:lineId
+func (h *BudgetLineHandler) GetBudgetLine(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	lineID, err := uuid.Parse(c.Param("lineId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid budget line ID", nil))
+	}
+
+	line, err := h.budgetLineService.GetBudgetLine(projectID, lineID)
+	if err != nil {
+		return handleBudgetLineError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(line))
+}
+
+// UpdateBudgetLine handles PUT /api/v1/projects/:id/budget-lines/:lineId
+func (h *BudgetLineHandler) UpdateBudgetLine(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	lineID, err := uuid.Parse(c.Param("lineId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid budget line ID", nil))
+	}
+
+	var req dto.UpdateBudgetLineRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	line, err := h.budgetLineService.UpdateBudgetLine(projectID, lineID, &req)
+	if err != nil {
+		return handleBudgetLineError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(line))
+}
+
+// DeleteBudgetLine handles DELETE /api/v1/projects/:id/budget-lines/:lineId
+func (h *BudgetLineHandler) DeleteBudgetLine(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	lineID, err := uuid.Parse(c.Param("lineId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid budget line ID", nil))
+	}
+
+	if err := h.budgetLineService.DeleteBudgetLine(projectID, lineID); err != nil {
+		return handleBudgetLineError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Budget line deleted successfully"}))
+}
+
+// handleBudgetLineError converts AppError to HTTP response
+func handleBudgetLineError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// ChangeOrderHandler handles HTTP requests for the change orders of projects
+type ChangeOrderHandler struct {
+	changeOrderService *service.ChangeOrderService
+}
+
+// NewChangeOrderHandler creates a new ChangeOrderHandler
+func NewChangeOrderHandler(changeOrderService *service.ChangeOrderService) *ChangeOrderHandler {
+	return &ChangeOrderHandler{changeOrderService: changeOrderService}
+}
+
+// CreateChangeOrder handles POST /api/v1/projects/:id/change-orders
+func (h *ChangeOrderHandler) CreateChangeOrder(c echo.Context) error {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	var req dto.CreateChangeOrderRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	order, err := h.changeOrderService.CreateChangeOrder(projectID, userID, &req)
+	if err != nil {
+		return handleChangeOrderError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(order))
+}
+
+// ListChangeOrders handles GET /api/v1/projects/:id/change-orders
+func (h *ChangeOrderHandler) ListChangeOrders(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	orders, err := h.changeOrderService.ListChangeOrders(projectID, c.QueryParam("status"))
+	if err != nil {
+		return handleChangeOrderError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(orders))
+}
+
+// GetChangeOrder handles GET /api/v1/projects/:id/change-orders/:orderId
+func (h *ChangeOrderHandler) GetChangeOrder(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	orderID, err := uuid.Parse(c.Param("orderId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid change order ID", nil))
+	}
+
+	order, err := h.changeOrderService.GetChangeOrder(projectID, orderID)
+	if err != nil {
+		return handleChangeOrderError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(order))
+}
+
+// ApproveChangeOrder handles POST /api/v1/projects/:id/change-orders/:orderId/approve
+func (h *ChangeOrderHandler) ApproveChangeOrder(c echo.Context) error {
+	return h.reviewChangeOrder(c, h.changeOrderService.ApproveChangeOrder)
+}
+
+// RejectChangeOrder handles POST /api/v1/projects/:id/change-orders/:orderId/reject
+func (h *ChangeOrderHandler) RejectChangeOrder(c echo.Context) error {
+	return h.reviewChangeOrder(c, h.changeOrderService.RejectChangeOrder)
+}
+
+// reviewChangeOrder records the decision of the current user on a change order
+func (h *ChangeOrderHandler) reviewChangeOrder(c echo.Context, review func(projectID, orderID, reviewerID uuid.UUID, req *dto.ReviewChangeOrderRequest) (*dto.ChangeOrderResponse, error)) error {
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	orderID, err := uuid.Parse(c.Param("orderId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid change order ID", nil))
+	}
+
+	var req dto.ReviewChangeOrderRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	order, err := review(projectID, orderID, reviewerID, &req)
+	if err != nil {
+		return handleChangeOrderError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(order))
+}
+
+// currentUserID returns the ID of the authenticated user
+func currentUserID(c echo.Context) (uuid.UUID, bool) {
+	userIDStr, ok := c.Get("user_id").(string)
+	if !ok {
+		return uuid.Nil, false
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// handleChangeOrderError converts AppError to HTTP response
+func handleChangeOrderError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
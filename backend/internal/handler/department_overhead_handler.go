@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// DepartmentOverheadHandler handles HTTP requests for the overhead multipliers of departments
+type DepartmentOverheadHandler struct {
+	departmentOverheadService *service.DepartmentOverheadService
+}
+
+// NewDepartmentOverheadHandler creates a new DepartmentOverheadHandler
+func NewDepartmentOverheadHandler(departmentOverheadService *service.DepartmentOverheadService) *DepartmentOverheadHandler {
+	return &DepartmentOverheadHandler{departmentOverheadService: departmentOverheadService}
+}
+
+// CreateDepartmentOverhead handles POST /api/v1/department-overheads
+func (h *DepartmentOverheadHandler) CreateDepartmentOverhead(c echo.Context) error {
+	var req dto.CreateDepartmentOverheadRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	overhead, err := h.departmentOverheadService.CreateDepartmentOverhead(&req)
+	if err != nil {
+		return handleDepartmentOverheadError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(overhead))
+}
+
+// ListDepartmentOverheads handles GET /api/v1/department-overheads
+func (h *DepartmentOverheadHandler) ListDepartmentOverheads(c echo.Context) error {
+	var department *string
+	if d := c.QueryParam("department"); d != "" {
+		department = &d
+	}
+
+	overheads, err := h.departmentOverheadService.ListDepartmentOverheads(department)
+	if err != nil {
+		return handleDepartmentOverheadError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(overheads))
+}
+
+// UpdateDepartmentOverhead handles PUT /api/v1/department-overheads/:id
+func (h *DepartmentOverheadHandler) UpdateDepartmentOverhead(c echo.Context) error {
+	overheadID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid department overhead ID", nil))
+	}
+
+	var req dto.UpdateDepartmentOverheadRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	overhead, err := h.departmentOverheadService.UpdateDepartmentOverhead(overheadID, &req)
+	if err != nil {
+		return handleDepartmentOverheadError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(overhead))
+}
+
+// DeleteDepartmentOverhead handles DELETE /api/v1/department-overheads/:id
+func (h *DepartmentOverheadHandler) DeleteDepartmentOverhead(c echo.Context) error {
+	overheadID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid department overhead ID", nil))
+	}
+
+	if err := h.departmentOverheadService.DeleteDepartmentOverhead(overheadID); err != nil {
+		return handleDepartmentOverheadError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Department overhead deleted successfully"}))
+}
+
+// handleDepartmentOverheadError converts AppError to HTTP response
+func handleDepartmentOverheadError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
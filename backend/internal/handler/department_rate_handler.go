@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// DepartmentRateHandler handles HTTP requests for the default rates of departments
+type DepartmentRateHandler struct {
+	departmentRateService *service.DepartmentRateService
+}
+
+// NewDepartmentRateHandler creates a new DepartmentRateHandler
+func NewDepartmentRateHandler(departmentRateService *service.DepartmentRateService) *DepartmentRateHandler {
+	return &DepartmentRateHandler{departmentRateService: departmentRateService}
+}
+
+// CreateDepartmentRate handles POST /api/v1/department-rates
+func (h *DepartmentRateHandler) CreateDepartmentRate(c echo.Context) error {
+	var req dto.CreateDepartmentRateRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	rate, err := h.departmentRateService.CreateDepartmentRate(&req)
+	if err != nil {
+		return handleDepartmentRateError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(rate))
+}
+
+// ListDepartmentRates handles GET /api/v1/department-rates
+func (h *DepartmentRateHandler) ListDepartmentRates(c echo.Context) error {
+	rates, err := h.departmentRateService.ListDepartmentRates()
+	if err != nil {
+		return handleDepartmentRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(rates))
+}
+
+// UpdateDepartmentRate handles PUT /api/v1/department-rates/:id
+func (h *DepartmentRateHandler) UpdateDepartmentRate(c echo.Context) error {
+	rateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid department rate ID", nil))
+	}
+
+	var req dto.UpdateDepartmentRateRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	rate, err := h.departmentRateService.UpdateDepartmentRate(rateID, &req)
+	if err != nil {
+		return handleDepartmentRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(rate))
+}
+
+// DeleteDepartmentRate handles DELETE /api/v1/department-rates/:id
+func (h *DepartmentRateHandler) DeleteDepartmentRate(c echo.Context) error {
+	rateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid department rate ID", nil))
+	}
+
+	if err := h.departmentRateService.DeleteDepartmentRate(rateID); err != nil {
+		return handleDepartmentRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Department rate deleted successfully"}))
+}
+
+// handleDepartmentRateError converts AppError to HTTP response
+func handleDepartmentRateError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// EVMHandler handles HTTP requests for earned value management metrics
+type EVMHandler struct {
+	evmService *service.EVMService
+}
+
+// NewEVMHandler creates a new EVMHandler
+func NewEVMHandler(evmService *service.EVMService) *EVMHandler {
+	return &EVMHandler{evmService: evmService}
+}
+
+// GetEVM handles GET /api/v1/projects/:id/evm
+func (h *EVMHandler) GetEVM(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	asOf := time.Now()
+	if asOfStr := c.QueryParam("as_of"); asOfStr != "" {
+		asOf, err = time.Parse("2006-01-02", asOfStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid as_of date", nil))
+		}
+	}
+
+	evm, err := h.evmService.GetEVM(projectID, asOf)
+	if err != nil {
+		return handleEVMError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(evm))
+}
+
+// handleEVMError converts AppError to HTTP response
+func handleEVMError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
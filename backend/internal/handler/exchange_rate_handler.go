@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// ExchangeRateHandler handles HTTP requests for exchange rates
+type ExchangeRateHandler struct {
+	exchangeRateService *service.ExchangeRateService
+}
+
+// NewExchangeRateHandler creates a new ExchangeRateHandler
+func NewExchangeRateHandler(exchangeRateService *service.ExchangeRateService) *ExchangeRateHandler {
+	return &ExchangeRateHandler{exchangeRateService: exchangeRateService}
+}
+
+// CreateExchangeRate handles POST /api/v1/exchange-rates
+func (h *ExchangeRateHandler) CreateExchangeRate(c echo.Context) error {
+	var req dto.CreateExchangeRateRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	rate, err := h.exchangeRateService.CreateExchangeRate(&req)
+	if err != nil {
+		return handleExchangeRateError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(rate))
+}
+
+// ImportExchangeRates handles POST /api/v1/exchange-rates/import
+func (h *ExchangeRateHandler) ImportExchangeRates(c echo.Context) error {
+	var req dto.ImportExchangeRatesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	result, err := h.exchangeRateService.ImportExchangeRates(&req)
+	if err != nil {
+		return handleExchangeRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(result))
+}
+
+// ListExchangeRates handles GET /api/v1/exchange-rates
+func (h *ExchangeRateHandler) ListExchangeRates(c echo.Context) error {
+	// Parse pagination params
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(c.QueryParam("per_page"))
+	if perPage < 1 {
+		perPage = 20
+	}
+
+	params := repository.ExchangeRateListParams{
+		FromCurrency: c.QueryParam("from"),
+		ToCurrency:   c.QueryParam("to"),
+		Page:         page,
+		PerPage:      perPage,
+	}
+
+	// Parse optional filters
+	if startDateStr := c.QueryParam("start_date"); startDateStr != "" {
+		if startDate, err := time.Parse("2006-01-02", startDateStr); err == nil {
+			params.StartDate = &startDate
+		}
+	}
+
+	if endDateStr := c.QueryParam("end_date"); endDateStr != "" {
+		if endDate, err := time.Parse("2006-01-02", endDateStr); err == nil {
+			params.EndDate = &endDate
+		}
+	}
+
+	rates, err := h.exchangeRateService.ListExchangeRates(params)
+	if err != nil {
+		return handleExchangeRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(rates))
+}
+
+// GetExchangeRate handles GET /api/v1/exchange-rates/:id
+func (h *ExchangeRateHandler) GetExchangeRate(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid exchange rate ID", nil))
+	}
+
+	rate, err := h.exchangeRateService.GetExchangeRate(id)
+	if err != nil {
+		return handleExchangeRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(rate))
+}
+
+// UpdateExchangeRate handles PUT /api/v1/exchange-rates/:id
+func (h *ExchangeRateHandler) UpdateExchangeRate(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid exchange rate ID", nil))
+	}
+
+	var req dto.UpdateExchangeRateRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	rate, err := h.exchangeRateService.UpdateExchangeRate(id, &req)
+	if err != nil {
+		return handleExchangeRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(rate))
+}
+
+// DeleteExchangeRate handles DELETE /api/v1/exchange-rates/:id
+func (h *ExchangeRateHandler) DeleteExchangeRate(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid exchange rate ID", nil))
+	}
+
+	if err := h.exchangeRateService.DeleteExchangeRate(id); err != nil {
+		return handleExchangeRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Exchange rate deleted successfully"}))
+}
+
+// handleExchangeRateError converts AppError to HTTP response
+func handleExchangeRateError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
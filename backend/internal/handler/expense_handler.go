@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// ExpenseHandler handles HTTP requests for project expenses
+type ExpenseHandler struct {
+	expenseService *service.ExpenseService
+}
+
+// NewExpenseHandler creates a new ExpenseHandler
+func NewExpenseHandler(expenseService *service.ExpenseService) *ExpenseHandler {
+	return &ExpenseHandler{expenseService: expenseService}
+}
+
+// CreateExpense handles POST /api/v1/projects/:id/expenses
+func (h *ExpenseHandler) CreateExpense(c echo.Context) error {
+	// Get user ID from context
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	var req dto.CreateExpenseRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	expense, err := h.expenseService.CreateExpense(projectID, userID, &req)
+	if err != nil {
+		return handleExpenseError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(expense))
+}
+
+// ListExpenses handles GET /api/v1/projects/:id/expenses
+func (h *ExpenseHandler) ListExpenses(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	// Parse pagination params
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(c.QueryParam("per_page"))
+	if perPage < 1 {
+		perPage = 20
+	}
+
+	params := repository.ExpenseListParams{
+		ProjectID: projectID,
+		Category:  c.QueryParam("category"),
+		Page:      page,
+		PerPage:   perPage,
+	}
+
+	// Parse optional filters
+	if startDateStr := c.QueryParam("start_date"); startDateStr != "" {
+		if startDate, err := time.Parse("2006-01-02", startDateStr); err == nil {
+			params.StartDate = &startDate
+		}
+	}
+
+	if endDateStr := c.QueryParam("end_date"); endDateStr != "" {
+		if endDate, err := time.Parse("2006-01-02", endDateStr); err == nil {
+			params.EndDate = &endDate
+		}
+	}
+
+	expenses, err := h.expenseService.ListExpenses(params)
+	if err != nil {
+		return handleExpenseError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(expenses))
+}
+
+// GetExpense handles GET /api/v1/projects/:id/expenses/:expenseId
+func (h *ExpenseHandler) GetExpense(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	expenseID, err := uuid.Parse(c.Param("expenseId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid expense ID", nil))
+	}
+
+	expense, err := h.expenseService.GetExpense(projectID, expenseID)
+	if err != nil {
+		return handleExpenseError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(expense))
+}
+
+// UpdateExpense handles PUT /api/v1/projects/:id/expenses/:expenseId
+func (h *ExpenseHandler) UpdateExpense(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	expenseID, err := uuid.Parse(c.Param("expenseId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid expense ID", nil))
+	}
+
+	var req dto.UpdateExpenseRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	expense, err := h.expenseService.UpdateExpense(projectID, expenseID, &req)
+	if err != nil {
+		return handleExpenseError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(expense))
+}
+
+// DeleteExpense handles DELETE /api/v1/projects/:id/expenses/:expenseId
+func (h *ExpenseHandler) DeleteExpense(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	expenseID, err := uuid.Parse(c.Param("expenseId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid expense ID", nil))
+	}
+
+	if err := h.expenseService.DeleteExpense(projectID, expenseID); err != nil {
+		return handleExpenseError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Expense deleted successfully"}))
+}
+
+// handleExpenseError converts AppError to HTTP response
+func handleExpenseError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
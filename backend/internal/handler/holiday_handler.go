@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// HolidayHandler handles HTTP requests for the holiday calendar
+type HolidayHandler struct {
+	holidayService *service.HolidayService
+}
+
+// NewHolidayHandler creates a new HolidayHandler
+func NewHolidayHandler(holidayService *service.HolidayService) *HolidayHandler {
+	return &HolidayHandler{holidayService: holidayService}
+}
+
+// CreateHoliday handles POST /api/v1/holidays
+func (h *HolidayHandler) CreateHoliday(c echo.Context) error {
+	var req dto.CreateHolidayRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	holiday, err := h.holidayService.CreateHoliday(&req)
+	if err != nil {
+		return handleHolidayError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(holiday))
+}
+
+// ListHolidays handles GET /api/v1/holidays
+func (h *HolidayHandler) ListHolidays(c echo.Context) error {
+	period, err := parsePeriod(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", err.Error(), nil))
+	}
+
+	holidays, err := h.holidayService.ListHolidays(period)
+	if err != nil {
+		return handleHolidayError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(holidays))
+}
+
+// DeleteHoliday handles DELETE /api/v1/holidays/:id
+func (h *HolidayHandler) DeleteHoliday(c echo.Context) error {
+	holidayID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid holiday ID", nil))
+	}
+
+	if err := h.holidayService.DeleteHoliday(holidayID); err != nil {
+		return handleHolidayError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Holiday deleted successfully"}))
+}
+
+// handleHolidayError converts AppError to HTTP response
+func handleHolidayError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
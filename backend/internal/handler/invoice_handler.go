@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// InvoiceHandler handles HTTP requests for project invoices
+type InvoiceHandler struct {
+	invoiceService *service.InvoiceService
+}
+
+// NewInvoiceHandler creates a new InvoiceHandler
+func NewInvoiceHandler(invoiceService *service.InvoiceService) *InvoiceHandler {
+	return &InvoiceHandler{invoiceService: invoiceService}
+}
+
+// CreateInvoice handles POST /api/v1/projects/:id/invoices
+func (h *InvoiceHandler) CreateInvoice(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	var req dto.CreateInvoiceRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	invoice, err := h.invoiceService.CreateInvoice(projectID, &req)
+	if err != nil {
+		return handleInvoiceError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(invoice))
+}
+
+// ListInvoices handles GET /api/v1/projects/:id/invoices
+func (h *InvoiceHandler) ListInvoices(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	status := c.QueryParam("status")
+	switch status {
+	case "", models.InvoiceStatusDraft, models.InvoiceStatusIssued, models.InvoiceStatusPaid:
+	default:
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "status must be one of draft, issued, paid", nil))
+	}
+
+	// Parse pagination params
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(c.QueryParam("per_page"))
+	if perPage < 1 {
+		perPage = 20
+	}
+
+	invoices, err := h.invoiceService.ListInvoices(repository.InvoiceListParams{
+		ProjectID: projectID,
+		Status:    status,
+		Page:      page,
+		PerPage:   perPage,
+	})
+	if err != nil {
+		return handleInvoiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(invoices))
+}
+
+// GetInvoice handles GET /api/v1/projects/:id/invoices/:invoiceId
+func (h *InvoiceHandler) GetInvoice(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("invoiceId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid invoice ID", nil))
+	}
+
+	invoice, err := h.invoiceService.GetInvoice(projectID, invoiceID)
+	if err != nil {
+		return handleInvoiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(invoice))
+}
+
+// IssueInvoice handles PUT /api/v1/projects/:id/invoices/:invoiceId/issue
+func (h *InvoiceHandler) IssueInvoice(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("invoiceId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid invoice ID", nil))
+	}
+
+	invoice, err := h.invoiceService.IssueInvoice(projectID, invoiceID)
+	if err != nil {
+		return handleInvoiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(invoice))
+}
+
+// PayInvoice handles PUT /api/v1/projects/:id/invoices/:invoiceId/pay
+func (h *InvoiceHandler) PayInvoice(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("invoiceId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid invoice ID", nil))
+	}
+
+	invoice, err := h.invoiceService.PayInvoice(projectID, invoiceID)
+	if err != nil {
+		return handleInvoiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(invoice))
+}
+
+// DeleteInvoice handles DELETE /api/v1/projects/:id/invoices/:invoiceId
+func (h *InvoiceHandler) DeleteInvoice(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	invoiceID, err := uuid.Parse(c.Param("invoiceId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid invoice ID", nil))
+	}
+
+	if err := h.invoiceService.DeleteInvoice(projectID, invoiceID); err != nil {
+		return handleInvoiceError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Invoice deleted successfully"}))
+}
+
+// handleInvoiceError converts AppError to HTTP response
+func handleInvoiceError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// MemberRateHandler handles HTTP requests for the hourly rate history of members
+type MemberRateHandler struct {
+	memberRateService *service.MemberRateService
+}
+
+// NewMemberRateHandler creates a new MemberRateHandler
+func NewMemberRateHandler(memberRateService *service.MemberRateService) *MemberRateHandler {
+	return &MemberRateHandler{memberRateService: memberRateService}
+}
+
+// ListMemberRates handles GET /api/v1/members/:id/rates
+func (h *MemberRateHandler) ListMemberRates(c echo.Context) error {
+	memberID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid member ID", nil))
+	}
+
+	rates, err := h.memberRateService.ListMemberRates(memberID)
+	if err != nil {
+		return handleMemberRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(rates))
+}
+
+// CreateMemberRate handles POST /api/v1/members/:id/rates
+func (h *MemberRateHandler) CreateMemberRate(c echo.Context) error {
+	memberID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid member ID", nil))
+	}
+
+	var req dto.CreateMemberRateRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	rate, err := h.memberRateService.CreateMemberRate(memberID, &req)
+	if err != nil {
+		return handleMemberRateError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(rate))
+}
+
+// RecomputeRateSnapshots handles POST /api/v1/admin/time-entries/recompute-rates
+func (h *MemberRateHandler) RecomputeRateSnapshots(c echo.Context) error {
+	var req dto.RecomputeRatesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	result, err := h.memberRateService.RecomputeRateSnapshots(&req)
+	if err != nil {
+		return handleMemberRateError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(result))
+}
+
+// handleMemberRateError converts AppError to HTTP response
+func handleMemberRateError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
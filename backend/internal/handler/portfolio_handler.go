@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// portfolioStatuses are the project statuses accepted by the status filter
+var portfolioStatuses = map[string]bool{
+	"planning":    true,
+	"in_progress": true,
+	"completed":   true,
+	"on_hold":     true,
+}
+
+// currencyCodePattern matches ISO 4217 currency codes
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// PortfolioHandler handles HTTP requests for the portfolio of an owner's projects
+type PortfolioHandler struct {
+	portfolioService *service.PortfolioService
+}
+
+// NewPortfolioHandler creates a new PortfolioHandler
+func NewPortfolioHandler(portfolioService *service.PortfolioService) *PortfolioHandler {
+	return &PortfolioHandler{portfolioService: portfolioService}
+}
+
+// GetPortfolioSummary handles GET /api/v1/portfolio/summary
+// The portfolio is the current user's unless an admin asks for another owner with owner_id.
+// Projects can be narrowed down by a comma-separated status list and a from/to schedule period,
+// and their figures converted into a reporting_currency.
+func (h *PortfolioHandler) GetPortfolioSummary(c echo.Context) error {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	params := repository.PortfolioParams{OwnerID: userID}
+	if ownerIDStr := c.QueryParam("owner_id"); ownerIDStr != "" {
+		ownerID, err := uuid.Parse(ownerIDStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid owner ID", nil))
+		}
+		if ownerID != userID && c.Get("role") != "admin" {
+			return handlePortfolioError(c, apperrors.ErrForbidden())
+		}
+		params.OwnerID = ownerID
+	}
+
+	if statusStr := c.QueryParam("status"); statusStr != "" {
+		for _, status := range strings.Split(statusStr, ",") {
+			status = strings.TrimSpace(status)
+			if !portfolioStatuses[status] {
+				return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid status: "+status, nil))
+			}
+			params.Statuses = append(params.Statuses, status)
+		}
+	}
+
+	var err error
+	params.Period, err = parsePeriod(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", err.Error(), nil))
+	}
+
+	// Figures in other currencies can be converted into a reporting currency
+	reportingCurrency := c.QueryParam("reporting_currency")
+	if reportingCurrency != "" && !currencyCodePattern.MatchString(reportingCurrency) {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid reporting currency", nil))
+	}
+
+	summary, err := h.portfolioService.GetPortfolioSummary(params, reportingCurrency)
+	if err != nil {
+		return handlePortfolioError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(summary))
+}
+
+// handlePortfolioError converts AppError to HTTP response
+func handlePortfolioError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// RevenueItemHandler handles HTTP requests for the revenue items of projects
+type RevenueItemHandler struct {
+	revenueItemService *service.RevenueItemService
+}
+
+// NewRevenueItemHandler creates a new RevenueItemHandler
+func NewRevenueItemHandler(revenueItemService *service.RevenueItemService) *RevenueItemHandler {
+	return &RevenueItemHandler{revenueItemService: revenueItemService}
+}
+
+// CreateRevenueItem handles POST /api/v1/projects/:id/revenue-items
+func (h *RevenueItemHandler) CreateRevenueItem(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	var req dto.CreateRevenueItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	item, err := h.revenueItemService.CreateRevenueItem(projectID, &req)
+	if err != nil {
+		return handleRevenueItemError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(item))
+}
+
+// ListRevenueItems handles GET /api/v1/projects/:id/revenue-items
+func (h *RevenueItemHandler) ListRevenueItems(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	status := c.QueryParam("status")
+	switch status {
+	case "", models.RevenueStatusPlanned, models.RevenueStatusInvoiced, models.RevenueStatusReceived:
+	default:
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "status must be one of planned, invoiced, received", nil))
+	}
+
+	items, err := h.revenueItemService.ListRevenueItems(projectID, status)
+	if err != nil {
+		return handleRevenueItemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(items))
+}
+
+// GetRevenueItem handles GET /api/v1/projects/:id/revenue-items/:itemId
+func (h *RevenueItemHandler) GetRevenueItem(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	itemID, err := uuid.Parse(c.Param("itemId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid revenue item ID", nil))
+	}
+
+	item, err := h.revenueItemService.GetRevenueItem(projectID, itemID)
+	if err != nil {
+		return handleRevenueItemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(item))
+}
+
+// UpdateRevenueItem handles PUT /api/v1/projects/:id/revenue-items/:itemId
+func (h *RevenueItemHandler) UpdateRevenueItem(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	itemID, err := uuid.Parse(c.Param("itemId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid revenue item ID", nil))
+	}
+
+	var req dto.UpdateRevenueItemRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	item, err := h.revenueItemService.UpdateRevenueItem(projectID, itemID, &req)
+	if err != nil {
+		return handleRevenueItemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(item))
+}
+
+// DeleteRevenueItem handles DELETE /api/v1/projects/:id/revenue-items/:itemId
+func (h *RevenueItemHandler) DeleteRevenueItem(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid project ID", nil))
+	}
+
+	itemID, err := uuid.Parse(c.Param("itemId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid revenue item ID", nil))
+	}
+
+	if err := h.revenueItemService.DeleteRevenueItem(projectID, itemID); err != nil {
+		return handleRevenueItemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(map[string]string{"message": "Revenue item deleted successfully"}))
+}
+
+// handleRevenueItemError converts AppError to HTTP response
+func handleRevenueItemError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
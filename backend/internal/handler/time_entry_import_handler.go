@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// TimeEntryImportHandler handles HTTP requests for importing time entries from CSV
+type TimeEntryImportHandler struct {
+	importService *service.TimeEntryImportService
+}
+
+// NewTimeEntryImportHandler creates a new TimeEntryImportHandler
+func NewTimeEntryImportHandler(importService *service.TimeEntryImportService) *TimeEntryImportHandler {
+	return &TimeEntryImportHandler{importService: importService}
+}
+
+// ImportTimeEntries handles POST /api/v1/time-entries/import. The CSV is sent either as the
+// "file" field of a multipart form or as the request body. With dry_run=true the rows are only checked.
+func (h *TimeEntryImportHandler) ImportTimeEntries(c echo.Context) error {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	dryRun := false
+	if dryRunStr := c.QueryParam("dry_run"); dryRunStr != "" {
+		parsed, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid dry_run", nil))
+		}
+		dryRun = parsed
+	}
+
+	var body io.Reader = c.Request().Body
+	if fileHeader, err := c.FormFile("file"); err == nil {
+		file, err := fileHeader.Open()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid CSV file", nil))
+		}
+		defer file.Close()
+		body = file
+	}
+
+	report, err := h.importService.ImportTimeEntries(userID, body, dryRun)
+	if err != nil {
+		return handleTimeEntryImportError(c, err)
+	}
+
+	// Nothing is imported while any row has errors
+	if !dryRun && len(report.Errors) > 0 {
+		message := fmt.Sprintf("%d errors in the CSV file; no time entries were imported", len(report.Errors))
+		return c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse("IMPORT_FAILED", message, report))
+	}
+
+	status := http.StatusCreated
+	if dryRun {
+		status = http.StatusOK
+	}
+	return c.JSON(status, dto.SuccessResponse(report))
+}
+
+// handleTimeEntryImportError converts AppError to HTTP response
+func handleTimeEntryImportError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// TimerHandler handles HTTP requests for the timers of members
+type TimerHandler struct {
+	timerService *service.TimerService
+}
+
+// NewTimerHandler creates a new TimerHandler
+func NewTimerHandler(timerService *service.TimerService) *TimerHandler {
+	return &TimerHandler{timerService: timerService}
+}
+
+// StartTimer handles POST /api/v1/timers/start
+func (h *TimerHandler) StartTimer(c echo.Context) error {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	var req dto.StartTimerRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	timer, err := h.timerService.StartTimer(userID, &req)
+	if err != nil {
+		return handleTimerError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.SuccessResponse(timer))
+}
+
+// StopTimer handles POST /api/v1/timers/stop
+func (h *TimerHandler) StopTimer(c echo.Context) error {
+	var req dto.StopTimerRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("VALIDATION_FAILED", err.Error(), nil))
+	}
+
+	timer, err := h.timerService.StopTimer(&req)
+	if err != nil {
+		return handleTimerError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(timer))
+}
+
+// ListRunningTimers handles GET /api/v1/timers
+func (h *TimerHandler) ListRunningTimers(c echo.Context) error {
+	var memberID *uuid.UUID
+	if memberIDStr := c.QueryParam("member_id"); memberIDStr != "" {
+		id, err := uuid.Parse(memberIDStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid member ID", nil))
+		}
+		memberID = &id
+	}
+
+	timers, err := h.timerService.ListRunningTimers(memberID)
+	if err != nil {
+		return handleTimerError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(timers))
+}
+
+// handleTimerError converts AppError to HTTP response
+func handleTimerError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, appErr.Details))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// TimesheetHandler handles HTTP requests for the weekly timesheets of members
+type TimesheetHandler struct {
+	timesheetService *service.TimesheetService
+}
+
+// NewTimesheetHandler creates a new TimesheetHandler
+func NewTimesheetHandler(timesheetService *service.TimesheetService) *TimesheetHandler {
+	return &TimesheetHandler{timesheetService: timesheetService}
+}
+
+// ListTimesheets handles GET /api/v1/timesheets
+func (h *TimesheetHandler) ListTimesheets(c echo.Context) error {
+	var memberID *uuid.UUID
+	if memberIDStr := c.QueryParam("member_id"); memberIDStr != "" {
+		id, err := uuid.Parse(memberIDStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid member ID", nil))
+		}
+		memberID = &id
+	}
+
+	timesheets, err := h.timesheetService.ListTimesheets(memberID, c.QueryParam("status"), c.QueryParam("week"))
+	if err != nil {
+		return handleTimesheetError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(timesheets))
+}
+
+// GetTimesheet handles GET /api/v1/members/:id/timesheets/:week
+func (h *TimesheetHandler) GetTimesheet(c echo.Context) error {
+	memberID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid member ID", nil))
+	}
+
+	timesheet, err := h.timesheetService.GetTimesheet(memberID, c.Param("week"))
+	if err != nil {
+		return handleTimesheetError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(timesheet))
+}
+
+// SubmitTimesheet handles POST /api/v1/members/:id/timesheets/:week/submit
+func (h *TimesheetHandler) SubmitTimesheet(c echo.Context) error {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	memberID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid member ID", nil))
+	}
+
+	timesheet, err := h.timesheetService.SubmitTimesheet(memberID, c.Param("week"), userID)
+	if err != nil {
+		return handleTimesheetError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(timesheet))
+}
+
+// ApproveTimesheet handles POST /api/v1/members/:id/timesheets/:week/approve
+func (h *TimesheetHandler) ApproveTimesheet(c echo.Context) error {
+	return h.reviewTimesheet(c, h.timesheetService.ApproveTimesheet)
+}
+
+// RejectTimesheet handles POST /api/v1/members/:id/timesheets/:week/reject
+func (h *TimesheetHandler) RejectTimesheet(c echo.Context) error {
+	return h.reviewTimesheet(c, h.timesheetService.RejectTimesheet)
+}
+
+// reviewTimesheet records the decision of the current user on a timesheet
+func (h *TimesheetHandler) reviewTimesheet(c echo.Context, review func(memberID uuid.UUID, week string, reviewerID uuid.UUID, req *dto.ReviewTimesheetRequest) (*dto.TimesheetResponse, error)) error {
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse("UNAUTHORIZED", "User not authenticated", nil))
+	}
+
+	memberID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_ID", "Invalid member ID", nil))
+	}
+
+	var req dto.ReviewTimesheetRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse("INVALID_REQUEST", "Invalid request body", nil))
+	}
+
+	timesheet, err := review(memberID, c.Param("week"), reviewerID, &req)
+	if err != nil {
+		return handleTimesheetError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.SuccessResponse(timesheet))
+}
+
+// handleTimesheetError converts AppError to HTTP response
+func handleTimesheetError(c echo.Context, err error) error {
+	if appErr, ok := err.(*apperrors.AppError); ok {
+		return c.JSON(appErr.StatusCode, dto.ErrorResponse(appErr.Code, appErr.Message, nil))
+	}
+	return c.JSON(http.StatusInternalServerError, dto.ErrorResponse("INTERNAL_ERROR", "An internal error occurred", nil))
+}
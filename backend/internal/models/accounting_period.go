@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Accounting period statuses
+const (
+	AccountingPeriodStatusOpen   = "open"
+	AccountingPeriodStatusClosed = "closed"
+)
+
+// AccountingPeriod is a month of the books, identified by its first day. Time entries, expenses
+// and revenue items dated in a closed period can no longer be changed. A month without a record
+// has never been closed and is open.
+type AccountingPeriod struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	PeriodStart time.Time  `gorm:"type:date;not null;uniqueIndex" json:"period_start"`
+	Status      string     `gorm:"type:varchar(20);not null;default:'open'" json:"status"`
+	ClosedBy    *uuid.UUID `gorm:"type:uuid" json:"closed_by,omitempty"`
+	ClosedAt    *time.Time `json:"closed_at,omitempty"`
+	ReopenedBy  *uuid.UUID `gorm:"type:uuid" json:"reopened_by,omitempty"`
+	ReopenedAt  *time.Time `json:"reopened_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName specifies table name
+func (AccountingPeriod) TableName() string {
+	return "accounting_periods"
+}
+
+// BeforeCreate hook
+func (ap *AccountingPeriod) BeforeCreate(tx *gorm.DB) error {
+	if ap.ID == uuid.Nil {
+		ap.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsClosed reports whether the period is closed
+func (ap *AccountingPeriod) IsClosed() bool {
+	return ap.Status == AccountingPeriodStatusClosed
+}
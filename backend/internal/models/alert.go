@@ -0,0 +1,90 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Alert rule metrics
+const (
+	// AlertMetricCostRatio triggers when the actual cost reaches the threshold (%) of BudgetAmount
+	AlertMetricCostRatio = "cost_ratio"
+	// AlertMetricProfitRate triggers when the profit rate (%) falls below the threshold
+	AlertMetricProfitRate = "profit_rate"
+	// AlertMetricWeeklyBurnRate triggers when the weekly burn rate exceeds the threshold amount
+	AlertMetricWeeklyBurnRate = "weekly_burn_rate"
+)
+
+// Alert statuses
+const (
+	AlertStatusOpen         = "open"
+	AlertStatusAcknowledged = "acknowledged"
+	AlertStatusResolved     = "resolved"
+)
+
+// AlertRule is a per-project condition on a budget metric
+type AlertRule struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID uuid.UUID       `gorm:"type:uuid;not null;index" json:"project_id"`
+	Metric    string          `gorm:"type:varchar(30);not null" json:"metric"`
+	Threshold decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"threshold"`
+	IsEnabled bool            `gorm:"not null" json:"is_enabled"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+
+	// Relations
+	Project Project `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+}
+
+// TableName specifies table name
+func (AlertRule) TableName() string {
+	return "alert_rules"
+}
+
+// BeforeCreate hook
+func (ar *AlertRule) BeforeCreate(tx *gorm.DB) error {
+	if ar.ID == uuid.Nil {
+		ar.ID = uuid.New()
+	}
+	return nil
+}
+
+// Alert is a triggered alert rule. The metric and threshold are copied from the rule
+// so that the alert stays readable after the rule is changed or deleted.
+type Alert struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID      uuid.UUID       `gorm:"type:uuid;not null;index" json:"project_id"`
+	RuleID         *uuid.UUID      `gorm:"type:uuid;index" json:"rule_id,omitempty"`
+	Metric         string          `gorm:"type:varchar(30);not null" json:"metric"`
+	Threshold      decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"threshold"`
+	Value          decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"value"`
+	Currency       string          `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	Message        string          `gorm:"type:text;not null" json:"message"`
+	Status         string          `gorm:"type:varchar(20);not null;default:'open';index" json:"status"`
+	TriggeredAt    time.Time       `gorm:"not null" json:"triggered_at"`
+	AcknowledgedAt *time.Time      `json:"acknowledged_at,omitempty"`
+	ResolvedAt     *time.Time      `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// TableName specifies table name
+func (Alert) TableName() string {
+	return "alerts"
+}
+
+// BeforeCreate hook
+func (a *Alert) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive reports whether the alert has not been resolved yet
+func (a *Alert) IsActive() bool {
+	return a.Status != AlertStatusResolved
+}
@@ -4,19 +4,33 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+)
+
+// Bases of the hours counted in budget summaries
+const (
+	// BudgetHoursBasisAll counts every time entry as soon as it is entered
+	BudgetHoursBasisAll = "all"
+	// BudgetHoursBasisApproved counts only the time entries of approved timesheets
+	BudgetHoursBasisApproved = "approved"
 )
 
 type Budget struct {
-	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	ProjectID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"project_id"`
-	Revenue    float64   `gorm:"type:decimal(15,2);default:0.00" json:"revenue"`
-	TotalCost  float64   `gorm:"type:decimal(15,2);default:0.00" json:"total_cost"`
-	Profit     float64   `gorm:"type:decimal(15,2);default:0.00" json:"profit"`
-	ProfitRate float64   `gorm:"type:decimal(5,2);default:0.00" json:"profit_rate"`
-	Currency   string    `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID                uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID         uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex" json:"project_id"`
+	Revenue           decimal.Decimal `gorm:"type:decimal(15,2);default:0.00" json:"revenue"`
+	RecognizedRevenue decimal.Decimal `gorm:"type:decimal(15,2);default:0.00" json:"recognized_revenue"`
+	PlannedRevenue    decimal.Decimal `gorm:"type:decimal(15,2);default:0.00" json:"planned_revenue"`
+	TotalCost         decimal.Decimal `gorm:"type:decimal(15,2);default:0.00" json:"total_cost"`
+	Profit            decimal.Decimal `gorm:"type:decimal(15,2);default:0.00" json:"profit"`
+	ProfitRate        decimal.Decimal `gorm:"type:decimal(5,2);default:0.00" json:"profit_rate"`
+	Currency          string          `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	HoursBasis        string          `gorm:"type:varchar(20);not null;default:'all'" json:"hours_basis"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
 
 	// Relations
 	Project Project `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
@@ -37,10 +51,10 @@ func (b *Budget) BeforeCreate(tx *gorm.DB) error {
 
 // CalculateProfit calculates profit and profit rate
 func (b *Budget) CalculateProfit() {
-	b.Profit = b.Revenue - b.TotalCost
-	if b.Revenue > 0 {
-		b.ProfitRate = (b.Profit / b.Revenue) * 100
+	b.Profit = b.Revenue.Sub(b.TotalCost)
+	if b.Revenue.IsPositive() {
+		b.ProfitRate = money.RoundRate(b.Profit.Div(b.Revenue).Mul(decimal.NewFromInt(100)))
 	} else {
-		b.ProfitRate = 0
+		b.ProfitRate = decimal.Zero
 	}
 }
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// BudgetLine is a line item of a project budget, such as design or development, with its planned
+// amount in the budget currency. The actual cost of the line is rolled up from the time entries of
+// its linked tasks and the expenses of its linked expense categories.
+type BudgetLine struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID     uuid.UUID       `gorm:"type:uuid;not null;index" json:"project_id"`
+	Name          string          `gorm:"type:varchar(200);not null" json:"name"`
+	Category      string          `gorm:"type:varchar(100);not null;index" json:"category"`
+	PlannedAmount decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0" json:"planned_amount"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+
+	// Relations
+	Project Project          `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	Links   []BudgetLineLink `gorm:"foreignKey:BudgetLineID" json:"links,omitempty"`
+}
+
+// TableName specifies table name
+func (BudgetLine) TableName() string {
+	return "budget_lines"
+}
+
+// BeforeCreate hook
+func (bl *BudgetLine) BeforeCreate(tx *gorm.DB) error {
+	if bl.ID == uuid.Nil {
+		bl.ID = uuid.New()
+	}
+	return nil
+}
+
+// BudgetLineLink links a task or an expense category to a budget line.
+// Each task and expense category of a project is counted in one line at most.
+type BudgetLineLink struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	BudgetLineID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"budget_line_id"`
+	ProjectID       uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:budget_line_links_task_idx;uniqueIndex:budget_line_links_expense_category_idx" json:"project_id"`
+	TaskID          *uuid.UUID `gorm:"type:uuid;uniqueIndex:budget_line_links_task_idx,where:task_id IS NOT NULL" json:"task_id,omitempty"`
+	ExpenseCategory *string    `gorm:"type:varchar(30);uniqueIndex:budget_line_links_expense_category_idx,where:expense_category IS NOT NULL" json:"expense_category,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// TableName specifies table name
+func (BudgetLineLink) TableName() string {
+	return "budget_line_links"
+}
+
+// BeforeCreate hook
+func (bll *BudgetLineLink) BeforeCreate(tx *gorm.DB) error {
+	if bll.ID == uuid.Nil {
+		bll.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Budget snapshot sources
+const (
+	BudgetSnapshotSourceDaily         = "daily"
+	BudgetSnapshotSourceRevenueChange = "revenue_change"
+	BudgetSnapshotSourceChangeOrder   = "change_order"
+)
+
+// BudgetSnapshot records the revenue, cost and profit of a project budget at a point in time.
+// One daily snapshot is kept per project and date, and every revenue change or approved
+// change order adds another.
+type BudgetSnapshot struct {
+	ID           uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID    uuid.UUID       `gorm:"type:uuid;not null;index:budget_snapshots_project_date_idx;uniqueIndex:budget_snapshots_project_date_daily_idx" json:"project_id"`
+	SnapshotDate time.Time       `gorm:"type:date;not null;index:budget_snapshots_project_date_idx;uniqueIndex:budget_snapshots_project_date_daily_idx,where:source = 'daily'" json:"snapshot_date"`
+	Source       string          `gorm:"type:varchar(20);not null;default:'daily'" json:"source"`
+	Revenue      decimal.Decimal `gorm:"type:decimal(15,2);default:0.00" json:"revenue"`
+	TotalCost    decimal.Decimal `gorm:"type:decimal(15,2);default:0.00" json:"total_cost"`
+	Profit       decimal.Decimal `gorm:"type:decimal(15,2);default:0.00" json:"profit"`
+	ProfitRate   decimal.Decimal `gorm:"type:decimal(5,2);default:0.00" json:"profit_rate"`
+	Currency     string          `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// TableName specifies table name
+func (BudgetSnapshot) TableName() string {
+	return "budget_snapshots"
+}
+
+// BeforeCreate hook
+func (bs *BudgetSnapshot) BeforeCreate(tx *gorm.DB) error {
+	if bs.ID == uuid.Nil {
+		bs.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Change order statuses
+const (
+	ChangeOrderStatusSubmitted = "submitted"
+	ChangeOrderStatusApproved  = "approved"
+	ChangeOrderStatusRejected  = "rejected"
+)
+
+// ChangeOrder is a request to revise the budget amount, revenue or end date of a project.
+// The requested values are applied when it is approved, and the values they replaced are kept
+// in the Previous fields so that the baseline of the project can be traced back.
+// Revenue and CostImpact are in the budget currency.
+type ChangeOrder struct {
+	ID                   uuid.UUID        `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID            uuid.UUID        `gorm:"type:uuid;not null;index" json:"project_id"`
+	Title                string           `gorm:"type:varchar(200);not null" json:"title"`
+	Reason               string           `gorm:"type:text;not null" json:"reason"`
+	BudgetAmount         *decimal.Decimal `gorm:"type:decimal(15,2)" json:"budget_amount,omitempty"`
+	Revenue              *decimal.Decimal `gorm:"type:decimal(15,2)" json:"revenue,omitempty"`
+	EndDate              *time.Time       `gorm:"type:date" json:"end_date,omitempty"`
+	CostImpact           decimal.Decimal  `gorm:"type:decimal(15,2);not null;default:0" json:"cost_impact"`
+	Status               string           `gorm:"type:varchar(20);not null;default:'submitted';index" json:"status"`
+	RequestedBy          uuid.UUID        `gorm:"type:uuid;not null" json:"requested_by"`
+	ReviewedBy           *uuid.UUID       `gorm:"type:uuid" json:"reviewed_by,omitempty"`
+	ReviewedAt           *time.Time       `json:"reviewed_at,omitempty"`
+	ReviewComment        *string          `gorm:"type:text" json:"review_comment,omitempty"`
+	PreviousBudgetAmount *decimal.Decimal `gorm:"type:decimal(15,2)" json:"previous_budget_amount,omitempty"`
+	PreviousRevenue      *decimal.Decimal `gorm:"type:decimal(15,2)" json:"previous_revenue,omitempty"`
+	PreviousEndDate      *time.Time       `gorm:"type:date" json:"previous_end_date,omitempty"`
+	CreatedAt            time.Time        `json:"created_at"`
+	UpdatedAt            time.Time        `json:"updated_at"`
+
+	// Relations
+	Project Project `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+}
+
+// TableName specifies table name
+func (ChangeOrder) TableName() string {
+	return "change_orders"
+}
+
+// BeforeCreate hook
+func (co *ChangeOrder) BeforeCreate(tx *gorm.DB) error {
+	if co.ID == uuid.Nil {
+		co.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsReviewed reports whether the change order has been approved or rejected
+func (co *ChangeOrder) IsReviewed() bool {
+	return co.Status != ChangeOrderStatusSubmitted
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// DepartmentOverhead is the overhead multiplier applied to the labor cost of the members of a
+// department from its effective date until the next multiplier of the department takes over
+type DepartmentOverhead struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Department    string          `gorm:"type:varchar(100);not null;uniqueIndex:department_overheads_department_effective_from_idx" json:"department"`
+	Multiplier    decimal.Decimal `gorm:"type:decimal(5,3);not null" json:"multiplier"`
+	EffectiveFrom time.Time       `gorm:"type:date;not null;uniqueIndex:department_overheads_department_effective_from_idx" json:"effective_from"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// TableName specifies table name
+func (DepartmentOverhead) TableName() string {
+	return "department_overheads"
+}
+
+// BeforeCreate hook
+func (do *DepartmentOverhead) BeforeCreate(tx *gorm.DB) error {
+	if do.ID == uuid.Nil {
+		do.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// DepartmentRate is the default hourly cost rate of members of a department
+// who have no rate of their own
+type DepartmentRate struct {
+	ID         uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Department string          `gorm:"type:varchar(100);not null;uniqueIndex" json:"department"`
+	HourlyRate decimal.Decimal `gorm:"type:decimal(10,2);not null" json:"hourly_rate"`
+	Currency   string          `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// TableName specifies table name
+func (DepartmentRate) TableName() string {
+	return "department_rates"
+}
+
+// BeforeCreate hook
+func (dr *DepartmentRate) BeforeCreate(tx *gorm.DB) error {
+	if dr.ID == uuid.Nil {
+		dr.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// ExchangeRate represents the rate converting one unit of FromCurrency into ToCurrency on a date
+type ExchangeRate struct {
+	ID           uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	RateDate     time.Time       `gorm:"type:date;not null;uniqueIndex:exchange_rates_date_pair_idx" json:"rate_date"`
+	FromCurrency string          `gorm:"type:varchar(3);not null;uniqueIndex:exchange_rates_date_pair_idx" json:"from_currency"`
+	ToCurrency   string          `gorm:"type:varchar(3);not null;uniqueIndex:exchange_rates_date_pair_idx" json:"to_currency"`
+	Rate         decimal.Decimal `gorm:"type:decimal(18,8);not null" json:"rate"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// TableName specifies table name
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
+
+// BeforeCreate hook
+func (er *ExchangeRate) BeforeCreate(tx *gorm.DB) error {
+	if er.ID == uuid.Nil {
+		er.ID = uuid.New()
+	}
+	return nil
+}
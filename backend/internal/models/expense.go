@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type Expense struct {
+	ID           uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID    uuid.UUID       `gorm:"type:uuid;not null;index" json:"project_id"`
+	UserID       uuid.UUID       `gorm:"type:uuid;not null;index" json:"user_id"`
+	Category     string          `gorm:"type:varchar(30);not null;index" json:"category"`
+	Amount       decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
+	Currency     string          `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	Vendor       *string         `gorm:"type:varchar(200)" json:"vendor,omitempty"`
+	IncurredDate time.Time       `gorm:"type:date;not null;index" json:"incurred_date"`
+	Note         *string         `gorm:"type:text" json:"note,omitempty"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+
+	// Relations
+	Project Project `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	User    User    `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// TableName specifies table name
+func (Expense) TableName() string {
+	return "expenses"
+}
+
+// BeforeCreate hook
+func (e *Expense) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Holiday is a non-working day of the company calendar.
+// Weekends are never working days and need not be registered.
+type Holiday struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Date      time.Time `gorm:"type:date;not null;uniqueIndex" json:"date"`
+	Name      string    `gorm:"type:varchar(100);not null" json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies table name
+func (Holiday) TableName() string {
+	return "holidays"
+}
+
+// BeforeCreate hook
+func (h *Holiday) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
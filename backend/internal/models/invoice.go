@@ -0,0 +1,108 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Invoice statuses
+const (
+	InvoiceStatusDraft  = "draft"
+	InvoiceStatusIssued = "issued"
+	InvoiceStatusPaid   = "paid"
+)
+
+// Invoice line grouping
+const (
+	InvoiceGroupByMember = "member"
+	InvoiceGroupByTask   = "task"
+)
+
+// Invoice is a bill for the time entries of a project within a period.
+// The invoice number is assigned in sequence when the invoice is issued.
+type Invoice struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID      uuid.UUID       `gorm:"type:uuid;not null;index" json:"project_id"`
+	SequenceNumber *int            `gorm:"uniqueIndex" json:"sequence_number,omitempty"`
+	InvoiceNumber  *string         `gorm:"type:varchar(30);uniqueIndex" json:"invoice_number,omitempty"`
+	Status         string          `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	PeriodStart    time.Time       `gorm:"type:date;not null" json:"period_start"`
+	PeriodEnd      time.Time       `gorm:"type:date;not null" json:"period_end"`
+	GroupBy        string          `gorm:"type:varchar(20);not null;default:'member'" json:"group_by"`
+	Currency       string          `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	TotalHours     decimal.Decimal `gorm:"type:decimal(10,2);not null;default:0" json:"total_hours"`
+	TotalAmount    decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0" json:"total_amount"`
+	Note           *string         `gorm:"type:text" json:"note,omitempty"`
+	IssuedAt       *time.Time      `json:"issued_at,omitempty"`
+	PaidAt         *time.Time      `json:"paid_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+
+	// Relations
+	Project Project       `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	Lines   []InvoiceLine `gorm:"foreignKey:InvoiceID" json:"lines,omitempty"`
+}
+
+// TableName specifies table name
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+// BeforeCreate hook
+func (i *Invoice) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// InvoiceSequenceID is the ID of the single row of the invoice sequence
+const InvoiceSequenceID = 1
+
+// InvoiceSequence is the counter invoice sequence numbers are taken from. Its single row is
+// locked while an invoice is issued, so concurrent issues never take the same number.
+type InvoiceSequence struct {
+	ID         int `gorm:"primaryKey" json:"id"`
+	LastNumber int `gorm:"not null;default:0" json:"last_number"`
+}
+
+// TableName specifies table name
+func (InvoiceSequence) TableName() string {
+	return "invoice_sequences"
+}
+
+// IsLocked reports whether the invoice has been issued, which freezes its time entries
+func (i *Invoice) IsLocked() bool {
+	return i.Status != InvoiceStatusDraft
+}
+
+// InvoiceLine is the billed amount for one member or task of an invoice
+type InvoiceLine struct {
+	ID          uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	InvoiceID   uuid.UUID       `gorm:"type:uuid;not null;index" json:"invoice_id"`
+	MemberID    *uuid.UUID      `gorm:"type:uuid" json:"member_id,omitempty"`
+	TaskID      *uuid.UUID      `gorm:"type:uuid" json:"task_id,omitempty"`
+	Description string          `gorm:"type:varchar(255);not null" json:"description"`
+	Hours       decimal.Decimal `gorm:"type:decimal(10,2);not null" json:"hours"`
+	UnitPrice   decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"unit_price"`
+	Amount      decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
+	SortOrder   int             `gorm:"not null;default:0" json:"sort_order"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// TableName specifies table name
+func (InvoiceLine) TableName() string {
+	return "invoice_lines"
+}
+
+// BeforeCreate hook
+func (il *InvoiceLine) BeforeCreate(tx *gorm.DB) error {
+	if il.ID == uuid.Nil {
+		il.ID = uuid.New()
+	}
+	return nil
+}
@@ -4,25 +4,28 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
 type Member struct {
-	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	UserID     *uuid.UUID     `gorm:"type:uuid;index" json:"user_id,omitempty"`
-	Name       string         `gorm:"type:varchar(100);not null" json:"name"`
-	Email      string         `gorm:"type:varchar(255);not null;index" json:"email"`
-	Role       *string        `gorm:"type:varchar(50)" json:"role,omitempty"`
-	HourlyRate float64        `gorm:"type:decimal(10,2);default:0.00" json:"hourly_rate"`
-	Department *string        `gorm:"type:varchar(100)" json:"department,omitempty"`
-	CreatedAt  time.Time      `json:"created_at"`
-	UpdatedAt  time.Time      `json:"updated_at"`
-	DeletedAt  gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+	ID         uuid.UUID        `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID     *uuid.UUID       `gorm:"type:uuid;index" json:"user_id,omitempty"`
+	Name       string           `gorm:"type:varchar(100);not null" json:"name"`
+	Email      string           `gorm:"type:varchar(255);not null;index" json:"email"`
+	Role       *string          `gorm:"type:varchar(50)" json:"role,omitempty"`
+	HourlyRate decimal.Decimal  `gorm:"type:decimal(10,2);default:0.00" json:"hourly_rate"`
+	BillRate   *decimal.Decimal `gorm:"type:decimal(10,2)" json:"bill_rate,omitempty"`
+	Currency   string           `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	Department *string          `gorm:"type:varchar(100)" json:"department,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+	DeletedAt  gorm.DeletedAt   `gorm:"index" json:"deleted_at,omitempty"`
 
 	// Relations
-	User        *User         `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Projects    []Project     `gorm:"many2many:project_members" json:"projects,omitempty"`
-	TimeEntries []TimeEntry   `gorm:"foreignKey:MemberID" json:"time_entries,omitempty"`
+	User        *User       `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Projects    []Project   `gorm:"many2many:project_members" json:"projects,omitempty"`
+	TimeEntries []TimeEntry `gorm:"foreignKey:MemberID" json:"time_entries,omitempty"`
 }
 
 // TableName specifies table name
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// MemberRate is a member's hourly cost rate valid from EffectiveFrom through EffectiveTo.
+// The latest rate of a member is open-ended and has no EffectiveTo.
+type MemberRate struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	MemberID      uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:member_rates_member_id_effective_from_idx" json:"member_id"`
+	HourlyRate    decimal.Decimal `gorm:"type:decimal(10,2);not null" json:"hourly_rate"`
+	Currency      string          `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	EffectiveFrom time.Time       `gorm:"type:date;not null;uniqueIndex:member_rates_member_id_effective_from_idx" json:"effective_from"`
+	EffectiveTo   *time.Time      `gorm:"type:date" json:"effective_to,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+
+	// Relations
+	Member Member `gorm:"foreignKey:MemberID" json:"member,omitempty"`
+}
+
+// TableName specifies table name
+func (MemberRate) TableName() string {
+	return "member_rates"
+}
+
+// BeforeCreate hook
+func (mr *MemberRate) BeforeCreate(tx *gorm.DB) error {
+	if mr.ID == uuid.Nil {
+		mr.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsEffectiveOn checks if the rate applies on the given date
+func (mr *MemberRate) IsEffectiveOn(date time.Time) bool {
+	if date.Before(mr.EffectiveFrom) {
+		return false
+	}
+	return mr.EffectiveTo == nil || !date.After(*mr.EffectiveTo)
+}
@@ -4,26 +4,44 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
+// Contract types of a project, which decide how its revenue is derived
+const (
+	// ContractTypeFixedPrice earns the agreed amount, set directly or scheduled in revenue items
+	ContractTypeFixedPrice = "fixed_price"
+	// ContractTypeTimeAndMaterials earns the billable hours at their bill rates
+	ContractTypeTimeAndMaterials = "time_and_materials"
+	// ContractTypeRetainer earns a monthly fee (RetainerFee, in the budget currency) covering a bank of
+	// RetainerHours each month. Unused hours roll over for RetainerRolloverMonths months and hours
+	// beyond the bank are billed at their bill rates.
+	ContractTypeRetainer = "retainer"
+)
+
 type Project struct {
-	ID           uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	UserID       uuid.UUID      `gorm:"type:uuid;not null;index" json:"user_id"`
-	Name         string         `gorm:"type:varchar(200);not null;index" json:"name"`
-	Description  *string        `gorm:"type:text" json:"description,omitempty"`
-	Status       string         `gorm:"type:varchar(20);not null;default:'planning';index" json:"status"`
-	BudgetAmount *float64       `gorm:"type:decimal(15,2)" json:"budget_amount,omitempty"`
-	StartDate    *time.Time     `gorm:"type:date" json:"start_date,omitempty"`
-	EndDate      *time.Time     `gorm:"type:date" json:"end_date,omitempty"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+	ID                     uuid.UUID        `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID                 uuid.UUID        `gorm:"type:uuid;not null;index" json:"user_id"`
+	Name                   string           `gorm:"type:varchar(200);not null;index" json:"name"`
+	Description            *string          `gorm:"type:text" json:"description,omitempty"`
+	Status                 string           `gorm:"type:varchar(20);not null;default:'planning';index" json:"status"`
+	BudgetAmount           *decimal.Decimal `gorm:"type:decimal(15,2)" json:"budget_amount,omitempty"`
+	StartDate              *time.Time       `gorm:"type:date" json:"start_date,omitempty"`
+	EndDate                *time.Time       `gorm:"type:date" json:"end_date,omitempty"`
+	DefaultBillable        *bool            `gorm:"not null;default:true" json:"default_billable,omitempty"`
+	ContractType           string           `gorm:"type:varchar(20);not null;default:'fixed_price'" json:"contract_type"`
+	RetainerFee            *decimal.Decimal `gorm:"type:decimal(15,2)" json:"retainer_fee,omitempty"`
+	RetainerHours          *decimal.Decimal `gorm:"type:decimal(7,2)" json:"retainer_hours,omitempty"`
+	RetainerRolloverMonths int              `gorm:"not null;default:0" json:"retainer_rollover_months"`
+	CreatedAt              time.Time        `json:"created_at"`
+	UpdatedAt              time.Time        `json:"updated_at"`
+	DeletedAt              gorm.DeletedAt   `gorm:"index" json:"deleted_at,omitempty"`
 
 	// Relations
-	User    User    `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Tasks   []Task  `gorm:"foreignKey:ProjectID" json:"tasks,omitempty"`
-	Budget  *Budget `gorm:"foreignKey:ProjectID" json:"budget,omitempty"`
+	User    User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Tasks   []Task   `gorm:"foreignKey:ProjectID" json:"tasks,omitempty"`
+	Budget  *Budget  `gorm:"foreignKey:ProjectID" json:"budget,omitempty"`
 	Members []Member `gorm:"many2many:project_members" json:"members,omitempty"`
 }
 
@@ -39,3 +57,17 @@ func (p *Project) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// IsBillableByDefault reports whether new time entries of the project are billable
+// unless specified otherwise
+func (p *Project) IsBillableByDefault() bool {
+	return p.DefaultBillable == nil || *p.DefaultBillable
+}
+
+// Contract returns the contract type of the project, which is fixed-price unless specified
+func (p *Project) Contract() string {
+	if p.ContractType == "" {
+		return ContractTypeFixedPrice
+	}
+	return p.ContractType
+}
@@ -4,19 +4,21 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
 type ProjectMember struct {
-	ID                 uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	ProjectID          uuid.UUID  `gorm:"type:uuid;not null;index" json:"project_id"`
-	MemberID           uuid.UUID  `gorm:"type:uuid;not null;index" json:"member_id"`
-	JoinedAt           time.Time  `gorm:"type:date;not null;default:CURRENT_DATE" json:"joined_at"`
-	LeftAt             *time.Time `gorm:"type:date" json:"left_at,omitempty"`
-	AllocationRate     float64    `gorm:"type:decimal(3,2);default:1.00" json:"allocation_rate"`
-	HourlyRateSnapshot *float64   `gorm:"type:decimal(10,2)" json:"hourly_rate_snapshot,omitempty"`
-	CreatedAt          time.Time  `json:"created_at"`
-	UpdatedAt          time.Time  `json:"updated_at"`
+	ID                 uuid.UUID        `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID          uuid.UUID        `gorm:"type:uuid;not null;index" json:"project_id"`
+	MemberID           uuid.UUID        `gorm:"type:uuid;not null;index" json:"member_id"`
+	JoinedAt           time.Time        `gorm:"type:date;not null;default:CURRENT_DATE" json:"joined_at"`
+	LeftAt             *time.Time       `gorm:"type:date" json:"left_at,omitempty"`
+	AllocationRate     float64          `gorm:"type:decimal(3,2);default:1.00" json:"allocation_rate"`
+	HourlyRateSnapshot *decimal.Decimal `gorm:"type:decimal(10,2)" json:"hourly_rate_snapshot,omitempty"`
+	BillRate           *decimal.Decimal `gorm:"type:decimal(10,2)" json:"bill_rate,omitempty"`
+	CreatedAt          time.Time        `json:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
 
 	// Relations
 	Project Project `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Revenue item statuses
+const (
+	RevenueStatusPlanned  = "planned"
+	RevenueStatusInvoiced = "invoiced"
+	RevenueStatusReceived = "received"
+)
+
+// RevenueItem is a billing milestone of a project, such as the kickoff or acceptance payment
+type RevenueItem struct {
+	ID          uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	ProjectID   uuid.UUID       `gorm:"type:uuid;not null;index" json:"project_id"`
+	TaskID      *uuid.UUID      `gorm:"type:uuid;index" json:"task_id,omitempty"`
+	Name        string          `gorm:"type:varchar(255);not null" json:"name"`
+	PlannedDate time.Time       `gorm:"type:date;not null;index" json:"planned_date"`
+	Amount      decimal.Decimal `gorm:"type:decimal(15,2);not null" json:"amount"`
+	Currency    string          `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	Status      string          `gorm:"type:varchar(20);not null;default:'planned';index" json:"status"`
+	Description *string         `gorm:"type:text" json:"description,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+
+	// Relations
+	Project Project `gorm:"foreignKey:ProjectID" json:"project,omitempty"`
+	Task    *Task   `gorm:"foreignKey:TaskID" json:"task,omitempty"`
+}
+
+// TableName specifies table name
+func (RevenueItem) TableName() string {
+	return "revenue_items"
+}
+
+// BeforeCreate hook
+func (ri *RevenueItem) BeforeCreate(tx *gorm.DB) error {
+	if ri.ID == uuid.Nil {
+		ri.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsRecognized reports whether the item has been invoiced or received
+func (ri *RevenueItem) IsRecognized() bool {
+	return ri.Status == RevenueStatusInvoiced || ri.Status == RevenueStatusReceived
+}
@@ -4,20 +4,43 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
+// Sources of the cost rate of a time entry, in order of precedence
+const (
+	// RateSourceEntry is a rate entered for the time entry itself
+	RateSourceEntry = "entry"
+	// RateSourceProject is the rate of the member's assignment to the project
+	RateSourceProject = "project"
+	// RateSourceMember is the member's rate valid on the work date
+	RateSourceMember = "member"
+	// RateSourceDepartment is the default rate of the member's department
+	RateSourceDepartment = "department"
+)
+
 type TimeEntry struct {
-	ID                 uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	TaskID             uuid.UUID `gorm:"type:uuid;not null;index" json:"task_id"`
-	MemberID           uuid.UUID `gorm:"type:uuid;not null;index" json:"member_id"`
-	UserID             uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
-	WorkDate           time.Time `gorm:"type:date;not null;index" json:"work_date"`
-	Hours              float64   `gorm:"type:decimal(5,2);not null" json:"hours"`
-	HourlyRateSnapshot *float64  `gorm:"type:decimal(10,2)" json:"hourly_rate_snapshot,omitempty"`
-	Comment            *string   `gorm:"type:text" json:"comment,omitempty"`
-	CreatedAt          time.Time `json:"created_at"`
-	UpdatedAt          time.Time `json:"updated_at"`
+	ID                 uuid.UUID        `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	TaskID             uuid.UUID        `gorm:"type:uuid;not null;index" json:"task_id"`
+	MemberID           uuid.UUID        `gorm:"type:uuid;not null;index" json:"member_id"`
+	UserID             uuid.UUID        `gorm:"type:uuid;not null;index" json:"user_id"`
+	WorkDate           time.Time        `gorm:"type:date;not null;index" json:"work_date"`
+	Hours              decimal.Decimal  `gorm:"type:decimal(5,2);not null" json:"hours"`
+	HourlyRateSnapshot *decimal.Decimal `gorm:"type:decimal(10,2)" json:"hourly_rate_snapshot,omitempty"`
+	RateSource         string           `gorm:"type:varchar(20);not null;default:'member'" json:"rate_source"`
+	BillRateSnapshot   *decimal.Decimal `gorm:"type:decimal(10,2)" json:"bill_rate_snapshot,omitempty"`
+	IsBillable         bool             `gorm:"not null;index" json:"is_billable"`
+	Currency           string           `gorm:"type:varchar(3);not null;default:'JPY'" json:"currency"`
+	Comment            *string          `gorm:"type:text" json:"comment,omitempty"`
+	InvoiceID          *uuid.UUID       `gorm:"type:uuid;index" json:"invoice_id,omitempty"`
+	StartedAt          *time.Time       `json:"started_at,omitempty"`
+	EndedAt            *time.Time       `json:"ended_at,omitempty"`
+	Timezone           *string          `gorm:"type:varchar(64)" json:"timezone,omitempty"`
+	LocalStartTime     *string          `gorm:"type:varchar(5)" json:"local_start_time,omitempty"`
+	LocalEndTime       *string          `gorm:"type:varchar(5)" json:"local_end_time,omitempty"`
+	CreatedAt          time.Time        `json:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
 
 	// Relations
 	Task   Task   `gorm:"foreignKey:TaskID" json:"task,omitempty"`
@@ -38,10 +61,25 @@ func (te *TimeEntry) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
-// Cost calculates the cost of this time entry
-func (te *TimeEntry) Cost() float64 {
+// Cost calculates the exact cost of this time entry in its currency
+func (te *TimeEntry) Cost() decimal.Decimal {
 	if te.HourlyRateSnapshot == nil {
-		return 0
+		return decimal.Zero
+	}
+	return te.Hours.Mul(*te.HourlyRateSnapshot)
+}
+
+// HasTimeRange reports whether the start and end times of the work are recorded. They are kept
+// in UTC along with the timezone they were recorded in and their clock times there as HH:MM.
+func (te *TimeEntry) HasTimeRange() bool {
+	return te.StartedAt != nil && te.EndedAt != nil
+}
+
+// BillValue calculates the exact value of this time entry at its bill rate in its currency.
+// Non-billable entries are valued as well, which is what the realization rate is based on.
+func (te *TimeEntry) BillValue() decimal.Decimal {
+	if te.BillRateSnapshot == nil {
+		return decimal.Zero
 	}
-	return te.Hours * *te.HourlyRateSnapshot
+	return te.Hours.Mul(*te.BillRateSnapshot)
 }
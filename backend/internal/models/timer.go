@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Timer records the time a member is working on a task. A member has at most one running timer,
+// and stopping it turns the elapsed time into time entries, one per work date.
+type Timer struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	TaskID      uuid.UUID  `gorm:"type:uuid;not null;index" json:"task_id"`
+	MemberID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"member_id"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	StartedAt   time.Time  `gorm:"not null" json:"started_at"`
+	StoppedAt   *time.Time `json:"stopped_at,omitempty"`
+	AutoStopped bool       `gorm:"not null;default:false" json:"auto_stopped"`
+	Comment     *string    `gorm:"type:text" json:"comment,omitempty"`
+	IsBillable  *bool      `json:"is_billable,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+
+	// Relations
+	Task   Task   `gorm:"foreignKey:TaskID" json:"task,omitempty"`
+	Member Member `gorm:"foreignKey:MemberID" json:"member,omitempty"`
+}
+
+// TableName specifies table name
+func (Timer) TableName() string {
+	return "timers"
+}
+
+// BeforeCreate hook
+func (t *Timer) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsRunning reports whether the timer has not been stopped yet
+func (t *Timer) IsRunning() bool {
+	return t.StoppedAt == nil
+}
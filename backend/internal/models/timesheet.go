@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Timesheet statuses
+const (
+	TimesheetStatusDraft     = "draft"
+	TimesheetStatusSubmitted = "submitted"
+	TimesheetStatusApproved  = "approved"
+	TimesheetStatusRejected  = "rejected"
+)
+
+// Timesheet is the time a member entered in one ISO week, from Monday to Sunday. The time entries
+// of a submitted or approved timesheet can no longer be changed. A week without a record has not
+// been submitted yet and is a draft.
+type Timesheet struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	MemberID      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:timesheets_member_id_week_start_idx" json:"member_id"`
+	WeekStart     time.Time  `gorm:"type:date;not null;uniqueIndex:timesheets_member_id_week_start_idx" json:"week_start"`
+	WeekEnd       time.Time  `gorm:"type:date;not null" json:"week_end"`
+	Status        string     `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	SubmittedBy   *uuid.UUID `gorm:"type:uuid" json:"submitted_by,omitempty"`
+	SubmittedAt   *time.Time `json:"submitted_at,omitempty"`
+	ReviewedBy    *uuid.UUID `gorm:"type:uuid" json:"reviewed_by,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewed_at,omitempty"`
+	ReviewComment *string    `gorm:"type:text" json:"review_comment,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+
+	// Relations
+	Member Member `gorm:"foreignKey:MemberID" json:"member,omitempty"`
+}
+
+// TableName specifies table name
+func (Timesheet) TableName() string {
+	return "timesheets"
+}
+
+// BeforeCreate hook
+func (ts *Timesheet) BeforeCreate(tx *gorm.DB) error {
+	if ts.ID == uuid.Nil {
+		ts.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsLocked reports whether the time entries of the timesheet can no longer be changed
+func (ts *Timesheet) IsLocked() bool {
+	return ts.Status == TimesheetStatusSubmitted || ts.Status == TimesheetStatusApproved
+}
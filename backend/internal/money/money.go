@@ -0,0 +1,166 @@
+// Package money defines how monetary amounts and hours are rounded.
+//
+// Amounts are kept exact with fixed-point decimals while they are summed,
+// multiplied and converted, and are rounded once to the minor unit of their
+// currency when they are stored as a total or presented in a response.
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+func init() {
+	// Keep amounts as JSON numbers so the API contract does not change
+	decimal.MarshalJSONWithoutQuotes = true
+}
+
+// RoundingMode represents how amounts are rounded to their minor unit
+type RoundingMode string
+
+const (
+	// RoundHalfUp rounds halves away from zero
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundHalfEven rounds halves to the nearest even digit (banker's rounding)
+	RoundHalfEven RoundingMode = "half_even"
+	// RoundDown truncates towards zero
+	RoundDown RoundingMode = "down"
+	// RoundUp rounds away from zero
+	RoundUp RoundingMode = "up"
+)
+
+const (
+	// DefaultMinorUnits is used for currencies without a configured minor unit
+	DefaultMinorUnits int32 = 2
+	// HourPlaces is the number of decimal places kept for hours
+	HourPlaces int32 = 2
+	// RatePlaces is the number of decimal places kept for hourly rates and percentages
+	RatePlaces int32 = 2
+)
+
+// defaultMinorUnits lists ISO 4217 currencies whose minor unit is not two digits
+var defaultMinorUnits = map[string]int32{
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"CLP": 0,
+	"ISK": 0,
+	"BHD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
+
+var (
+	mu         sync.RWMutex
+	mode       = RoundHalfUp
+	minorUnits = copyMinorUnits(defaultMinorUnits)
+)
+
+// Configure sets the rounding mode and overrides the minor unit of currencies
+func Configure(roundingMode RoundingMode, overrides map[string]int32) error {
+	switch roundingMode {
+	case RoundHalfUp, RoundHalfEven, RoundDown, RoundUp:
+	default:
+		return fmt.Errorf("unknown rounding mode: %q", roundingMode)
+	}
+
+	units := copyMinorUnits(defaultMinorUnits)
+	for currency, places := range overrides {
+		if places < 0 {
+			return fmt.Errorf("invalid minor unit for %s: %d", currency, places)
+		}
+		units[strings.ToUpper(currency)] = places
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	mode = roundingMode
+	minorUnits = units
+	return nil
+}
+
+// ParseMinorUnits parses minor unit overrides in the form "JPY:0,USD:2"
+func ParseMinorUnits(s string) (map[string]int32, error) {
+	overrides := make(map[string]int32)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		currency, places, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid minor unit override: %q", pair)
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(places), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid minor unit override: %q", pair)
+		}
+		overrides[strings.ToUpper(strings.TrimSpace(currency))] = int32(n)
+	}
+	return overrides, nil
+}
+
+// MinorUnits returns the number of decimal places of the currency
+func MinorUnits(currency string) int32 {
+	mu.RLock()
+	defer mu.RUnlock()
+	if places, ok := minorUnits[currency]; ok {
+		return places
+	}
+	return DefaultMinorUnits
+}
+
+// Round rounds an amount to the minor unit of the currency
+func Round(amount decimal.Decimal, currency string) decimal.Decimal {
+	return RoundTo(amount, MinorUnits(currency))
+}
+
+// RoundHours rounds hours to HourPlaces
+func RoundHours(hours decimal.Decimal) decimal.Decimal {
+	return RoundTo(hours, HourPlaces)
+}
+
+// RoundRate rounds an hourly rate or a percentage to RatePlaces
+func RoundRate(rate decimal.Decimal) decimal.Decimal {
+	return RoundTo(rate, RatePlaces)
+}
+
+// RoundTo rounds a value to the given number of decimal places with the configured mode
+func RoundTo(value decimal.Decimal, places int32) decimal.Decimal {
+	mu.RLock()
+	m := mode
+	mu.RUnlock()
+
+	switch m {
+	case RoundHalfEven:
+		return value.RoundBank(places)
+	case RoundDown:
+		return value.RoundDown(places)
+	case RoundUp:
+		return value.RoundUp(places)
+	default:
+		return value.Round(places)
+	}
+}
+
+// Percentage returns part / whole * 100 as a float, or zero when whole is zero
+func Percentage(part, whole decimal.Decimal) float64 {
+	if whole.IsZero() {
+		return 0
+	}
+	return RoundRate(part.Div(whole).Mul(decimal.NewFromInt(100))).InexactFloat64()
+}
+
+func copyMinorUnits(src map[string]int32) map[string]int32 {
+	dst := make(map[string]int32, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
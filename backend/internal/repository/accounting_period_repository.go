@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// AccountingPeriodRepository handles database operations for accounting periods
+type AccountingPeriodRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountingPeriodRepository creates a new AccountingPeriodRepository
+func NewAccountingPeriodRepository(db *gorm.DB) *AccountingPeriodRepository {
+	return &AccountingPeriodRepository{db: db}
+}
+
+// Save creates or updates an accounting period
+func (r *AccountingPeriodRepository) Save(period *models.AccountingPeriod) error {
+	return r.db.Save(period).Error
+}
+
+// GetByPeriodStart retrieves the accounting period starting on the given day
+func (r *AccountingPeriodRepository) GetByPeriodStart(periodStart time.Time) (*models.AccountingPeriod, error) {
+	var period models.AccountingPeriod
+	if err := r.db.First(&period, "period_start = ?", periodStart).Error; err != nil {
+		return nil, err
+	}
+	return &period, nil
+}
+
+// List retrieves the accounting periods, latest first
+func (r *AccountingPeriodRepository) List() ([]models.AccountingPeriod, error) {
+	var periods []models.AccountingPeriod
+	err := r.db.Order("period_start DESC").Find(&periods).Error
+	return periods, err
+}
+
+// FindClosed retrieves the closed accounting periods among those starting on the given days
+func (r *AccountingPeriodRepository) FindClosed(periodStarts []time.Time) ([]models.AccountingPeriod, error) {
+	var periods []models.AccountingPeriod
+	err := r.db.
+		Where("period_start IN ? AND status = ?", periodStarts, models.AccountingPeriodStatusClosed).
+		Order("period_start ASC").
+		Find(&periods).Error
+	return periods, err
+}
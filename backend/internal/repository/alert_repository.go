@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// AlertRepository handles database operations for triggered alerts
+type AlertRepository struct {
+	db *gorm.DB
+}
+
+// NewAlertRepository creates a new AlertRepository
+func NewAlertRepository(db *gorm.DB) *AlertRepository {
+	return &AlertRepository{db: db}
+}
+
+// Create creates a new alert
+func (r *AlertRepository) Create(alert *models.Alert) error {
+	return r.db.Create(alert).Error
+}
+
+// GetByID retrieves an alert by ID
+func (r *AlertRepository) GetByID(id uuid.UUID) (*models.Alert, error) {
+	var alert models.Alert
+	if err := r.db.First(&alert, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// AlertListParams represents parameters for listing alerts
+type AlertListParams struct {
+	ProjectID uuid.UUID
+	Status    string
+	Page      int
+	PerPage   int
+}
+
+// List retrieves alerts of a project with filtering and pagination, newest first
+func (r *AlertRepository) List(params AlertListParams) ([]models.Alert, int64, error) {
+	var alerts []models.Alert
+	var total int64
+
+	query := r.db.Model(&models.Alert{}).Where("project_id = ?", params.ProjectID)
+
+	// Apply filters
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (params.Page - 1) * params.PerPage
+	if err := query.
+		Order("triggered_at DESC").
+		Offset(offset).
+		Limit(params.PerPage).
+		Find(&alerts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return alerts, total, nil
+}
+
+// ListActiveByProject retrieves the open and acknowledged alerts of a project
+func (r *AlertRepository) ListActiveByProject(projectID uuid.UUID) ([]models.Alert, error) {
+	var alerts []models.Alert
+	err := r.db.
+		Where("project_id = ? AND status <> ?", projectID, models.AlertStatusResolved).
+		Order("triggered_at ASC").
+		Find(&alerts).Error
+	return alerts, err
+}
+
+// Update updates an alert
+func (r *AlertRepository) Update(alert *models.Alert) error {
+	return r.db.Save(alert).Error
+}
+
+// ResolveByRule resolves the open and acknowledged alerts of a rule
+func (r *AlertRepository) ResolveByRule(ruleID uuid.UUID, resolvedAt time.Time) error {
+	return r.db.Model(&models.Alert{}).
+		Where("rule_id = ? AND status <> ?", ruleID, models.AlertStatusResolved).
+		Updates(map[string]interface{}{"status": models.AlertStatusResolved, "resolved_at": resolvedAt}).Error
+}
+
+// DetachRule unlinks the alerts of a rule that is about to be deleted
+func (r *AlertRepository) DetachRule(ruleID uuid.UUID) error {
+	return r.db.Model(&models.Alert{}).Where("rule_id = ?", ruleID).Update("rule_id", nil).Error
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// AlertRuleRepository handles database operations for alert rules
+type AlertRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewAlertRuleRepository creates a new AlertRuleRepository
+func NewAlertRuleRepository(db *gorm.DB) *AlertRuleRepository {
+	return &AlertRuleRepository{db: db}
+}
+
+// Create creates a new alert rule
+func (r *AlertRuleRepository) Create(rule *models.AlertRule) error {
+	return r.db.Create(rule).Error
+}
+
+// GetByID retrieves an alert rule by ID
+func (r *AlertRuleRepository) GetByID(id uuid.UUID) (*models.AlertRule, error) {
+	var rule models.AlertRule
+	if err := r.db.First(&rule, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// ListByProject retrieves the alert rules of a project, optionally only the enabled ones
+func (r *AlertRuleRepository) ListByProject(projectID uuid.UUID, enabledOnly bool) ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+
+	query := r.db.Where("project_id = ?", projectID)
+	if enabledOnly {
+		query = query.Where("is_enabled = ?", true)
+	}
+
+	err := query.Order("created_at ASC").Find(&rules).Error
+	return rules, err
+}
+
+// Update updates an alert rule
+func (r *AlertRuleRepository) Update(rule *models.AlertRule) error {
+	return r.db.Save(rule).Error
+}
+
+// Delete deletes an alert rule
+func (r *AlertRuleRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.AlertRule{}, "id = ?", id).Error
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// BudgetLineRepository handles database operations for budget line items
+type BudgetLineRepository struct {
+	db *gorm.DB
+}
+
+// NewBudgetLineRepository creates a new BudgetLineRepository
+func NewBudgetLineRepository(db *gorm.DB) *BudgetLineRepository {
+	return &BudgetLineRepository{db: db}
+}
+
+// Create creates a new budget line together with its links
+func (r *BudgetLineRepository) Create(line *models.BudgetLine) error {
+	return r.db.Omit("Project").Create(line).Error
+}
+
+// GetByID retrieves a budget line by ID with its links
+func (r *BudgetLineRepository) GetByID(id uuid.UUID) (*models.BudgetLine, error) {
+	var line models.BudgetLine
+	if err := r.db.Preload("Links").First(&line, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &line, nil
+}
+
+// ListByProject retrieves the budget lines of a project with their links, ordered by category and name
+func (r *BudgetLineRepository) ListByProject(projectID uuid.UUID) ([]models.BudgetLine, error) {
+	var lines []models.BudgetLine
+	err := r.db.Preload("Links").
+		Where("project_id = ?", projectID).
+		Order("category ASC, name ASC, created_at ASC").
+		Find(&lines).Error
+	return lines, err
+}
+
+// Update updates a budget line. When links is not nil, they replace the links of the line.
+func (r *BudgetLineRepository) Update(line *models.BudgetLine, links []models.BudgetLineLink) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Project", "Links").Save(line).Error; err != nil {
+			return err
+		}
+		if links == nil {
+			return nil
+		}
+
+		if err := tx.Where("budget_line_id = ?", line.ID).Delete(&models.BudgetLineLink{}).Error; err != nil {
+			return err
+		}
+		for i := range links {
+			links[i].BudgetLineID = line.ID
+			links[i].ProjectID = line.ProjectID
+		}
+		if len(links) > 0 {
+			if err := tx.Create(&links).Error; err != nil {
+				return err
+			}
+		}
+		line.Links = links
+		return nil
+	})
+}
+
+// Delete deletes a budget line and its links
+func (r *BudgetLineRepository) Delete(id uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("budget_line_id = ?", id).Delete(&models.BudgetLineLink{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.BudgetLine{}, "id = ?", id).Error
+	})
+}
+
+// GetConflictingLinks retrieves the links of other budget lines of the project to any of the
+// given tasks or expense categories
+func (r *BudgetLineRepository) GetConflictingLinks(projectID, lineID uuid.UUID, taskIDs []uuid.UUID, categories []string) ([]models.BudgetLineLink, error) {
+	var links []models.BudgetLineLink
+	if len(taskIDs) == 0 && len(categories) == 0 {
+		return links, nil
+	}
+
+	query := r.db.Where("project_id = ? AND budget_line_id <> ?", projectID, lineID)
+	switch {
+	case len(taskIDs) > 0 && len(categories) > 0:
+		query = query.Where("task_id IN ? OR expense_category IN ?", taskIDs, categories)
+	case len(taskIDs) > 0:
+		query = query.Where("task_id IN ?", taskIDs)
+	default:
+		query = query.Where("expense_category IN ?", categories)
+	}
+
+	err := query.Find(&links).Error
+	return links, err
+}
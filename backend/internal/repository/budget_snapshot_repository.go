@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// BudgetSnapshotRepository handles database operations for budget snapshots
+type BudgetSnapshotRepository struct {
+	db *gorm.DB
+}
+
+// NewBudgetSnapshotRepository creates a new BudgetSnapshotRepository
+func NewBudgetSnapshotRepository(db *gorm.DB) *BudgetSnapshotRepository {
+	return &BudgetSnapshotRepository{db: db}
+}
+
+// Create creates a new budget snapshot
+func (r *BudgetSnapshotRepository) Create(snapshot *models.BudgetSnapshot) error {
+	return r.db.Create(snapshot).Error
+}
+
+// SaveDaily creates the daily snapshot of a project for the snapshot date,
+// or overwrites it when one was already taken that day
+func (r *BudgetSnapshotRepository) SaveDaily(snapshot *models.BudgetSnapshot) error {
+	snapshot.Source = models.BudgetSnapshotSourceDaily
+
+	var existing models.BudgetSnapshot
+	err := r.db.Where("project_id = ? AND snapshot_date = ? AND source = ?",
+		snapshot.ProjectID, snapshot.SnapshotDate, models.BudgetSnapshotSourceDaily).
+		First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return r.db.Create(snapshot).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	snapshot.ID = existing.ID
+	snapshot.CreatedAt = existing.CreatedAt
+	return r.db.Save(snapshot).Error
+}
+
+// ListByProject retrieves the snapshots of a project within the period in chronological order
+func (r *BudgetSnapshotRepository) ListByProject(projectID uuid.UUID, period DateRange) ([]models.BudgetSnapshot, error) {
+	var snapshots []models.BudgetSnapshot
+
+	query := r.db.Where("project_id = ?", projectID)
+	query = period.apply(query, "snapshot_date")
+
+	err := query.Order("snapshot_date ASC, updated_at ASC").Find(&snapshots).Error
+	return snapshots, err
+}
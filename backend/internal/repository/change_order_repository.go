@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// ChangeOrderRepository handles database operations for change orders
+type ChangeOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewChangeOrderRepository creates a new ChangeOrderRepository
+func NewChangeOrderRepository(db *gorm.DB) *ChangeOrderRepository {
+	return &ChangeOrderRepository{db: db}
+}
+
+// Create creates a new change order
+func (r *ChangeOrderRepository) Create(order *models.ChangeOrder) error {
+	return r.db.Omit("Project").Create(order).Error
+}
+
+// GetByID retrieves a change order by ID
+func (r *ChangeOrderRepository) GetByID(id uuid.UUID) (*models.ChangeOrder, error) {
+	var order models.ChangeOrder
+	if err := r.db.First(&order, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// ListByProject retrieves the change orders of a project in the order they were submitted,
+// optionally narrowed to one status
+func (r *ChangeOrderRepository) ListByProject(projectID uuid.UUID, status string) ([]models.ChangeOrder, error) {
+	var orders []models.ChangeOrder
+
+	query := r.db.Where("project_id = ?", projectID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Order("created_at ASC").Find(&orders).Error
+	return orders, err
+}
+
+// ListApprovedByProject retrieves the approved change orders of a project in the order they were applied
+func (r *ChangeOrderRepository) ListApprovedByProject(projectID uuid.UUID) ([]models.ChangeOrder, error) {
+	var orders []models.ChangeOrder
+	err := r.db.
+		Where("project_id = ? AND status = ?", projectID, models.ChangeOrderStatusApproved).
+		Order("reviewed_at ASC, created_at ASC").
+		Find(&orders).Error
+	return orders, err
+}
+
+// Update updates a change order
+func (r *ChangeOrderRepository) Update(order *models.ChangeOrder) error {
+	return r.db.Omit("Project").Save(order).Error
+}
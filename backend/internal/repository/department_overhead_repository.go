@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// DepartmentOverheadRepository handles database operations for department overhead multipliers
+type DepartmentOverheadRepository struct {
+	db *gorm.DB
+}
+
+// NewDepartmentOverheadRepository creates a new DepartmentOverheadRepository
+func NewDepartmentOverheadRepository(db *gorm.DB) *DepartmentOverheadRepository {
+	return &DepartmentOverheadRepository{db: db}
+}
+
+// Create creates a new department overhead
+func (r *DepartmentOverheadRepository) Create(overhead *models.DepartmentOverhead) error {
+	return r.db.Create(overhead).Error
+}
+
+// GetByID retrieves a department overhead by ID
+func (r *DepartmentOverheadRepository) GetByID(id uuid.UUID) (*models.DepartmentOverhead, error) {
+	var overhead models.DepartmentOverhead
+	if err := r.db.First(&overhead, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &overhead, nil
+}
+
+// GetByEffectiveFrom retrieves the overhead of a department that takes effect on the date
+func (r *DepartmentOverheadRepository) GetByEffectiveFrom(department string, effectiveFrom time.Time) (*models.DepartmentOverhead, error) {
+	var overhead models.DepartmentOverhead
+	if err := r.db.First(&overhead, "department = ? AND effective_from = ?", department, effectiveFrom).Error; err != nil {
+		return nil, err
+	}
+	return &overhead, nil
+}
+
+// List retrieves department overheads ordered by department and effective date,
+// optionally narrowed to one department
+func (r *DepartmentOverheadRepository) List(department *string) ([]models.DepartmentOverhead, error) {
+	var overheads []models.DepartmentOverhead
+	query := r.db.Model(&models.DepartmentOverhead{})
+	if department != nil {
+		query = query.Where("department = ?", *department)
+	}
+	err := query.Order("department ASC, effective_from ASC").Find(&overheads).Error
+	return overheads, err
+}
+
+// Update updates a department overhead
+func (r *DepartmentOverheadRepository) Update(overhead *models.DepartmentOverhead) error {
+	return r.db.Save(overhead).Error
+}
+
+// Delete deletes a department overhead
+func (r *DepartmentOverheadRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.DepartmentOverhead{}, "id = ?", id).Error
+}
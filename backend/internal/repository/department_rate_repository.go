@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// DepartmentRateRepository handles database operations for department default rates
+type DepartmentRateRepository struct {
+	db *gorm.DB
+}
+
+// NewDepartmentRateRepository creates a new DepartmentRateRepository
+func NewDepartmentRateRepository(db *gorm.DB) *DepartmentRateRepository {
+	return &DepartmentRateRepository{db: db}
+}
+
+// Create creates a new department rate
+func (r *DepartmentRateRepository) Create(rate *models.DepartmentRate) error {
+	return r.db.Create(rate).Error
+}
+
+// GetByID retrieves a department rate by ID
+func (r *DepartmentRateRepository) GetByID(id uuid.UUID) (*models.DepartmentRate, error) {
+	var rate models.DepartmentRate
+	if err := r.db.First(&rate, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// GetByDepartment retrieves the rate of a department
+func (r *DepartmentRateRepository) GetByDepartment(department string) (*models.DepartmentRate, error) {
+	var rate models.DepartmentRate
+	if err := r.db.First(&rate, "department = ?", department).Error; err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// List retrieves all department rates ordered by department
+func (r *DepartmentRateRepository) List() ([]models.DepartmentRate, error) {
+	var rates []models.DepartmentRate
+	err := r.db.Order("department ASC").Find(&rates).Error
+	return rates, err
+}
+
+// Update updates a department rate
+func (r *DepartmentRateRepository) Update(rate *models.DepartmentRate) error {
+	return r.db.Save(rate).Error
+}
+
+// Delete deletes a department rate
+func (r *DepartmentRateRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.DepartmentRate{}, "id = ?", id).Error
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// ExchangeRateRepository handles database operations for exchange rates
+type ExchangeRateRepository struct {
+	db *gorm.DB
+}
+
+// NewExchangeRateRepository creates a new ExchangeRateRepository
+func NewExchangeRateRepository(db *gorm.DB) *ExchangeRateRepository {
+	return &ExchangeRateRepository{db: db}
+}
+
+// Create creates a new exchange rate
+func (r *ExchangeRateRepository) Create(rate *models.ExchangeRate) error {
+	return r.db.Create(rate).Error
+}
+
+// GetByID retrieves an exchange rate by ID
+func (r *ExchangeRateRepository) GetByID(id uuid.UUID) (*models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	if err := r.db.First(&rate, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// ExchangeRateListParams represents parameters for listing exchange rates
+type ExchangeRateListParams struct {
+	FromCurrency string
+	ToCurrency   string
+	StartDate    *time.Time
+	EndDate      *time.Time
+	Page         int
+	PerPage      int
+}
+
+// List retrieves exchange rates with filtering and pagination
+func (r *ExchangeRateRepository) List(params ExchangeRateListParams) ([]models.ExchangeRate, int64, error) {
+	var rates []models.ExchangeRate
+	var total int64
+
+	query := r.db.Model(&models.ExchangeRate{})
+
+	// Apply filters
+	if params.FromCurrency != "" {
+		query = query.Where("from_currency = ?", params.FromCurrency)
+	}
+
+	if params.ToCurrency != "" {
+		query = query.Where("to_currency = ?", params.ToCurrency)
+	}
+
+	if params.StartDate != nil {
+		query = query.Where("rate_date >= ?", *params.StartDate)
+	}
+
+	if params.EndDate != nil {
+		query = query.Where("rate_date <= ?", *params.EndDate)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (params.Page - 1) * params.PerPage
+	if err := query.
+		Order("rate_date DESC, from_currency ASC, to_currency ASC").
+		Offset(offset).
+		Limit(params.PerPage).
+		Find(&rates).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return rates, total, nil
+}
+
+// Update updates an exchange rate
+func (r *ExchangeRateRepository) Update(rate *models.ExchangeRate) error {
+	return r.db.Save(rate).Error
+}
+
+// Delete deletes an exchange rate
+func (r *ExchangeRateRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.ExchangeRate{}, "id = ?", id).Error
+}
+
+// Upsert creates exchange rates, overwriting the rate of an existing date and currency pair
+func (r *ExchangeRateRepository) Upsert(rates []models.ExchangeRate) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "rate_date"}, {Name: "from_currency"}, {Name: "to_currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&rates).Error
+}
+
+// FindLatest retrieves the most recent rate of a currency pair on or before the given date
+func (r *ExchangeRateRepository) FindLatest(from, to string, date time.Time) (*models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	if err := r.db.
+		Where("from_currency = ? AND to_currency = ? AND rate_date <= ?", from, to, date).
+		Order("rate_date DESC").
+		First(&rate).Error; err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// currencyCodePattern matches ISO 4217 currency codes
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// CurrencyRates maps currency codes to the rate converting them into a reporting currency.
+// Amounts in currencies missing from the map are summed as they are.
+type CurrencyRates map[string]decimal.Decimal
+
+// Convert converts an amount in the given currency into the reporting currency
+func (cr CurrencyRates) Convert(amount decimal.Decimal, currency string) decimal.Decimal {
+	if rate, ok := cr[currency]; ok {
+		return amount.Mul(rate)
+	}
+	return amount
+}
+
+// convert wraps an amount expression so that each row is converted by its currency column
+func (cr CurrencyRates) convert(amountExpr, currencyColumn string) string {
+	if len(cr) == 0 {
+		return amountExpr
+	}
+
+	codes := make([]string, 0, len(cr))
+	for code := range cr {
+		if currencyCodePattern.MatchString(code) {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return amountExpr
+	}
+	sort.Strings(codes)
+
+	var b strings.Builder
+	b.WriteString("(" + amountExpr + ") * CASE " + currencyColumn)
+	for _, code := range codes {
+		b.WriteString(" WHEN '" + code + "' THEN " + cr[code].String())
+	}
+	b.WriteString(" ELSE 1 END")
+	return b.String()
+}
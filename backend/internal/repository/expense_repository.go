@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// ExpenseRepository handles database operations for expenses
+type ExpenseRepository struct {
+	db *gorm.DB
+}
+
+// NewExpenseRepository creates a new ExpenseRepository
+func NewExpenseRepository(db *gorm.DB) *ExpenseRepository {
+	return &ExpenseRepository{db: db}
+}
+
+// Create creates a new expense
+func (r *ExpenseRepository) Create(expense *models.Expense) error {
+	return r.db.Create(expense).Error
+}
+
+// GetByID retrieves an expense by ID
+func (r *ExpenseRepository) GetByID(id uuid.UUID) (*models.Expense, error) {
+	var expense models.Expense
+	if err := r.db.First(&expense, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &expense, nil
+}
+
+// ExpenseListParams represents parameters for listing expenses
+type ExpenseListParams struct {
+	ProjectID uuid.UUID
+	Category  string
+	StartDate *time.Time
+	EndDate   *time.Time
+	Page      int
+	PerPage   int
+}
+
+// List retrieves expenses with filtering and pagination
+func (r *ExpenseRepository) List(params ExpenseListParams) ([]models.Expense, int64, error) {
+	var expenses []models.Expense
+	var total int64
+
+	query := r.db.Model(&models.Expense{}).Where("project_id = ?", params.ProjectID)
+
+	// Apply filters
+	if params.Category != "" {
+		query = query.Where("category = ?", params.Category)
+	}
+
+	if params.StartDate != nil {
+		query = query.Where("incurred_date >= ?", *params.StartDate)
+	}
+
+	if params.EndDate != nil {
+		query = query.Where("incurred_date <= ?", *params.EndDate)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (params.Page - 1) * params.PerPage
+	if err := query.
+		Order("incurred_date DESC").
+		Offset(offset).
+		Limit(params.PerPage).
+		Find(&expenses).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return expenses, total, nil
+}
+
+// Update updates an expense
+func (r *ExpenseRepository) Update(expense *models.Expense) error {
+	return r.db.Save(expense).Error
+}
+
+// Delete deletes an expense
+func (r *ExpenseRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Expense{}, "id = ?", id).Error
+}
+
+// GetCurrencies retrieves the distinct currencies of the expenses of a project
+func (r *ExpenseRepository) GetCurrencies(projectID uuid.UUID) ([]string, error) {
+	var currencies []string
+	if err := r.db.Model(&models.Expense{}).
+		Where("project_id = ?", projectID).
+		Distinct().
+		Pluck("currency", &currencies).Error; err != nil {
+		return nil, err
+	}
+	return currencies, nil
+}
+
+// GetSummaryByProject calculates the total expense amount for a project.
+// Amounts are converted into the reporting currency by the given rates.
+func (r *ExpenseRepository) GetSummaryByProject(projectID uuid.UUID, period DateRange, rates CurrencyRates) (*ExpenseSummary, error) {
+	var summary ExpenseSummary
+
+	query := r.db.Model(&models.Expense{}).
+		Select(`
+			COUNT(*) as count,
+			COALESCE(SUM(`+rates.convert("amount", "currency")+`), 0) as total_amount
+		`).
+		Where("project_id = ?", projectID)
+
+	if err := period.apply(query, "incurred_date").
+		Scan(&summary).Error; err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// GetSummaryByCategory calculates expense amounts grouped by category for a project
+func (r *ExpenseRepository) GetSummaryByCategory(projectID uuid.UUID, period DateRange, rates CurrencyRates) ([]ExpenseCategorySummary, error) {
+	var summaries []ExpenseCategorySummary
+
+	query := r.db.Model(&models.Expense{}).
+		Select(`
+			category,
+			COUNT(*) as count,
+			COALESCE(SUM(`+rates.convert("amount", "currency")+`), 0) as amount
+		`).
+		Where("project_id = ?", projectID)
+
+	if err := period.apply(query, "incurred_date").
+		Group("category").
+		Order("category ASC").
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// GetDailyAmounts calculates expense amounts per incurred date for a project
+func (r *ExpenseRepository) GetDailyAmounts(projectID uuid.UUID, period DateRange, rates CurrencyRates) ([]DailyExpenseSummary, error) {
+	var summaries []DailyExpenseSummary
+
+	query := r.db.Model(&models.Expense{}).
+		Select(`
+			incurred_date,
+			COALESCE(SUM(`+rates.convert("amount", "currency")+`), 0) as amount
+		`).
+		Where("project_id = ?", projectID)
+
+	if err := period.apply(query, "incurred_date").
+		Group("incurred_date").
+		Order("incurred_date ASC").
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// ExpenseSummary represents aggregated expense data
+type ExpenseSummary struct {
+	Count       int             `json:"count"`
+	TotalAmount decimal.Decimal `json:"total_amount"`
+}
+
+// ExpenseCategorySummary represents expense summary by category
+type ExpenseCategorySummary struct {
+	Category string          `json:"category"`
+	Count    int             `json:"count"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// DailyExpenseSummary represents expense amount by incurred date
+type DailyExpenseSummary struct {
+	IncurredDate time.Time       `json:"incurred_date"`
+	Amount       decimal.Decimal `json:"amount"`
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// HolidayRepository handles database operations for the holiday calendar
+type HolidayRepository struct {
+	db *gorm.DB
+}
+
+// NewHolidayRepository creates a new HolidayRepository
+func NewHolidayRepository(db *gorm.DB) *HolidayRepository {
+	return &HolidayRepository{db: db}
+}
+
+// Create creates a new holiday
+func (r *HolidayRepository) Create(holiday *models.Holiday) error {
+	return r.db.Create(holiday).Error
+}
+
+// GetByID retrieves a holiday by ID
+func (r *HolidayRepository) GetByID(id uuid.UUID) (*models.Holiday, error) {
+	var holiday models.Holiday
+	if err := r.db.First(&holiday, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &holiday, nil
+}
+
+// GetByDate retrieves the holiday on a date
+func (r *HolidayRepository) GetByDate(date time.Time) (*models.Holiday, error) {
+	var holiday models.Holiday
+	if err := r.db.First(&holiday, "date = ?", date).Error; err != nil {
+		return nil, err
+	}
+	return &holiday, nil
+}
+
+// List retrieves the holidays within the period ordered by date
+func (r *HolidayRepository) List(period DateRange) ([]models.Holiday, error) {
+	var holidays []models.Holiday
+	query := period.apply(r.db.Model(&models.Holiday{}), "date")
+	err := query.Order("date ASC").Find(&holidays).Error
+	return holidays, err
+}
+
+// Delete deletes a holiday
+func (r *HolidayRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Holiday{}, "id = ?", id).Error
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// InvoiceRepository handles database operations for invoices
+type InvoiceRepository struct {
+	db *gorm.DB
+}
+
+// NewInvoiceRepository creates a new InvoiceRepository
+func NewInvoiceRepository(db *gorm.DB) *InvoiceRepository {
+	return &InvoiceRepository{db: db}
+}
+
+// Create creates a new invoice together with its lines
+func (r *InvoiceRepository) Create(invoice *models.Invoice) error {
+	return r.db.Create(invoice).Error
+}
+
+// GetByID retrieves an invoice by ID with its lines
+func (r *InvoiceRepository) GetByID(id uuid.UUID) (*models.Invoice, error) {
+	var invoice models.Invoice
+	if err := r.db.
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sort_order ASC")
+		}).
+		First(&invoice, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// GetByIDForUpdate retrieves an invoice by ID without its lines and locks its row until the
+// transaction of the repository ends
+func (r *InvoiceRepository) GetByIDForUpdate(id uuid.UUID) (*models.Invoice, error) {
+	var invoice models.Invoice
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&invoice, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// InvoiceListParams represents parameters for listing invoices
+type InvoiceListParams struct {
+	ProjectID uuid.UUID
+	Status    string
+	Page      int
+	PerPage   int
+}
+
+// List retrieves invoices of a project with filtering and pagination, newest first
+func (r *InvoiceRepository) List(params InvoiceListParams) ([]models.Invoice, int64, error) {
+	var invoices []models.Invoice
+	var total int64
+
+	query := r.db.Model(&models.Invoice{}).Where("project_id = ?", params.ProjectID)
+
+	// Apply filters
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (params.Page - 1) * params.PerPage
+	if err := query.
+		Order("period_start DESC, created_at DESC").
+		Offset(offset).
+		Limit(params.PerPage).
+		Find(&invoices).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return invoices, total, nil
+}
+
+// Update updates an invoice without touching its lines
+func (r *InvoiceRepository) Update(invoice *models.Invoice) error {
+	return r.db.Omit("Project", "Lines").Save(invoice).Error
+}
+
+// Delete deletes an invoice and its lines
+func (r *InvoiceRepository) Delete(id uuid.UUID) error {
+	if err := r.db.Delete(&models.InvoiceLine{}, "invoice_id = ?", id).Error; err != nil {
+		return err
+	}
+	return r.db.Delete(&models.Invoice{}, "id = ?", id).Error
+}
+
+// ReplaceLines replaces the lines of an invoice
+func (r *InvoiceRepository) ReplaceLines(invoiceID uuid.UUID, lines []models.InvoiceLine) error {
+	if err := r.db.Delete(&models.InvoiceLine{}, "invoice_id = ?", invoiceID).Error; err != nil {
+		return err
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	for i := range lines {
+		lines[i].InvoiceID = invoiceID
+	}
+	return r.db.Create(&lines).Error
+}
+
+// NextSequenceNumber takes the next invoice sequence number from the invoice sequence, whose row
+// stays locked until the transaction of the repository ends so that concurrent issues never take
+// the same number. A missing sequence is started from the numbers already issued.
+func (r *InvoiceRepository) NextSequenceNumber() (int, error) {
+	sequence, err := r.lockSequence()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		var last int
+		if err := r.db.Model(&models.Invoice{}).
+			Select("COALESCE(MAX(sequence_number), 0)").
+			Scan(&last).Error; err != nil {
+			return 0, err
+		}
+		start := models.InvoiceSequence{ID: models.InvoiceSequenceID, LastNumber: last}
+		if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&start).Error; err != nil {
+			return 0, err
+		}
+		sequence, err = r.lockSequence()
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	sequence.LastNumber++
+	if err := r.db.Model(sequence).Update("last_number", sequence.LastNumber).Error; err != nil {
+		return 0, err
+	}
+	return sequence.LastNumber, nil
+}
+
+// lockSequence retrieves the invoice sequence and locks its row
+func (r *InvoiceRepository) lockSequence() (*models.InvoiceSequence, error) {
+	var sequence models.InvoiceSequence
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&sequence, "id = ?", models.InvoiceSequenceID).Error; err != nil {
+		return nil, err
+	}
+	return &sequence, nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// MemberRateRepository handles database operations for member rate history
+type MemberRateRepository struct {
+	db *gorm.DB
+}
+
+// NewMemberRateRepository creates a new MemberRateRepository
+func NewMemberRateRepository(db *gorm.DB) *MemberRateRepository {
+	return &MemberRateRepository{db: db}
+}
+
+// Create creates a new member rate
+func (r *MemberRateRepository) Create(rate *models.MemberRate) error {
+	return r.db.Create(rate).Error
+}
+
+// ListByMember retrieves the rate history of a member, oldest first
+func (r *MemberRateRepository) ListByMember(memberID uuid.UUID) ([]models.MemberRate, error) {
+	var rates []models.MemberRate
+	err := r.db.
+		Where("member_id = ?", memberID).
+		Order("effective_from ASC").
+		Find(&rates).Error
+	return rates, err
+}
+
+// Update updates a member rate
+func (r *MemberRateRepository) Update(rate *models.MemberRate) error {
+	return r.db.Omit("Member").Save(rate).Error
+}
+
+// GetEffective retrieves the rate of a member that applies on the given date.
+// Dates before the history begins fall back to the earliest rate.
+func (r *MemberRateRepository) GetEffective(memberID uuid.UUID, date time.Time) (*models.MemberRate, error) {
+	var rate models.MemberRate
+	err := r.db.
+		Where("member_id = ? AND effective_from <= ?", memberID, date).
+		Order("effective_from DESC").
+		First(&rate).Error
+	if err == nil {
+		return &rate, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if err := r.db.
+		Where("member_id = ?", memberID).
+		Order("effective_from ASC").
+		First(&rate).Error; err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
@@ -126,6 +128,32 @@ func (r *MemberRepository) GetProjectMember(projectID, memberID uuid.UUID) (*mod
 	return &pm, nil
 }
 
+// GetProjectMemberOn retrieves the assignment of a member to a project that was not
+// yet over on the given date, including ones the member has left since
+func (r *MemberRepository) GetProjectMemberOn(projectID, memberID uuid.UUID, date time.Time) (*models.ProjectMember, error) {
+	var pm models.ProjectMember
+	if err := r.db.
+		Where("project_id = ? AND member_id = ?", projectID, memberID).
+		Where("left_at IS NULL OR left_at >= ?", date).
+		Order("joined_at DESC").
+		First(&pm).Error; err != nil {
+		return nil, err
+	}
+	return &pm, nil
+}
+
+// GetAssignmentsOn retrieves the project assignments of a member in effect on the given date
+func (r *MemberRepository) GetAssignmentsOn(memberID uuid.UUID, date time.Time) ([]models.ProjectMember, error) {
+	var projectMembers []models.ProjectMember
+	if err := r.db.
+		Where("member_id = ? AND joined_at <= ?", memberID, date).
+		Where("left_at IS NULL OR left_at >= ?", date).
+		Find(&projectMembers).Error; err != nil {
+		return nil, err
+	}
+	return projectMembers, nil
+}
+
 // GetProjectMembers retrieves all active project member assignments
 func (r *MemberRepository) GetProjectMembers(projectID uuid.UUID) ([]models.ProjectMember, error) {
 	var projectMembers []models.ProjectMember
@@ -137,3 +165,18 @@ func (r *MemberRepository) GetProjectMembers(projectID uuid.UUID) ([]models.Proj
 	}
 	return projectMembers, nil
 }
+
+// GetProjectMembersActiveAfter retrieves the project member assignments that have not
+// ended by the given date
+func (r *MemberRepository) GetProjectMembersActiveAfter(projectID uuid.UUID, date time.Time) ([]models.ProjectMember, error) {
+	var projectMembers []models.ProjectMember
+	if err := r.db.
+		Preload("Member").
+		Where("project_id = ?", projectID).
+		Where("left_at IS NULL OR left_at > ?", date).
+		Order("joined_at ASC").
+		Find(&projectMembers).Error; err != nil {
+		return nil, err
+	}
+	return projectMembers, nil
+}
@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"github.com/your-org/project-budget-tracker/backend/internal/models"
@@ -45,13 +48,13 @@ func (r *ProjectRepository) GetByIDWithDetails(id uuid.UUID) (*models.Project, e
 
 // ProjectListParams represents parameters for listing projects
 type ProjectListParams struct {
-	UserID uuid.UUID
-	Page   int
+	UserID  uuid.UUID
+	Page    int
 	PerPage int
-	Status string
-	Search string
-	Sort   string
-	Order  string
+	Status  string
+	Search  string
+	Sort    string
+	Order   string
 }
 
 // List retrieves projects with pagination, filtering, and search
@@ -167,10 +170,77 @@ func (r *ProjectRepository) GetProjectStats(projectID uuid.UUID) (*ProjectStats,
 
 // ProjectStats represents project statistics
 type ProjectStats struct {
-	ProjectID          uuid.UUID `json:"project_id"`
-	TotalTasks         int       `json:"total_tasks"`
-	TotalPlannedHours  float64   `json:"total_planned_hours"`
-	TotalActualHours   float64   `json:"total_actual_hours"`
-	CompletedTasks     int       `json:"completed_tasks"`
-	CompletionRate     float64   `json:"completion_rate"`
+	ProjectID         uuid.UUID       `json:"project_id"`
+	TotalTasks        int             `json:"total_tasks"`
+	TotalPlannedHours decimal.Decimal `json:"total_planned_hours"`
+	TotalActualHours  decimal.Decimal `json:"total_actual_hours"`
+	CompletedTasks    int             `json:"completed_tasks"`
+	CompletionRate    float64         `json:"completion_rate"`
+}
+
+// PortfolioParams represents parameters for the portfolio of an owner's projects
+type PortfolioParams struct {
+	OwnerID  uuid.UUID
+	Statuses []string
+	// Period keeps the projects whose schedule overlaps it; a missing start or end date is open-ended
+	Period DateRange
+}
+
+// PortfolioProject represents the stored budget figures of a project in a portfolio
+type PortfolioProject struct {
+	ProjectID    uuid.UUID
+	Name         string
+	Status       string
+	BudgetAmount *decimal.Decimal
+	StartDate    *time.Time
+	EndDate      *time.Time
+	Currency     string
+	Revenue      decimal.Decimal
+	TotalCost    decimal.Decimal
+	Profit       decimal.Decimal
+	ProfitRate   decimal.Decimal
+	ActiveAlerts int
+}
+
+// ListPortfolio retrieves the projects of an owner with their budget figures and the number of
+// unresolved alerts in a single query. Projects without a budget yet have zero figures.
+func (r *ProjectRepository) ListPortfolio(params PortfolioParams) ([]PortfolioProject, error) {
+	var projects []PortfolioProject
+
+	alerts := r.db.Model(&models.Alert{}).
+		Select("project_id, COUNT(*) AS active_alerts").
+		Where("status <> ?", models.AlertStatusResolved).
+		Group("project_id")
+
+	query := r.db.Table("projects p").
+		Select(`
+			p.id AS project_id,
+			p.name,
+			p.status,
+			p.budget_amount,
+			p.start_date,
+			p.end_date,
+			COALESCE(b.currency, 'JPY') AS currency,
+			COALESCE(b.revenue, 0) AS revenue,
+			COALESCE(b.total_cost, 0) AS total_cost,
+			COALESCE(b.profit, 0) AS profit,
+			COALESCE(b.profit_rate, 0) AS profit_rate,
+			COALESCE(a.active_alerts, 0) AS active_alerts
+		`).
+		Joins("LEFT JOIN budgets b ON b.project_id = p.id").
+		Joins("LEFT JOIN (?) a ON a.project_id = p.id", alerts).
+		Where("p.deleted_at IS NULL AND p.user_id = ?", params.OwnerID)
+
+	if len(params.Statuses) > 0 {
+		query = query.Where("p.status IN ?", params.Statuses)
+	}
+	if params.Period.From != nil {
+		query = query.Where("(p.end_date IS NULL OR p.end_date >= ?)", *params.Period.From)
+	}
+	if params.Period.To != nil {
+		query = query.Where("(p.start_date IS NULL OR p.start_date <= ?)", *params.Period.To)
+	}
+
+	err := query.Order("p.name ASC").Scan(&projects).Error
+	return projects, err
 }
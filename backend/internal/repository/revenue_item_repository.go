@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// RevenueItemRepository handles database operations for revenue items
+type RevenueItemRepository struct {
+	db *gorm.DB
+}
+
+// NewRevenueItemRepository creates a new RevenueItemRepository
+func NewRevenueItemRepository(db *gorm.DB) *RevenueItemRepository {
+	return &RevenueItemRepository{db: db}
+}
+
+// Create creates a new revenue item
+func (r *RevenueItemRepository) Create(item *models.RevenueItem) error {
+	return r.db.Create(item).Error
+}
+
+// GetByID retrieves a revenue item by ID
+func (r *RevenueItemRepository) GetByID(id uuid.UUID) (*models.RevenueItem, error) {
+	var item models.RevenueItem
+	if err := r.db.Preload("Task").First(&item, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ListByProject retrieves the revenue items of a project in order of planned date,
+// optionally narrowed to one status
+func (r *RevenueItemRepository) ListByProject(projectID uuid.UUID, status string) ([]models.RevenueItem, error) {
+	var items []models.RevenueItem
+
+	query := r.db.Preload("Task").Where("project_id = ?", projectID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Order("planned_date ASC, created_at ASC").Find(&items).Error
+	return items, err
+}
+
+// Update updates a revenue item
+func (r *RevenueItemRepository) Update(item *models.RevenueItem) error {
+	return r.db.Omit("Project", "Task").Save(item).Error
+}
+
+// Delete deletes a revenue item
+func (r *RevenueItemRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.RevenueItem{}, "id = ?", id).Error
+}
+
+// GetCurrencies retrieves the distinct currencies of the revenue items of a project
+func (r *RevenueItemRepository) GetCurrencies(projectID uuid.UUID) ([]string, error) {
+	var currencies []string
+	if err := r.db.Model(&models.RevenueItem{}).
+		Where("project_id = ?", projectID).
+		Distinct().
+		Pluck("currency", &currencies).Error; err != nil {
+		return nil, err
+	}
+	return currencies, nil
+}
+
+// GetSummaryByProject calculates the revenue of a project, split into the items
+// already invoiced or received and those still planned.
+// Amounts are converted into the reporting currency by the given rates.
+func (r *RevenueItemRepository) GetSummaryByProject(projectID uuid.UUID, rates CurrencyRates) (*RevenueSummary, error) {
+	var summary RevenueSummary
+
+	amount := rates.convert("amount", "currency")
+	if err := r.db.Model(&models.RevenueItem{}).
+		Select(`
+			COUNT(*) as count,
+			COALESCE(SUM(`+amount+`), 0) as total_amount,
+			COALESCE(SUM(CASE WHEN status IN ('invoiced', 'received') THEN `+amount+` ELSE 0 END), 0) as recognized_amount,
+			COALESCE(SUM(CASE WHEN status = 'planned' THEN `+amount+` ELSE 0 END), 0) as planned_amount
+		`).
+		Where("project_id = ?", projectID).
+		Scan(&summary).Error; err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// RevenueSummary represents aggregated revenue item data
+type RevenueSummary struct {
+	Count            int             `json:"count"`
+	TotalAmount      decimal.Decimal `json:"total_amount"`
+	RecognizedAmount decimal.Decimal `json:"recognized_amount"`
+	PlannedAmount    decimal.Decimal `json:"planned_amount"`
+}
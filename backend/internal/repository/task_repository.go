@@ -2,9 +2,11 @@ package repository
 
 import (
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
 )
 
 // TaskRepository handles database operations for tasks
@@ -91,24 +93,22 @@ func (r *TaskRepository) GetProjectSummary(projectID uuid.UUID) (*TaskSummary, e
 	}
 
 	summary.ProjectID = projectID
-	summary.VarianceHours = summary.TotalActualHours - summary.TotalPlannedHours
-	if summary.TotalPlannedHours > 0 {
-		summary.VariancePercentage = (summary.VarianceHours / summary.TotalPlannedHours) * 100
-	}
+	summary.VarianceHours = summary.TotalActualHours.Sub(summary.TotalPlannedHours)
+	summary.VariancePercentage = money.Percentage(summary.VarianceHours, summary.TotalPlannedHours)
 
 	return &summary, nil
 }
 
 // TaskSummary represents the aggregated task data for a project
 type TaskSummary struct {
-	ProjectID          uuid.UUID `json:"project_id"`
-	TotalTasks         int       `json:"total_tasks"`
-	TotalPlannedHours  float64   `json:"total_planned_hours"`
-	TotalActualHours   float64   `json:"total_actual_hours"`
-	VarianceHours      float64   `json:"variance_hours"`
-	VariancePercentage float64   `json:"variance_percentage"`
-	CompletedTasks     int       `json:"completed_tasks"`
-	InProgressTasks    int       `json:"in_progress_tasks"`
-	TodoTasks          int       `json:"todo_tasks"`
-	BlockedTasks       int       `json:"blocked_tasks"`
+	ProjectID          uuid.UUID       `json:"project_id"`
+	TotalTasks         int             `json:"total_tasks"`
+	TotalPlannedHours  decimal.Decimal `json:"total_planned_hours"`
+	TotalActualHours   decimal.Decimal `json:"total_actual_hours"`
+	VarianceHours      decimal.Decimal `json:"variance_hours"`
+	VariancePercentage float64         `json:"variance_percentage"`
+	CompletedTasks     int             `json:"completed_tasks"`
+	InProgressTasks    int             `json:"in_progress_tasks"`
+	TodoTasks          int             `json:"todo_tasks"`
+	BlockedTasks       int             `json:"blocked_tasks"`
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"github.com/your-org/project-budget-tracker/backend/internal/models"
@@ -12,6 +13,8 @@ import (
 // TimeEntryRepository handles database operations for time entries
 type TimeEntryRepository struct {
 	db *gorm.DB
+	// approvedOnly narrows the cost summaries to the time entries of approved timesheets
+	approvedOnly bool
 }
 
 // NewTimeEntryRepository creates a new TimeEntryRepository
@@ -19,6 +22,28 @@ func NewTimeEntryRepository(db *gorm.DB) *TimeEntryRepository {
 	return &TimeEntryRepository{db: db}
 }
 
+// ApprovedOnly returns a repository whose cost summaries count only the time entries
+// of approved timesheets
+func (r *TimeEntryRepository) ApprovedOnly() *TimeEntryRepository {
+	return &TimeEntryRepository{db: r.db, approvedOnly: true}
+}
+
+// approvedTimesheetCondition is the SQL condition that a time entry is in an approved timesheet of its member
+const approvedTimesheetCondition = `EXISTS (
+	SELECT 1 FROM timesheets
+	WHERE timesheets.member_id = time_entries.member_id
+		AND timesheets.status = 'approved'
+		AND time_entries.work_date BETWEEN timesheets.week_start AND timesheets.week_end
+)`
+
+// applyApproval narrows the query to the time entries of approved timesheets when the repository is scoped so
+func (r *TimeEntryRepository) applyApproval(query *gorm.DB) *gorm.DB {
+	if r.approvedOnly {
+		return query.Where(approvedTimesheetCondition)
+	}
+	return query
+}
+
 // Create creates a new time entry
 func (r *TimeEntryRepository) Create(entry *models.TimeEntry) error {
 	return r.db.Create(entry).Error
@@ -40,10 +65,75 @@ type TimeEntryListParams struct {
 	MemberID  *uuid.UUID
 	StartDate *time.Time
 	EndDate   *time.Time
+	TimeOfDay *TimeOfDayRange
 	Page      int
 	PerPage   int
 }
 
+// TimeOfDayRange is a range of clock times as HH:MM, from inclusive to exclusive. A range whose
+// end is before its start wraps past midnight, such as 22:00 to 05:00.
+type TimeOfDayRange struct {
+	From string
+	To   string
+}
+
+// apply narrows the query to the time entries whose local start and end times overlap the range.
+// An entry whose end time is not after its start time runs past midnight.
+func (tr TimeOfDayRange) apply(query *gorm.DB) *gorm.DB {
+	query = query.Where("time_entries.local_start_time IS NOT NULL AND time_entries.local_end_time IS NOT NULL")
+	if tr.From < tr.To {
+		return query.Where(`(
+			(time_entries.local_start_time < time_entries.local_end_time
+				AND time_entries.local_start_time < ? AND time_entries.local_end_time > ?)
+			OR (time_entries.local_start_time >= time_entries.local_end_time
+				AND (time_entries.local_start_time < ? OR time_entries.local_end_time > ?))
+		)`, tr.To, tr.From, tr.To, tr.From)
+	}
+	// Both the range and the entries past midnight contain midnight
+	return query.Where(`(
+		time_entries.local_start_time >= time_entries.local_end_time
+		OR time_entries.local_end_time > ? OR time_entries.local_start_time < ?
+	)`, tr.From, tr.To)
+}
+
+// DateRange represents an optional inclusive date range used to narrow aggregations
+type DateRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// apply adds the date range conditions for the given date column to the query
+func (dr DateRange) apply(query *gorm.DB, column string) *gorm.DB {
+	if dr.From != nil {
+		query = query.Where(column+" >= ?", *dr.From)
+	}
+	if dr.To != nil {
+		query = query.Where(column+" <= ?", *dr.To)
+	}
+	return query
+}
+
+// laborCostExpr is the SQL expression for the cost of a time entry in its own currency
+const laborCostExpr = "time_entries.hours * COALESCE(time_entries.hourly_rate_snapshot, 0)"
+
+// billValueExpr is the SQL expression for the value of a time entry at its bill rate in its own currency
+const billValueExpr = "time_entries.hours * COALESCE(time_entries.bill_rate_snapshot, 0)"
+
+// overheadMultiplierExpr is the SQL expression for the overhead multiplier of the department of a
+// time entry's member in effect on its work date. Members of departments without one are charged at 1.
+const overheadMultiplierExpr = `COALESCE((
+	SELECT department_overheads.multiplier
+	FROM department_overheads
+	JOIN members AS overhead_members ON overhead_members.department = department_overheads.department
+	WHERE overhead_members.id = time_entries.member_id
+	  AND department_overheads.effective_from <= time_entries.work_date
+	ORDER BY department_overheads.effective_from DESC
+	LIMIT 1
+), 1)`
+
+// loadedCostExpr is the SQL expression for the fully loaded cost of a time entry in its own currency
+const loadedCostExpr = laborCostExpr + " * " + overheadMultiplierExpr
+
 // List retrieves time entries with filtering and pagination
 func (r *TimeEntryRepository) List(params TimeEntryListParams) ([]models.TimeEntry, int64, error) {
 	var entries []models.TimeEntry
@@ -73,6 +163,10 @@ func (r *TimeEntryRepository) List(params TimeEntryListParams) ([]models.TimeEnt
 		query = query.Where("work_date <= ?", *params.EndDate)
 	}
 
+	if params.TimeOfDay != nil {
+		query = params.TimeOfDay.apply(query)
+	}
+
 	// Count total
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -116,17 +210,152 @@ func (r *TimeEntryRepository) GetByProjectID(projectID uuid.UUID) ([]models.Time
 	return entries, nil
 }
 
-// GetSummaryByProject calculates total hours and cost for a project
-func (r *TimeEntryRepository) GetSummaryByProject(projectID uuid.UUID) (*TimeEntrySummary, error) {
-	var summary TimeEntrySummary
+// GetUnbilledByProject retrieves the billable time entries of a project within the period
+// that are not on any invoice yet
+func (r *TimeEntryRepository) GetUnbilledByProject(projectID uuid.UUID, period DateRange) ([]models.TimeEntry, error) {
+	var entries []models.TimeEntry
+
+	query := r.db.
+		Joins("JOIN tasks ON tasks.id = time_entries.task_id").
+		Where("tasks.project_id = ? AND time_entries.is_billable = ? AND time_entries.invoice_id IS NULL", projectID, true)
+
+	if err := period.apply(query, "time_entries.work_date").
+		Preload("Member").
+		Preload("Task").
+		Order("time_entries.work_date ASC").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetByInvoice retrieves the time entries billed by an invoice
+func (r *TimeEntryRepository) GetByInvoice(invoiceID uuid.UUID) ([]models.TimeEntry, error) {
+	var entries []models.TimeEntry
+	if err := r.db.
+		Where("invoice_id = ?", invoiceID).
+		Preload("Member").
+		Preload("Task").
+		Order("work_date ASC").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetByPeriod retrieves the time entries within the period, optionally narrowed to one member
+func (r *TimeEntryRepository) GetByPeriod(period DateRange, memberID *uuid.UUID) ([]models.TimeEntry, error) {
+	var entries []models.TimeEntry
+
+	query := r.db.Model(&models.TimeEntry{})
+	if memberID != nil {
+		query = query.Where("member_id = ?", *memberID)
+	}
+
+	// Members removed since keep their rate history
+	if err := period.apply(query, "work_date").
+		Preload("Member", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
+		Preload("Task").
+		Order("work_date ASC, created_at ASC").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetHoursByMemberAndDate calculates the hours a member has recorded on a work date across all
+// projects, leaving out the time entry being changed, if any
+func (r *TimeEntryRepository) GetHoursByMemberAndDate(memberID uuid.UUID, workDate time.Time, excludeID *uuid.UUID) (decimal.Decimal, error) {
+	var hours decimal.Decimal
+
+	query := r.db.Model(&models.TimeEntry{}).
+		Select("COALESCE(SUM(hours), 0)").
+		Where("member_id = ? AND work_date = ?", memberID, workDate)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	if err := query.Row().Scan(&hours); err != nil {
+		return decimal.Zero, err
+	}
+	return hours, nil
+}
+
+// GetOverlapping retrieves the time entries of a member whose start and end times overlap the
+// given range, leaving out the time entry being changed, if any
+func (r *TimeEntryRepository) GetOverlapping(memberID uuid.UUID, startedAt, endedAt time.Time, excludeID *uuid.UUID) ([]models.TimeEntry, error) {
+	var entries []models.TimeEntry
+
+	query := r.db.Where("member_id = ? AND started_at < ? AND ended_at > ?", memberID, endedAt, startedAt)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	if err := query.Order("started_at ASC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// UpdateRateSnapshot replaces the cost rate snapshot of a time entry
+func (r *TimeEntryRepository) UpdateRateSnapshot(id uuid.UUID, hourlyRate decimal.Decimal, currency, source string) error {
+	return r.db.Model(&models.TimeEntry{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"hourly_rate_snapshot": hourlyRate,
+			"currency":             currency,
+			"rate_source":          source,
+		}).Error
+}
+
+// AssignInvoice links the time entries to an invoice
+func (r *TimeEntryRepository) AssignInvoice(ids []uuid.UUID, invoiceID uuid.UUID) error {
+	return r.db.Model(&models.TimeEntry{}).
+		Where("id IN ?", ids).
+		Update("invoice_id", invoiceID).Error
+}
+
+// ReleaseInvoice unlinks all time entries from an invoice
+func (r *TimeEntryRepository) ReleaseInvoice(invoiceID uuid.UUID) error {
+	return r.db.Model(&models.TimeEntry{}).
+		Where("invoice_id = ?", invoiceID).
+		Update("invoice_id", nil).Error
+}
 
+// GetCurrencies retrieves the distinct currencies of the time entries of a project
+func (r *TimeEntryRepository) GetCurrencies(projectID uuid.UUID) ([]string, error) {
+	var currencies []string
 	if err := r.db.Model(&models.TimeEntry{}).
+		Joins("JOIN tasks ON tasks.id = time_entries.task_id").
+		Where("tasks.project_id = ?", projectID).
+		Distinct().
+		Pluck("time_entries.currency", &currencies).Error; err != nil {
+		return nil, err
+	}
+	return currencies, nil
+}
+
+// GetSummaryByProject calculates total hours and cost for a project, split into billable
+// and non-billable time. Amounts are converted into the reporting currency by the given rates.
+func (r *TimeEntryRepository) GetSummaryByProject(projectID uuid.UUID, period DateRange, rates CurrencyRates) (*TimeEntrySummary, error) {
+	var summary TimeEntrySummary
+
+	query := r.db.Model(&models.TimeEntry{}).
 		Select(`
 			COALESCE(SUM(time_entries.hours), 0) as total_hours,
-			COALESCE(SUM(time_entries.hours * COALESCE(time_entries.hourly_rate_snapshot, 0)), 0) as total_cost
+			COALESCE(SUM(`+rates.convert(laborCostExpr, "time_entries.currency")+`), 0) as total_cost,
+			COALESCE(SUM(`+rates.convert(loadedCostExpr, "time_entries.currency")+`), 0) as loaded_cost,
+			COALESCE(SUM(CASE WHEN time_entries.is_billable THEN time_entries.hours ELSE 0 END), 0) as billable_hours,
+			COALESCE(SUM(CASE WHEN time_entries.is_billable THEN 0 ELSE time_entries.hours END), 0) as non_billable_hours,
+			COALESCE(SUM(CASE WHEN time_entries.is_billable THEN `+rates.convert(billValueExpr, "time_entries.currency")+` ELSE 0 END), 0) as billable_value,
+			COALESCE(SUM(CASE WHEN time_entries.is_billable THEN 0 ELSE `+rates.convert(billValueExpr, "time_entries.currency")+` END), 0) as non_billable_value
 		`).
 		Joins("JOIN tasks ON tasks.id = time_entries.task_id").
-		Where("tasks.project_id = ?", projectID).
+		Where("tasks.project_id = ?", projectID)
+
+	if err := period.apply(r.applyApproval(query), "time_entries.work_date").
 		Scan(&summary).Error; err != nil {
 		return nil, err
 	}
@@ -135,20 +364,23 @@ func (r *TimeEntryRepository) GetSummaryByProject(projectID uuid.UUID) (*TimeEnt
 }
 
 // GetSummaryByMember calculates hours and cost grouped by member for a project
-func (r *TimeEntryRepository) GetSummaryByMember(projectID uuid.UUID) ([]MemberCostSummary, error) {
+func (r *TimeEntryRepository) GetSummaryByMember(projectID uuid.UUID, period DateRange, rates CurrencyRates) ([]MemberCostSummary, error) {
 	var summaries []MemberCostSummary
 
-	if err := r.db.Model(&models.TimeEntry{}).
+	query := r.db.Model(&models.TimeEntry{}).
 		Select(`
 			time_entries.member_id,
 			members.name as member_name,
 			COALESCE(SUM(time_entries.hours), 0) as hours,
-			COALESCE(AVG(time_entries.hourly_rate_snapshot), 0) as hourly_rate,
-			COALESCE(SUM(time_entries.hours * COALESCE(time_entries.hourly_rate_snapshot, 0)), 0) as cost
+			COALESCE(AVG(`+rates.convert("time_entries.hourly_rate_snapshot", "time_entries.currency")+`), 0) as hourly_rate,
+			COALESCE(SUM(`+rates.convert(laborCostExpr, "time_entries.currency")+`), 0) as cost,
+			COALESCE(SUM(`+rates.convert(loadedCostExpr, "time_entries.currency")+`), 0) as loaded_cost
 		`).
 		Joins("JOIN tasks ON tasks.id = time_entries.task_id").
 		Joins("JOIN members ON members.id = time_entries.member_id").
-		Where("tasks.project_id = ?", projectID).
+		Where("tasks.project_id = ?", projectID)
+
+	if err := period.apply(r.applyApproval(query), "time_entries.work_date").
 		Group("time_entries.member_id, members.name").
 		Scan(&summaries).Error; err != nil {
 		return nil, err
@@ -157,17 +389,162 @@ func (r *TimeEntryRepository) GetSummaryByMember(projectID uuid.UUID) ([]MemberC
 	return summaries, nil
 }
 
+// GetSummaryByTask calculates hours and cost grouped by task for a project.
+// Every task of the project is returned, including tasks without time entries in the period.
+func (r *TimeEntryRepository) GetSummaryByTask(projectID uuid.UUID, period DateRange, rates CurrencyRates) ([]TaskCostSummary, error) {
+	var summaries []TaskCostSummary
+
+	joinCondition := "time_entries.task_id = tasks.id"
+	var joinArgs []interface{}
+	if period.From != nil {
+		joinCondition += " AND time_entries.work_date >= ?"
+		joinArgs = append(joinArgs, *period.From)
+	}
+	if period.To != nil {
+		joinCondition += " AND time_entries.work_date <= ?"
+		joinArgs = append(joinArgs, *period.To)
+	}
+	if r.approvedOnly {
+		joinCondition += " AND " + approvedTimesheetCondition
+	}
+
+	if err := r.db.Model(&models.Task{}).
+		Select(`
+			tasks.id as task_id,
+			tasks.name as task_name,
+			tasks.planned_hours,
+			COALESCE(SUM(time_entries.hours), 0) as hours,
+			COALESCE(SUM(`+rates.convert(laborCostExpr, "time_entries.currency")+`), 0) as cost
+		`).
+		Joins("LEFT JOIN time_entries ON "+joinCondition, joinArgs...).
+		Where("tasks.project_id = ?", projectID).
+		Group("tasks.id, tasks.name, tasks.planned_hours").
+		Order("cost DESC, tasks.name ASC").
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// GetDailyCosts calculates hours and cost per work date for a project
+func (r *TimeEntryRepository) GetDailyCosts(projectID uuid.UUID, period DateRange, rates CurrencyRates) ([]DailyCostSummary, error) {
+	var summaries []DailyCostSummary
+
+	query := r.db.Model(&models.TimeEntry{}).
+		Select(`
+			time_entries.work_date,
+			COALESCE(SUM(time_entries.hours), 0) as hours,
+			COALESCE(SUM(`+rates.convert(laborCostExpr, "time_entries.currency")+`), 0) as cost
+		`).
+		Joins("JOIN tasks ON tasks.id = time_entries.task_id").
+		Where("tasks.project_id = ?", projectID)
+
+	if err := period.apply(query, "time_entries.work_date").
+		Group("time_entries.work_date").
+		Order("time_entries.work_date ASC").
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// GetDailyBillable calculates the billable hours and their value at bill rates per work date for a project
+func (r *TimeEntryRepository) GetDailyBillable(projectID uuid.UUID, period DateRange, rates CurrencyRates) ([]DailyBillableSummary, error) {
+	var summaries []DailyBillableSummary
+
+	query := r.db.Model(&models.TimeEntry{}).
+		Select(`
+			time_entries.work_date,
+			COALESCE(SUM(time_entries.hours), 0) as hours,
+			COALESCE(SUM(`+rates.convert(billValueExpr, "time_entries.currency")+`), 0) as value
+		`).
+		Joins("JOIN tasks ON tasks.id = time_entries.task_id").
+		Where("tasks.project_id = ? AND time_entries.is_billable = ?", projectID, true)
+
+	if err := period.apply(query, "time_entries.work_date").
+		Group("time_entries.work_date").
+		Order("time_entries.work_date ASC").
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// GetDailyHoursByTask calculates hours and cost per task and work date for a project
+func (r *TimeEntryRepository) GetDailyHoursByTask(projectID uuid.UUID, period DateRange, rates CurrencyRates) ([]TaskDailySummary, error) {
+	var summaries []TaskDailySummary
+
+	query := r.db.Model(&models.TimeEntry{}).
+		Select(`
+			time_entries.task_id,
+			time_entries.work_date,
+			COALESCE(SUM(time_entries.hours), 0) as hours,
+			COALESCE(SUM(`+rates.convert(laborCostExpr, "time_entries.currency")+`), 0) as cost
+		`).
+		Joins("JOIN tasks ON tasks.id = time_entries.task_id").
+		Where("tasks.project_id = ?", projectID)
+
+	if err := period.apply(query, "time_entries.work_date").
+		Group("time_entries.task_id, time_entries.work_date").
+		Order("time_entries.work_date ASC").
+		Scan(&summaries).Error; err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
 // TimeEntrySummary represents aggregated time entry data
 type TimeEntrySummary struct {
-	TotalHours float64 `json:"total_hours"`
-	TotalCost  float64 `json:"total_cost"`
+	TotalHours       decimal.Decimal `json:"total_hours"`
+	TotalCost        decimal.Decimal `json:"total_cost"`
+	LoadedCost       decimal.Decimal `json:"loaded_cost"`
+	BillableHours    decimal.Decimal `json:"billable_hours"`
+	NonBillableHours decimal.Decimal `json:"non_billable_hours"`
+	BillableValue    decimal.Decimal `json:"billable_value"`
+	NonBillableValue decimal.Decimal `json:"non_billable_value"`
 }
 
 // MemberCostSummary represents cost summary by member
 type MemberCostSummary struct {
-	MemberID   uuid.UUID `json:"member_id"`
-	MemberName string    `json:"member_name"`
-	Hours      float64   `json:"hours"`
-	HourlyRate float64   `json:"hourly_rate"`
-	Cost       float64   `json:"cost"`
+	MemberID   uuid.UUID       `json:"member_id"`
+	MemberName string          `json:"member_name"`
+	Hours      decimal.Decimal `json:"hours"`
+	HourlyRate decimal.Decimal `json:"hourly_rate"`
+	Cost       decimal.Decimal `json:"cost"`
+	LoadedCost decimal.Decimal `json:"loaded_cost"`
+}
+
+// TaskCostSummary represents cost summary by task
+type TaskCostSummary struct {
+	TaskID       uuid.UUID       `json:"task_id"`
+	TaskName     string          `json:"task_name"`
+	PlannedHours decimal.Decimal `json:"planned_hours"`
+	Hours        decimal.Decimal `json:"hours"`
+	Cost         decimal.Decimal `json:"cost"`
+}
+
+// DailyCostSummary represents cost summary by work date
+type DailyCostSummary struct {
+	WorkDate time.Time       `json:"work_date"`
+	Hours    decimal.Decimal `json:"hours"`
+	Cost     decimal.Decimal `json:"cost"`
+}
+
+// DailyBillableSummary represents billable hours and their value at bill rates on a work date
+type DailyBillableSummary struct {
+	WorkDate time.Time       `json:"work_date"`
+	Hours    decimal.Decimal `json:"hours"`
+	Value    decimal.Decimal `json:"value"`
+}
+
+// TaskDailySummary represents hours and cost of a task on a work date
+type TaskDailySummary struct {
+	TaskID   uuid.UUID       `json:"task_id"`
+	WorkDate time.Time       `json:"work_date"`
+	Hours    decimal.Decimal `json:"hours"`
+	Cost     decimal.Decimal `json:"cost"`
 }
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// TimerRepository handles database operations for timers
+type TimerRepository struct {
+	db *gorm.DB
+}
+
+// NewTimerRepository creates a new TimerRepository
+func NewTimerRepository(db *gorm.DB) *TimerRepository {
+	return &TimerRepository{db: db}
+}
+
+// Create creates a new timer
+func (r *TimerRepository) Create(timer *models.Timer) error {
+	return r.db.Omit("Task", "Member").Create(timer).Error
+}
+
+// Update updates a timer
+func (r *TimerRepository) Update(timer *models.Timer) error {
+	return r.db.Omit("Task", "Member").Save(timer).Error
+}
+
+// GetRunningByMember retrieves the running timer of a member
+func (r *TimerRepository) GetRunningByMember(memberID uuid.UUID) (*models.Timer, error) {
+	var timer models.Timer
+	err := r.db.Preload("Task").
+		Where("member_id = ? AND stopped_at IS NULL", memberID).
+		First(&timer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &timer, nil
+}
+
+// ListRunning retrieves the running timers, optionally narrowed to one member, oldest first
+func (r *TimerRepository) ListRunning(memberID *uuid.UUID) ([]models.Timer, error) {
+	var timers []models.Timer
+	query := r.db.Preload("Task").Where("stopped_at IS NULL")
+	if memberID != nil {
+		query = query.Where("member_id = ?", *memberID)
+	}
+	err := query.Order("started_at ASC").Find(&timers).Error
+	return timers, err
+}
+
+// ListStartedBefore retrieves the running timers started before the given time
+func (r *TimerRepository) ListStartedBefore(startedBefore time.Time) ([]models.Timer, error) {
+	var timers []models.Timer
+	err := r.db.Preload("Task").
+		Where("stopped_at IS NULL AND started_at < ?", startedBefore).
+		Order("started_at ASC").
+		Find(&timers).Error
+	return timers, err
+}
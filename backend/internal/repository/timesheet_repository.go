@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+)
+
+// TimesheetRepository handles database operations for timesheets
+type TimesheetRepository struct {
+	db *gorm.DB
+}
+
+// NewTimesheetRepository creates a new TimesheetRepository
+func NewTimesheetRepository(db *gorm.DB) *TimesheetRepository {
+	return &TimesheetRepository{db: db}
+}
+
+// TimesheetListParams represents parameters for listing timesheets
+type TimesheetListParams struct {
+	MemberID  *uuid.UUID
+	Status    string
+	WeekStart *time.Time
+}
+
+// Save creates or updates a timesheet
+func (r *TimesheetRepository) Save(timesheet *models.Timesheet) error {
+	return r.db.Omit("Member").Save(timesheet).Error
+}
+
+// GetByMemberAndWeek retrieves the timesheet of a member for the week starting on the given Monday
+func (r *TimesheetRepository) GetByMemberAndWeek(memberID uuid.UUID, weekStart time.Time) (*models.Timesheet, error) {
+	var timesheet models.Timesheet
+	if err := r.db.Preload("Member").
+		First(&timesheet, "member_id = ? AND week_start = ?", memberID, weekStart).Error; err != nil {
+		return nil, err
+	}
+	return &timesheet, nil
+}
+
+// List retrieves timesheets with filters, latest week first
+func (r *TimesheetRepository) List(params TimesheetListParams) ([]models.Timesheet, error) {
+	var timesheets []models.Timesheet
+
+	query := r.db.Model(&models.Timesheet{}).Preload("Member")
+	if params.MemberID != nil {
+		query = query.Where("member_id = ?", *params.MemberID)
+	}
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.WeekStart != nil {
+		query = query.Where("week_start = ?", *params.WeekStart)
+	}
+
+	err := query.Order("week_start DESC, created_at ASC").Find(&timesheets).Error
+	return timesheets, err
+}
+
+// FindLocked retrieves the submitted or approved timesheets of a member among the weeks starting
+// on the given Mondays
+func (r *TimesheetRepository) FindLocked(memberID uuid.UUID, weekStarts []time.Time) ([]models.Timesheet, error) {
+	var timesheets []models.Timesheet
+	err := r.db.
+		Where("member_id = ? AND week_start IN ? AND status IN ?", memberID, weekStarts,
+			[]string{models.TimesheetStatusSubmitted, models.TimesheetStatusApproved}).
+		Order("week_start ASC").
+		Find(&timesheets).Error
+	return timesheets, err
+}
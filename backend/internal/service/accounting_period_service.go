@@ -0,0 +1,145 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// AccountingPeriodService handles business logic for closing and reopening monthly accounting periods
+type AccountingPeriodService struct {
+	periodRepo *repository.AccountingPeriodRepository
+}
+
+// NewAccountingPeriodService creates a new AccountingPeriodService
+func NewAccountingPeriodService(db *gorm.DB) *AccountingPeriodService {
+	return &AccountingPeriodService{
+		periodRepo: repository.NewAccountingPeriodRepository(db),
+	}
+}
+
+// ListAccountingPeriods retrieves the accounting periods that have been closed at least once, latest first.
+// Months not listed have never been closed.
+func (s *AccountingPeriodService) ListAccountingPeriods() (*dto.AccountingPeriodListResponse, error) {
+	periods, err := s.periodRepo.List()
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	responses := make([]dto.AccountingPeriodResponse, len(periods))
+	for i := range periods {
+		responses[i] = *toAccountingPeriodResponse(&periods[i])
+	}
+
+	return &dto.AccountingPeriodListResponse{Periods: responses}, nil
+}
+
+// CloseAccountingPeriod closes the month (YYYY-MM) so that its time entries, expenses and
+// revenue items can no longer be changed
+func (s *AccountingPeriodService) CloseAccountingPeriod(month string, userID uuid.UUID) (*dto.AccountingPeriodResponse, error) {
+	periodStart, err := time.Parse("2006-01", month)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+
+	period, err := s.periodRepo.GetByPeriodStart(periodStart)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrDatabaseError(err)
+		}
+		period = &models.AccountingPeriod{PeriodStart: periodStart}
+	}
+	if period.IsClosed() {
+		return nil, apperrors.ErrConflict("Accounting period is already closed")
+	}
+
+	now := time.Now()
+	period.Status = models.AccountingPeriodStatusClosed
+	period.ClosedBy = &userID
+	period.ClosedAt = &now
+
+	if err := s.periodRepo.Save(period); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return toAccountingPeriodResponse(period), nil
+}
+
+// ReopenAccountingPeriod reopens a closed month (YYYY-MM) for corrections
+func (s *AccountingPeriodService) ReopenAccountingPeriod(month string, userID uuid.UUID) (*dto.AccountingPeriodResponse, error) {
+	periodStart, err := time.Parse("2006-01", month)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+
+	period, err := s.periodRepo.GetByPeriodStart(periodStart)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if period == nil || !period.IsClosed() {
+		return nil, apperrors.ErrConflict("Accounting period is not closed")
+	}
+
+	now := time.Now()
+	period.Status = models.AccountingPeriodStatusOpen
+	period.ReopenedBy = &userID
+	period.ReopenedAt = &now
+
+	if err := s.periodRepo.Save(period); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return toAccountingPeriodResponse(period), nil
+}
+
+// ensurePeriodsOpen checks that none of the dates falls in a closed accounting period
+func ensurePeriodsOpen(db *gorm.DB, dates ...time.Time) error {
+	closed, err := findClosedPeriod(db, dates...)
+	if err != nil {
+		return err
+	}
+	if closed != nil {
+		return apperrors.ErrPeriodClosed(closed.PeriodStart.Format("2006-01"))
+	}
+	return nil
+}
+
+// findClosedPeriod returns the earliest closed accounting period any of the dates falls in,
+// or nil when they are all in open periods
+func findClosedPeriod(db *gorm.DB, dates ...time.Time) (*models.AccountingPeriod, error) {
+	periodStarts := make([]time.Time, len(dates))
+	for i, date := range dates {
+		periodStarts[i] = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	periods, err := repository.NewAccountingPeriodRepository(db).FindClosed(periodStarts)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if len(periods) == 0 {
+		return nil, nil
+	}
+	return &periods[0], nil
+}
+
+// toAccountingPeriodResponse converts an accounting period to a response
+func toAccountingPeriodResponse(period *models.AccountingPeriod) *dto.AccountingPeriodResponse {
+	return &dto.AccountingPeriodResponse{
+		ID:          period.ID,
+		Period:      period.PeriodStart.Format("2006-01"),
+		PeriodStart: period.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:   period.PeriodStart.AddDate(0, 1, -1).Format("2006-01-02"),
+		Status:      period.Status,
+		ClosedBy:    period.ClosedBy,
+		ClosedAt:    period.ClosedAt,
+		ReopenedBy:  period.ReopenedBy,
+		ReopenedAt:  period.ReopenedAt,
+	}
+}
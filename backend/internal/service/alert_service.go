@@ -0,0 +1,421 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// AlertService handles business logic for budget alert rules and triggered alerts
+type AlertService struct {
+	db        *gorm.DB
+	ruleRepo  *repository.AlertRuleRepository
+	alertRepo *repository.AlertRepository
+}
+
+// NewAlertService creates a new AlertService
+func NewAlertService(db *gorm.DB) *AlertService {
+	return &AlertService{
+		db:        db,
+		ruleRepo:  repository.NewAlertRuleRepository(db),
+		alertRepo: repository.NewAlertRepository(db),
+	}
+}
+
+// AlertMetrics holds the current budget figures of a project that alert rules are checked against
+type AlertMetrics struct {
+	Currency string
+	// CostRatio is the actual cost as a percentage of BudgetAmount, nil when no budget amount is set
+	CostRatio *decimal.Decimal
+	// ProfitRate is the profit as a percentage of revenue, nil while there is no revenue
+	ProfitRate     *decimal.Decimal
+	WeeklyBurnRate decimal.Decimal
+}
+
+// CreateAlertRule creates a new alert rule for a project
+func (s *AlertService) CreateAlertRule(projectID uuid.UUID, req *dto.CreateAlertRuleRequest) (*dto.AlertRuleResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if err := validateAlertThreshold(req.Metric, req.Threshold); err != nil {
+		return nil, err
+	}
+
+	rule := &models.AlertRule{
+		ProjectID: projectID,
+		Metric:    req.Metric,
+		Threshold: req.Threshold,
+		IsEnabled: true,
+	}
+	if req.IsEnabled != nil {
+		rule.IsEnabled = *req.IsEnabled
+	}
+
+	if err := s.ruleRepo.Create(rule); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toAlertRuleResponse(rule), nil
+}
+
+// ListAlertRules retrieves the alert rules of a project
+func (s *AlertService) ListAlertRules(projectID uuid.UUID) ([]dto.AlertRuleResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	rules, err := s.ruleRepo.ListByProject(projectID, false)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	responses := make([]dto.AlertRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = *s.toAlertRuleResponse(&rule)
+	}
+
+	return responses, nil
+}
+
+// UpdateAlertRule updates an alert rule of a project. Disabling a rule resolves its active alert.
+func (s *AlertService) UpdateAlertRule(projectID, id uuid.UUID, req *dto.UpdateAlertRuleRequest) (*dto.AlertRuleResponse, error) {
+	rule, err := s.getProjectAlertRule(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields
+	if req.Metric != nil {
+		rule.Metric = *req.Metric
+	}
+	if req.Threshold != nil {
+		rule.Threshold = *req.Threshold
+	}
+	if req.IsEnabled != nil {
+		rule.IsEnabled = *req.IsEnabled
+	}
+
+	if err := validateAlertThreshold(rule.Metric, rule.Threshold); err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := repository.NewAlertRuleRepository(tx).Update(rule); err != nil {
+			return err
+		}
+		// A disabled rule is no longer evaluated, so its alerts would otherwise stay active
+		if !rule.IsEnabled {
+			return repository.NewAlertRepository(tx).ResolveByRule(rule.ID, time.Now())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toAlertRuleResponse(rule), nil
+}
+
+// DeleteAlertRule deletes an alert rule of a project. Alerts it triggered are kept
+// without the link to the rule, and those still active are resolved.
+func (s *AlertService) DeleteAlertRule(projectID, id uuid.UUID) error {
+	if _, err := s.getProjectAlertRule(projectID, id); err != nil {
+		return err
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		alertRepo := repository.NewAlertRepository(tx)
+		if err := alertRepo.ResolveByRule(id, time.Now()); err != nil {
+			return err
+		}
+		if err := alertRepo.DetachRule(id); err != nil {
+			return err
+		}
+		return repository.NewAlertRuleRepository(tx).Delete(id)
+	})
+	if err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	return nil
+}
+
+// ListAlerts retrieves the triggered alerts of a project with filtering
+func (s *AlertService) ListAlerts(params repository.AlertListParams) (*dto.AlertListResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", params.ProjectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PerPage < 1 || params.PerPage > 100 {
+		params.PerPage = 20
+	}
+
+	alerts, total, err := s.alertRepo.List(params)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Convert to response
+	alertResponses := make([]dto.AlertResponse, len(alerts))
+	for i, alert := range alerts {
+		alertResponses[i] = *s.toAlertResponse(&alert)
+	}
+
+	totalPages := int(total) / params.PerPage
+	if int(total)%params.PerPage > 0 {
+		totalPages++
+	}
+
+	return &dto.AlertListResponse{
+		Alerts: alertResponses,
+		Pagination: dto.Pagination{
+			Page:       params.Page,
+			PerPage:    params.PerPage,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// AcknowledgeAlert marks an open alert of a project as acknowledged
+func (s *AlertService) AcknowledgeAlert(projectID, id uuid.UUID) (*dto.AlertResponse, error) {
+	alert, err := s.getProjectAlert(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch alert.Status {
+	case models.AlertStatusAcknowledged:
+		return s.toAlertResponse(alert), nil
+	case models.AlertStatusResolved:
+		return nil, apperrors.ErrConflict("Alert is already resolved")
+	}
+
+	now := time.Now()
+	alert.Status = models.AlertStatusAcknowledged
+	alert.AcknowledgedAt = &now
+
+	if err := s.alertRepo.Update(alert); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toAlertResponse(alert), nil
+}
+
+// ResolveAlert marks an alert of a project as resolved
+func (s *AlertService) ResolveAlert(projectID, id uuid.UUID) (*dto.AlertResponse, error) {
+	alert, err := s.getProjectAlert(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !alert.IsActive() {
+		return nil, apperrors.ErrConflict("Alert is already resolved")
+	}
+
+	now := time.Now()
+	alert.Status = models.AlertStatusResolved
+	alert.ResolvedAt = &now
+
+	if err := s.alertRepo.Update(alert); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toAlertResponse(alert), nil
+}
+
+// EvaluateAlerts checks the enabled rules of a project against the given metrics.
+// A rule that starts to hold triggers a new alert unless it already has an active one,
+// and the active alert of a rule that no longer holds is resolved. The newly
+// triggered alerts are returned.
+func (s *AlertService) EvaluateAlerts(projectID uuid.UUID, metrics AlertMetrics) ([]dto.AlertResponse, error) {
+	rules, err := s.ruleRepo.ListByProject(projectID, true)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	activeAlerts, err := s.alertRepo.ListActiveByProject(projectID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	activeByRule := make(map[uuid.UUID]*models.Alert, len(activeAlerts))
+	for i := range activeAlerts {
+		if activeAlerts[i].RuleID != nil {
+			activeByRule[*activeAlerts[i].RuleID] = &activeAlerts[i]
+		}
+	}
+
+	now := time.Now()
+	var triggered []dto.AlertResponse
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		alertRepo := repository.NewAlertRepository(tx)
+		for _, rule := range rules {
+			value, holds := metrics.check(&rule)
+			active := activeByRule[rule.ID]
+
+			switch {
+			case holds && active == nil:
+				ruleID := rule.ID
+				alert := &models.Alert{
+					ProjectID:   projectID,
+					RuleID:      &ruleID,
+					Metric:      rule.Metric,
+					Threshold:   rule.Threshold,
+					Value:       value,
+					Currency:    metrics.Currency,
+					Message:     alertMessage(&rule, value, metrics.Currency),
+					Status:      models.AlertStatusOpen,
+					TriggeredAt: now,
+				}
+				if err := alertRepo.Create(alert); err != nil {
+					return err
+				}
+				triggered = append(triggered, *s.toAlertResponse(alert))
+			case !holds && active != nil:
+				active.Status = models.AlertStatusResolved
+				active.ResolvedAt = &now
+				if err := alertRepo.Update(active); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return triggered, nil
+}
+
+// check returns the value of the rule's metric and whether the rule holds
+func (m AlertMetrics) check(rule *models.AlertRule) (decimal.Decimal, bool) {
+	switch rule.Metric {
+	case models.AlertMetricCostRatio:
+		if m.CostRatio == nil {
+			return decimal.Zero, false
+		}
+		return *m.CostRatio, m.CostRatio.GreaterThanOrEqual(rule.Threshold)
+	case models.AlertMetricProfitRate:
+		if m.ProfitRate == nil {
+			return decimal.Zero, false
+		}
+		return *m.ProfitRate, m.ProfitRate.LessThan(rule.Threshold)
+	case models.AlertMetricWeeklyBurnRate:
+		return m.WeeklyBurnRate, m.WeeklyBurnRate.GreaterThan(rule.Threshold)
+	default:
+		return decimal.Zero, false
+	}
+}
+
+// alertMessage describes a triggered rule
+func alertMessage(rule *models.AlertRule, value decimal.Decimal, currency string) string {
+	switch rule.Metric {
+	case models.AlertMetricCostRatio:
+		return fmt.Sprintf("コストが予算の%s%%に達しました（しきい値: %s%%）", value.StringFixed(2), rule.Threshold.String())
+	case models.AlertMetricProfitRate:
+		return fmt.Sprintf("利益率が%s%%となり、しきい値%s%%を下回りました", value.StringFixed(2), rule.Threshold.String())
+	default:
+		return fmt.Sprintf("週次バーンレートが%s %sとなり、しきい値%s %sを超えました", value.String(), currency, rule.Threshold.String(), currency)
+	}
+}
+
+// validateAlertThreshold checks that the threshold makes sense for the metric
+func validateAlertThreshold(metric string, threshold decimal.Decimal) error {
+	if metric != models.AlertMetricProfitRate && threshold.IsNegative() {
+		return apperrors.ErrValidationFailed(fmt.Sprintf("threshold of %s must not be negative", metric))
+	}
+	return nil
+}
+
+// getProjectAlertRule retrieves an alert rule and verifies that it belongs to the project
+func (s *AlertService) getProjectAlertRule(projectID, id uuid.UUID) (*models.AlertRule, error) {
+	rule, err := s.ruleRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("AlertRule")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if rule.ProjectID != projectID {
+		return nil, apperrors.ErrNotFound("AlertRule")
+	}
+	return rule, nil
+}
+
+// getProjectAlert retrieves an alert and verifies that it belongs to the project
+func (s *AlertService) getProjectAlert(projectID, id uuid.UUID) (*models.Alert, error) {
+	alert, err := s.alertRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Alert")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if alert.ProjectID != projectID {
+		return nil, apperrors.ErrNotFound("Alert")
+	}
+	return alert, nil
+}
+
+// toAlertRuleResponse converts an AlertRule model to AlertRuleResponse DTO
+func (s *AlertService) toAlertRuleResponse(rule *models.AlertRule) *dto.AlertRuleResponse {
+	return &dto.AlertRuleResponse{
+		ID:        rule.ID,
+		ProjectID: rule.ProjectID,
+		Metric:    rule.Metric,
+		Threshold: rule.Threshold,
+		IsEnabled: rule.IsEnabled,
+		CreatedAt: rule.CreatedAt,
+		UpdatedAt: rule.UpdatedAt,
+	}
+}
+
+// toAlertResponse converts an Alert model to AlertResponse DTO
+func (s *AlertService) toAlertResponse(alert *models.Alert) *dto.AlertResponse {
+	return &dto.AlertResponse{
+		ID:             alert.ID,
+		ProjectID:      alert.ProjectID,
+		RuleID:         alert.RuleID,
+		Metric:         alert.Metric,
+		Threshold:      alert.Threshold,
+		Value:          alert.Value,
+		Currency:       alert.Currency,
+		Message:        alert.Message,
+		Status:         alert.Status,
+		TriggeredAt:    alert.TriggeredAt,
+		AcknowledgedAt: alert.AcknowledgedAt,
+		ResolvedAt:     alert.ResolvedAt,
+	}
+}
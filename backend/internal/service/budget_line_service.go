@@ -0,0 +1,266 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// BudgetLineService handles business logic for the budget line items of projects
+type BudgetLineService struct {
+	db             *gorm.DB
+	budgetLineRepo *repository.BudgetLineRepository
+}
+
+// NewBudgetLineService creates a new BudgetLineService
+func NewBudgetLineService(db *gorm.DB) *BudgetLineService {
+	return &BudgetLineService{
+		db:             db,
+		budgetLineRepo: repository.NewBudgetLineRepository(db),
+	}
+}
+
+// CreateBudgetLine creates a new budget line item for a project
+func (s *BudgetLineService) CreateBudgetLine(projectID uuid.UUID, req *dto.CreateBudgetLineRequest) (*dto.BudgetLineResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	line := &models.BudgetLine{
+		ID:            uuid.New(),
+		ProjectID:     projectID,
+		Name:          req.Name,
+		Category:      req.Category,
+		PlannedAmount: money.Round(req.PlannedAmount, currency),
+	}
+
+	links, err := s.buildLinks(line, req.TaskIDs, req.ExpenseCategories)
+	if err != nil {
+		return nil, err
+	}
+	line.Links = links
+
+	if err := s.budgetLineRepo.Create(line); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toBudgetLineResponse(line), nil
+}
+
+// ListBudgetLines retrieves the budget line items of a project
+func (s *BudgetLineService) ListBudgetLines(projectID uuid.UUID) (*dto.BudgetLineListResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	lines, err := s.budgetLineRepo.ListByProject(projectID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	lineResponses := make([]dto.BudgetLineResponse, len(lines))
+	for i := range lines {
+		lineResponses[i] = *s.toBudgetLineResponse(&lines[i])
+	}
+
+	return &dto.BudgetLineListResponse{Lines: lineResponses}, nil
+}
+
+// GetBudgetLine retrieves a budget line item of a project by ID
+func (s *BudgetLineService) GetBudgetLine(projectID, id uuid.UUID) (*dto.BudgetLineResponse, error) {
+	line, err := s.getProjectBudgetLine(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toBudgetLineResponse(line), nil
+}
+
+// UpdateBudgetLine updates a budget line item of a project
+func (s *BudgetLineService) UpdateBudgetLine(projectID, id uuid.UUID, req *dto.UpdateBudgetLineRequest) (*dto.BudgetLineResponse, error) {
+	line, err := s.getProjectBudgetLine(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields
+	if req.Name != nil {
+		line.Name = *req.Name
+	}
+	if req.Category != nil {
+		line.Category = *req.Category
+	}
+	if req.PlannedAmount != nil {
+		currency, err := projectCurrency(s.db, projectID)
+		if err != nil {
+			return nil, err
+		}
+		line.PlannedAmount = money.Round(*req.PlannedAmount, currency)
+	}
+
+	// Links given in the request replace the current ones; the others are kept
+	var links []models.BudgetLineLink
+	if req.TaskIDs != nil || req.ExpenseCategories != nil {
+		taskIDs, categories := linkTargets(line.Links)
+		if req.TaskIDs != nil {
+			taskIDs = *req.TaskIDs
+		}
+		if req.ExpenseCategories != nil {
+			categories = *req.ExpenseCategories
+		}
+		if links, err = s.buildLinks(line, taskIDs, categories); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.budgetLineRepo.Update(line, links); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toBudgetLineResponse(line), nil
+}
+
+// DeleteBudgetLine deletes a budget line item of a project
+func (s *BudgetLineService) DeleteBudgetLine(projectID, id uuid.UUID) error {
+	if _, err := s.getProjectBudgetLine(projectID, id); err != nil {
+		return err
+	}
+
+	if err := s.budgetLineRepo.Delete(id); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	return nil
+}
+
+// buildLinks validates the tasks and expense categories to link to a budget line. The tasks must
+// belong to the project, and neither may already be linked to another line of the project.
+func (s *BudgetLineService) buildLinks(line *models.BudgetLine, taskIDs []uuid.UUID, categories []string) ([]models.BudgetLineLink, error) {
+	taskIDs = uniqueIDs(taskIDs)
+	categories = uniqueStrings(categories)
+
+	if len(taskIDs) > 0 {
+		var count int64
+		if err := s.db.Model(&models.Task{}).
+			Where("id IN ? AND project_id = ?", taskIDs, line.ProjectID).
+			Count(&count).Error; err != nil {
+			return nil, apperrors.ErrDatabaseError(err)
+		}
+		if int(count) != len(taskIDs) {
+			return nil, apperrors.ErrValidationFailed("all tasks must belong to the project")
+		}
+	}
+
+	conflicts, err := s.budgetLineRepo.GetConflictingLinks(line.ProjectID, line.ID, taskIDs, categories)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if len(conflicts) > 0 {
+		return nil, apperrors.ErrConflict("A task or expense category is already linked to another budget line")
+	}
+
+	links := make([]models.BudgetLineLink, 0, len(taskIDs)+len(categories))
+	for i := range taskIDs {
+		links = append(links, models.BudgetLineLink{BudgetLineID: line.ID, ProjectID: line.ProjectID, TaskID: &taskIDs[i]})
+	}
+	for i := range categories {
+		links = append(links, models.BudgetLineLink{BudgetLineID: line.ID, ProjectID: line.ProjectID, ExpenseCategory: &categories[i]})
+	}
+	return links, nil
+}
+
+// getProjectBudgetLine retrieves a budget line and ensures it belongs to the project
+func (s *BudgetLineService) getProjectBudgetLine(projectID, id uuid.UUID) (*models.BudgetLine, error) {
+	line, err := s.budgetLineRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Budget line")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if line.ProjectID != projectID {
+		return nil, apperrors.ErrNotFound("Budget line")
+	}
+
+	return line, nil
+}
+
+// toBudgetLineResponse converts a BudgetLine model to BudgetLineResponse DTO
+func (s *BudgetLineService) toBudgetLineResponse(line *models.BudgetLine) *dto.BudgetLineResponse {
+	taskIDs, categories := linkTargets(line.Links)
+	return &dto.BudgetLineResponse{
+		ID:                line.ID,
+		ProjectID:         line.ProjectID,
+		Name:              line.Name,
+		Category:          line.Category,
+		PlannedAmount:     line.PlannedAmount,
+		TaskIDs:           taskIDs,
+		ExpenseCategories: categories,
+		CreatedAt:         line.CreatedAt,
+		UpdatedAt:         line.UpdatedAt,
+	}
+}
+
+// linkTargets splits the links of a budget line into the linked tasks and expense categories
+func linkTargets(links []models.BudgetLineLink) ([]uuid.UUID, []string) {
+	taskIDs := make([]uuid.UUID, 0, len(links))
+	categories := make([]string, 0, len(links))
+	for _, link := range links {
+		if link.TaskID != nil {
+			taskIDs = append(taskIDs, *link.TaskID)
+		}
+		if link.ExpenseCategory != nil {
+			categories = append(categories, *link.ExpenseCategory)
+		}
+	}
+	return taskIDs, categories
+}
+
+// uniqueIDs returns the IDs without duplicates, keeping their order
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// uniqueStrings returns the strings without duplicates, keeping their order
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
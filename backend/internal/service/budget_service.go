@@ -2,14 +2,20 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"log"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/your-org/project-budget-tracker/backend/internal/dto"
 	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
 	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
 	"github.com/your-org/project-budget-tracker/backend/internal/repository"
 )
 
@@ -18,49 +24,276 @@ type BudgetService struct {
 	db              *gorm.DB
 	timeEntryRepo   *repository.TimeEntryRepository
 	memberRepo      *repository.MemberRepository
+	expenseRepo     *repository.ExpenseRepository
+	snapshotRepo    *repository.BudgetSnapshotRepository
+	revenueItemRepo *repository.RevenueItemRepository
+	budgetLineRepo  *repository.BudgetLineRepository
+	changeOrderRepo *repository.ChangeOrderRepository
+	alertService    *AlertService
+	dailyLimit      DailyHoursLimit
 }
 
-// NewBudgetService creates a new BudgetService
-func NewBudgetService(db *gorm.DB) *BudgetService {
+// NewBudgetService creates a new BudgetService that checks time entries against the given daily limit
+func NewBudgetService(db *gorm.DB, dailyLimit DailyHoursLimit) *BudgetService {
 	return &BudgetService{
 		db:              db,
 		timeEntryRepo:   repository.NewTimeEntryRepository(db),
 		memberRepo:      repository.NewMemberRepository(db),
+		expenseRepo:     repository.NewExpenseRepository(db),
+		snapshotRepo:    repository.NewBudgetSnapshotRepository(db),
+		revenueItemRepo: repository.NewRevenueItemRepository(db),
+		budgetLineRepo:  repository.NewBudgetLineRepository(db),
+		changeOrderRepo: repository.NewChangeOrderRepository(db),
+		alertService:    NewAlertService(db),
+		dailyLimit:      dailyLimit,
 	}
 }
 
+// withDB returns a BudgetService with the same settings on another connection, such as a transaction
+func (s *BudgetService) withDB(db *gorm.DB) *BudgetService {
+	return NewBudgetService(db, s.dailyLimit)
+}
+
 // GetBudget retrieves or creates a budget for a project
 func (s *BudgetService) GetBudget(projectID uuid.UUID) (*dto.BudgetResponse, error) {
+	budget, contract, err := s.refreshBudget(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toBudgetResponse(budget, contract), nil
+}
+
+// contractRevenue describes how the revenue of a budget was derived from the contract of its project
+type contractRevenue struct {
+	contractType string
+	retainer     *dto.RetainerUsageResponse
+}
+
+// refreshBudget recalculates the revenue, cost and profit of a project's budget, saves them
+// and keeps today's snapshot in step with them
+func (s *BudgetService) refreshBudget(projectID uuid.UUID) (*models.Budget, *contractRevenue, error) {
+	budget, contract, err := s.calculateBudget(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.db.Save(budget).Error; err != nil {
+		return nil, nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Keep today's snapshot in step with the latest figures
+	if err := s.snapshotRepo.SaveDaily(newBudgetSnapshot(budget, models.BudgetSnapshotSourceDaily)); err != nil {
+		return nil, nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return budget, contract, nil
+}
+
+// calculateBudget recalculates the revenue, cost and profit of a project's budget without
+// saving anything. A project without a stored budget is calculated with the default settings.
+func (s *BudgetService) calculateBudget(projectID uuid.UUID) (*models.Budget, *contractRevenue, error) {
 	// Verify project exists
 	var project models.Project
 	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, apperrors.ErrNotFound("Project")
+			return nil, nil, apperrors.ErrNotFound("Project")
 		}
-		return nil, apperrors.ErrDatabaseError(err)
+		return nil, nil, apperrors.ErrDatabaseError(err)
 	}
 
-	// Get or create budget
 	var budget models.Budget
-	if err := s.db.FirstOrCreate(&budget, models.Budget{ProjectID: projectID}).Error; err != nil {
-		return nil, apperrors.ErrDatabaseError(err)
+	if err := s.db.First(&budget, "project_id = ?", projectID).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, apperrors.ErrDatabaseError(err)
+		}
+		budget = models.Budget{ProjectID: projectID, Currency: defaultCurrency, HoursBasis: models.BudgetHoursBasisAll}
 	}
 
-	// Calculate current cost from time entries
-	summary, err := s.timeEntryRepo.GetSummaryByProject(projectID)
+	// Costs are converted into the budget currency at today's rates
+	today := truncateToDate(time.Now())
+	rates, err := resolveProjectRates(s.db, projectID, budget.Currency, today)
 	if err != nil {
-		return nil, apperrors.ErrDatabaseError(err)
+		return nil, nil, err
+	}
+
+	// Calculate current cost from the time entries counted by the budget
+	summary, err := s.countedTimeEntries(budget.HoursBasis).GetSummaryByProject(projectID, repository.DateRange{}, rates)
+	if err != nil {
+		return nil, nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Calculate non-labor cost from expenses
+	expenseSummary, err := s.expenseRepo.GetSummaryByProject(projectID, repository.DateRange{}, rates)
+	if err != nil {
+		return nil, nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Derive revenue according to the contract of the project
+	contract := &contractRevenue{contractType: project.Contract()}
+	switch contract.contractType {
+	case models.ContractTypeTimeAndMaterials:
+		// Billable hours are earned at their bill rates as they are worked
+		budget.RecognizedRevenue = money.Round(summary.BillableValue, budget.Currency)
+		budget.PlannedRevenue = decimal.Zero
+		budget.Revenue = budget.RecognizedRevenue
+	case models.ContractTypeRetainer:
+		retainer, err := s.calculateRetainerUsage(&project, budget.Currency, rates, today)
+		if err != nil {
+			return nil, nil, err
+		}
+		contract.retainer = retainer
+
+		remainingMonths := decimal.NewFromInt(int64(retainer.ContractMonths - retainer.ElapsedMonths))
+		budget.RecognizedRevenue = retainer.FeeRevenue.Add(retainer.OverageRevenue)
+		budget.PlannedRevenue = money.Round(retainer.MonthlyFee.Mul(remainingMonths), budget.Currency)
+		budget.Revenue = budget.RecognizedRevenue.Add(budget.PlannedRevenue)
+	default:
+		// Derive revenue from the revenue items when the project has any
+		revenueSummary, err := s.revenueItemRepo.GetSummaryByProject(projectID, rates)
+		if err != nil {
+			return nil, nil, apperrors.ErrDatabaseError(err)
+		}
+		if revenueSummary.Count > 0 {
+			budget.RecognizedRevenue = money.Round(revenueSummary.RecognizedAmount, budget.Currency)
+			budget.PlannedRevenue = money.Round(revenueSummary.PlannedAmount, budget.Currency)
+			budget.Revenue = budget.RecognizedRevenue.Add(budget.PlannedRevenue)
+		} else {
+			// A single revenue figure set by UpdateRevenue is still to be billed
+			budget.RecognizedRevenue = decimal.Zero
+			budget.PlannedRevenue = budget.Revenue
+		}
 	}
 
 	// Update total cost and recalculate profit
-	budget.TotalCost = summary.TotalCost
+	budget.TotalCost = money.Round(summary.TotalCost.Add(expenseSummary.TotalAmount), budget.Currency)
 	budget.CalculateProfit()
 
-	if err := s.db.Save(&budget).Error; err != nil {
+	return &budget, contract, nil
+}
+
+// countedTimeEntries returns the time entry repository that counts the hours of a budget
+// on the given basis. Finance counts only the hours of approved timesheets.
+func (s *BudgetService) countedTimeEntries(hoursBasis string) *repository.TimeEntryRepository {
+	if hoursBasis == models.BudgetHoursBasisApproved {
+		return s.timeEntryRepo.ApprovedOnly()
+	}
+	return s.timeEntryRepo
+}
+
+// calculateRetainerUsage works through the hour bank of a retainer project month by month from the
+// start month until the current month, or the end month when the contract has ended. Each month adds
+// the retainer hours to the bank, and the billable hours of the month draw on the oldest hours first.
+// Unused hours expire after the rollover months, and hours beyond the bank are overage valued at the
+// average bill rate of the month.
+func (s *BudgetService) calculateRetainerUsage(project *models.Project, currency string, rates repository.CurrencyRates, asOf time.Time) (*dto.RetainerUsageResponse, error) {
+	fee, monthlyHours := decimal.Zero, decimal.Zero
+	if project.RetainerFee != nil {
+		fee = *project.RetainerFee
+	}
+	if project.RetainerHours != nil {
+		monthlyHours = *project.RetainerHours
+	}
+
+	firstMonth := periodStart(asOf, HistoryGranularityMonth)
+	if project.StartDate != nil {
+		firstMonth = periodStart(*project.StartDate, HistoryGranularityMonth)
+	}
+	lastMonth := periodStart(asOf, HistoryGranularityMonth)
+	contractMonths := -1
+	if project.EndDate != nil {
+		endMonth := periodStart(*project.EndDate, HistoryGranularityMonth)
+		if endMonth.Before(lastMonth) {
+			lastMonth = endMonth
+		}
+		contractMonths = max(monthsBetween(firstMonth, endMonth)+1, 0)
+	}
+	elapsedMonths := max(monthsBetween(firstMonth, lastMonth)+1, 0)
+	if contractMonths < 0 {
+		contractMonths = elapsedMonths
+	}
+
+	usage := &dto.RetainerUsageResponse{
+		MonthlyFee:     fee,
+		MonthlyHours:   monthlyHours,
+		RolloverMonths: project.RetainerRolloverMonths,
+		ContractMonths: contractMonths,
+		ElapsedMonths:  elapsedMonths,
+		FeeRevenue:     money.Round(fee.Mul(decimal.NewFromInt(int64(elapsedMonths))), currency),
+		Months:         make([]dto.RetainerMonthResponse, 0, elapsedMonths),
+	}
+	if elapsedMonths == 0 {
+		return usage, nil
+	}
+
+	// Billable hours and their value per month. Hours outside the elapsed months are
+	// counted in the first or last of them.
+	dailyBillable, err := s.timeEntryRepo.GetDailyBillable(project.ID, repository.DateRange{}, rates)
+	if err != nil {
 		return nil, apperrors.ErrDatabaseError(err)
 	}
+	monthlyUsage := make([]repository.DailyBillableSummary, elapsedMonths)
+	for _, day := range dailyBillable {
+		i := min(max(monthsBetween(firstMonth, day.WorkDate), 0), elapsedMonths-1)
+		monthlyUsage[i].Hours = monthlyUsage[i].Hours.Add(day.Hours)
+		monthlyUsage[i].Value = monthlyUsage[i].Value.Add(day.Value)
+	}
+
+	type bankedHours struct {
+		month int
+		hours decimal.Decimal
+	}
+	var bank []bankedHours
+	for i, mu := range monthlyUsage {
+		// Hours expire once they are older than the rollover months
+		kept := bank[:0]
+		for _, b := range bank {
+			if i-b.month <= project.RetainerRolloverMonths && b.hours.IsPositive() {
+				kept = append(kept, b)
+			}
+		}
+		bank = append(kept, bankedHours{month: i, hours: monthlyHours})
+
+		available := decimal.Zero
+		for _, b := range bank {
+			available = available.Add(b.hours)
+		}
+
+		// Draw on the oldest hours first
+		remaining := mu.Hours
+		for j := range bank {
+			drawn := decimal.Min(bank[j].hours, remaining)
+			bank[j].hours = bank[j].hours.Sub(drawn)
+			remaining = remaining.Sub(drawn)
+		}
+
+		overageRevenue := decimal.Zero
+		if remaining.IsPositive() {
+			overageRevenue = money.Round(remaining.Mul(mu.Value).Div(mu.Hours), currency)
+		}
+		unused := decimal.Zero
+		for _, b := range bank {
+			unused = unused.Add(b.hours)
+		}
+
+		usage.UsedHours = usage.UsedHours.Add(mu.Hours)
+		usage.OverageHours = usage.OverageHours.Add(remaining)
+		usage.OverageRevenue = usage.OverageRevenue.Add(overageRevenue)
+		usage.AvailableHours = unused
+		usage.Months = append(usage.Months, dto.RetainerMonthResponse{
+			Month:          firstMonth.AddDate(0, i, 0).Format("2006-01"),
+			AvailableHours: money.RoundHours(available),
+			UsedHours:      money.RoundHours(mu.Hours),
+			OverageHours:   money.RoundHours(remaining),
+			OverageRevenue: overageRevenue,
+			UnusedHours:    money.RoundHours(unused),
+		})
+	}
+	usage.UsedHours = money.RoundHours(usage.UsedHours)
+	usage.OverageHours = money.RoundHours(usage.OverageHours)
+	usage.AvailableHours = money.RoundHours(usage.AvailableHours)
 
-	return s.toBudgetResponse(&budget), nil
+	return usage, nil
 }
 
 // UpdateRevenue updates the revenue for a project
@@ -80,11 +313,17 @@ func (s *BudgetService) UpdateRevenue(projectID uuid.UUID, req *dto.UpdateRevenu
 		return nil, apperrors.ErrDatabaseError(err)
 	}
 
+	if err := ensureRevenueEditable(s.db, &project); err != nil {
+		return nil, err
+	}
+
 	// Update revenue
-	budget.Revenue = req.Revenue
 	if req.Currency != nil {
 		budget.Currency = *req.Currency
 	}
+	budget.Revenue = money.Round(req.Revenue, budget.Currency)
+	budget.RecognizedRevenue = decimal.Zero
+	budget.PlannedRevenue = budget.Revenue
 
 	// Recalculate profit
 	budget.CalculateProfit()
@@ -93,11 +332,64 @@ func (s *BudgetService) UpdateRevenue(projectID uuid.UUID, req *dto.UpdateRevenu
 		return nil, apperrors.ErrDatabaseError(err)
 	}
 
-	return s.toBudgetResponse(&budget), nil
+	// Every revenue change is kept in the history
+	if err := s.snapshotRepo.Create(newBudgetSnapshot(&budget, models.BudgetSnapshotSourceRevenueChange)); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	s.reevaluateAlerts(projectID)
+
+	return s.toBudgetResponse(&budget, &contractRevenue{contractType: project.Contract()}), nil
+}
+
+// UpdateHoursBasis chooses whether the budget of a project counts every time entry or only
+// those of approved timesheets
+func (s *BudgetService) UpdateHoursBasis(projectID uuid.UUID, req *dto.UpdateHoursBasisRequest) (*dto.BudgetResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	var budget models.Budget
+	if err := s.db.FirstOrCreate(&budget, models.Budget{ProjectID: projectID}).Error; err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if err := s.db.Model(&budget).Update("hours_basis", req.HoursBasis).Error; err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.GetBudget(projectID)
+}
+
+// ensureRevenueEditable checks that the revenue of a project is a single figure that can be set
+// directly, rather than one derived from its contract or revenue items
+func ensureRevenueEditable(db *gorm.DB, project *models.Project) error {
+	// Revenue of time-and-materials and retainer contracts is derived from their terms
+	if project.Contract() != models.ContractTypeFixedPrice {
+		return apperrors.ErrConflict("Revenue is derived from the contract of the project")
+	}
+
+	// Revenue of a project billed in milestones is derived from its revenue items
+	var itemCount int64
+	if err := db.Model(&models.RevenueItem{}).Where("project_id = ?", project.ID).Count(&itemCount).Error; err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+	if itemCount > 0 {
+		return apperrors.ErrConflict("Revenue is derived from the revenue items of the project")
+	}
+	return nil
 }
 
-// GetBudgetSummary retrieves a comprehensive budget summary for a project
-func (s *BudgetService) GetBudgetSummary(projectID uuid.UUID) (*dto.BudgetSummaryResponse, error) {
+// GetBudgetSummary retrieves a comprehensive budget summary for a project.
+// The budget totals, the budget lines and the profits always cover the whole project, while
+// the cost breakdowns are narrowed to the given period. When the budget counts approved hours,
+// the labor cost of the totals, breakdowns and budget lines leaves out time not yet approved.
+func (s *BudgetService) GetBudgetSummary(projectID uuid.UUID, period repository.DateRange) (*dto.BudgetSummaryResponse, error) {
 	// Verify project exists
 	var project models.Project
 	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
@@ -113,40 +405,104 @@ func (s *BudgetService) GetBudgetSummary(projectID uuid.UUID) (*dto.BudgetSummar
 		return nil, err
 	}
 
+	// Costs in other currencies are converted into the budget currency at the
+	// closing rate of the period
+	rateDate := truncateToDate(time.Now())
+	if period.To != nil {
+		rateDate = *period.To
+	}
+	rates, err := resolveProjectRates(s.db, projectID, budget.Currency, rateDate)
+	if err != nil {
+		return nil, err
+	}
+
+	timeEntryRepo := s.countedTimeEntries(budget.HoursBasis)
+
 	// Get time entry summary
-	summary, err := s.timeEntryRepo.GetSummaryByProject(projectID)
+	summary, err := timeEntryRepo.GetSummaryByProject(projectID, period, rates)
 	if err != nil {
 		return nil, apperrors.ErrDatabaseError(err)
 	}
 
 	// Get cost breakdown by member
-	memberSummaries, err := s.timeEntryRepo.GetSummaryByMember(projectID)
+	memberSummaries, err := timeEntryRepo.GetSummaryByMember(projectID, period, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Get cost breakdown by task
+	taskSummaries, err := timeEntryRepo.GetSummaryByTask(projectID, period, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Get expense breakdown by category
+	expenseSummaries, err := s.expenseRepo.GetSummaryByCategory(projectID, period, rates)
 	if err != nil {
 		return nil, apperrors.ErrDatabaseError(err)
 	}
 
 	// Calculate average rate
-	averageRate := 0.0
-	if summary.TotalHours > 0 {
-		averageRate = summary.TotalCost / summary.TotalHours
+	averageRate := decimal.Zero
+	if summary.TotalHours.IsPositive() {
+		averageRate = summary.TotalCost.Div(summary.TotalHours)
 	}
 
 	// Convert member summaries to response
 	memberCosts := make([]dto.MemberCostResponse, len(memberSummaries))
 	for i, ms := range memberSummaries {
-		percentage := 0.0
-		if summary.TotalCost > 0 {
-			percentage = (ms.Cost / summary.TotalCost) * 100
-		}
 		memberCosts[i] = dto.MemberCostResponse{
 			MemberID:   ms.MemberID,
 			MemberName: ms.MemberName,
-			Hours:      ms.Hours,
-			HourlyRate: ms.HourlyRate,
-			Cost:       ms.Cost,
-			Percentage: percentage,
+			Hours:      money.RoundHours(ms.Hours),
+			HourlyRate: money.Round(ms.HourlyRate, budget.Currency),
+			Cost:       money.Round(ms.Cost, budget.Currency),
+			LoadedCost: money.Round(ms.LoadedCost, budget.Currency),
+			Percentage: money.Percentage(ms.Cost, summary.TotalCost),
+		}
+	}
+
+	// Convert task summaries to response
+	taskCosts := make([]dto.TaskCostResponse, len(taskSummaries))
+	for i, ts := range taskSummaries {
+		taskCosts[i] = toTaskCostResponse(ts, averageRate, summary.TotalCost, budget.Currency)
+	}
+
+	// Convert expense summaries to response
+	expenseCost := decimal.Zero
+	for _, es := range expenseSummaries {
+		expenseCost = expenseCost.Add(es.Amount)
+	}
+	expenseCosts := make([]dto.ExpenseCostResponse, len(expenseSummaries))
+	for i, es := range expenseSummaries {
+		expenseCosts[i] = dto.ExpenseCostResponse{
+			Category:   es.Category,
+			Count:      es.Count,
+			Amount:     money.Round(es.Amount, budget.Currency),
+			Percentage: money.Percentage(es.Amount, expenseCost),
+		}
+	}
+
+	// Planned and actual cost of each budget line over the whole project
+	budgetLines, err := s.getBudgetLinesSummary(timeEntryRepo, projectID, budget.Currency, rates)
+	if err != nil {
+		return nil, err
+	}
+
+	// Profit on the direct cost and on the cost loaded with department overheads. The revenue
+	// covers the whole project, so with a period the profit is set against the whole cost
+	// rather than the cost of the period.
+	directCost := summary.TotalCost.Add(expenseCost)
+	loadedCost := summary.LoadedCost.Add(expenseCost)
+	projectDirectCost, projectLoadedCost := directCost, loadedCost
+	if period.From != nil || period.To != nil {
+		projectDirectCost, projectLoadedCost, err = s.getProjectCosts(timeEntryRepo, projectID, budget.Currency)
+		if err != nil {
+			return nil, err
 		}
 	}
+	directProfit := budget.Revenue.Sub(projectDirectCost)
+	loadedProfit := budget.Revenue.Sub(projectLoadedCost)
 
 	// Create warning message if deficit
 	var warningMessage *string
@@ -155,75 +511,848 @@ func (s *BudgetService) GetBudgetSummary(projectID uuid.UUID) (*dto.BudgetSummar
 		warningMessage = &msg
 	}
 
-	return &dto.BudgetSummaryResponse{
+	response := &dto.BudgetSummaryResponse{
 		ProjectID:   projectID,
 		ProjectName: project.Name,
 		Budget:      *budget,
 		CostBreakdown: dto.CostBreakdownResponse{
-			LaborCost:   summary.TotalCost,
-			TotalHours:  summary.TotalHours,
-			AverageRate: averageRate,
+			LaborCost:    money.Round(summary.TotalCost, budget.Currency),
+			ExpenseCost:  money.Round(expenseCost, budget.Currency),
+			TotalCost:    money.Round(summary.TotalCost.Add(expenseCost), budget.Currency),
+			TotalHours:   money.RoundHours(summary.TotalHours),
+			AverageRate:  money.Round(averageRate, budget.Currency),
+			ExpenseCosts: expenseCosts,
+		},
+		LoadedCost: dto.LoadedCostResponse{
+			DirectCost:       money.Round(directCost, budget.Currency),
+			LoadedLaborCost:  money.Round(summary.LoadedCost, budget.Currency),
+			OverheadCost:     money.Round(loadedCost.Sub(directCost), budget.Currency),
+			LoadedCost:       money.Round(loadedCost, budget.Currency),
+			DirectProfit:     money.Round(directProfit, budget.Currency),
+			DirectProfitRate: profitRate(directProfit, budget.Revenue),
+			LoadedProfit:     money.Round(loadedProfit, budget.Currency),
+			LoadedProfitRate: profitRate(loadedProfit, budget.Revenue),
 		},
+		Billing: dto.BillingSummaryResponse{
+			BillableHours:    money.RoundHours(summary.BillableHours),
+			NonBillableHours: money.RoundHours(summary.NonBillableHours),
+			BillableValue:    money.Round(summary.BillableValue, budget.Currency),
+			NonBillableValue: money.Round(summary.NonBillableValue, budget.Currency),
+			RealizationRate:  money.Percentage(summary.BillableValue, summary.BillableValue.Add(summary.NonBillableValue)),
+		},
+		BudgetLines:    *budgetLines,
 		MemberCosts:    memberCosts,
+		TaskCosts:      taskCosts,
 		WarningMessage: warningMessage,
+	}
+
+	if period.From != nil {
+		from := period.From.Format("2006-01-02")
+		response.From = &from
+	}
+	if period.To != nil {
+		to := period.To.Format("2006-01-02")
+		response.To = &to
+	}
+
+	return response, nil
+}
+
+// getProjectCosts returns the direct and the loaded cost of the whole project, as counted by the
+// given repository, converted at today's rates like the revenue of the budget
+func (s *BudgetService) getProjectCosts(timeEntryRepo *repository.TimeEntryRepository, projectID uuid.UUID, currency string) (decimal.Decimal, decimal.Decimal, error) {
+	rates, err := resolveProjectRates(s.db, projectID, currency, truncateToDate(time.Now()))
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	summary, err := timeEntryRepo.GetSummaryByProject(projectID, repository.DateRange{}, rates)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, apperrors.ErrDatabaseError(err)
+	}
+	expenseSummary, err := s.expenseRepo.GetSummaryByProject(projectID, repository.DateRange{}, rates)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, apperrors.ErrDatabaseError(err)
+	}
+
+	return summary.TotalCost.Add(expenseSummary.TotalAmount), summary.LoadedCost.Add(expenseSummary.TotalAmount), nil
+}
+
+// getBudgetLinesSummary rolls up the actual cost of the whole project into its budget lines: the labor
+// cost of the time entries of each linked task, as counted by the given repository, and the expenses
+// of each linked expense category
+func (s *BudgetService) getBudgetLinesSummary(timeEntryRepo *repository.TimeEntryRepository, projectID uuid.UUID, currency string, rates repository.CurrencyRates) (*dto.BudgetLinesSummaryResponse, error) {
+	lines, err := s.budgetLineRepo.ListByProject(projectID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	taskSummaries, err := timeEntryRepo.GetSummaryByTask(projectID, repository.DateRange{}, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	expenseSummaries, err := s.expenseRepo.GetSummaryByCategory(projectID, repository.DateRange{}, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	totalCost := decimal.Zero
+	taskCosts := make(map[uuid.UUID]decimal.Decimal, len(taskSummaries))
+	for _, ts := range taskSummaries {
+		taskCosts[ts.TaskID] = ts.Cost
+		totalCost = totalCost.Add(ts.Cost)
+	}
+	expenseCosts := make(map[string]decimal.Decimal, len(expenseSummaries))
+	for _, es := range expenseSummaries {
+		expenseCosts[es.Category] = es.Amount
+		totalCost = totalCost.Add(es.Amount)
+	}
+
+	summary := &dto.BudgetLinesSummaryResponse{
+		Lines: make([]dto.BudgetLineVarianceResponse, len(lines)),
+	}
+	plannedTotal, actualTotal := decimal.Zero, decimal.Zero
+	for i, line := range lines {
+		laborCost, expenseCost := decimal.Zero, decimal.Zero
+		for _, link := range line.Links {
+			if link.TaskID != nil {
+				laborCost = laborCost.Add(taskCosts[*link.TaskID])
+			}
+			if link.ExpenseCategory != nil {
+				expenseCost = expenseCost.Add(expenseCosts[*link.ExpenseCategory])
+			}
+		}
+		actualCost := laborCost.Add(expenseCost)
+		variance := line.PlannedAmount.Sub(actualCost)
+
+		summary.Lines[i] = dto.BudgetLineVarianceResponse{
+			ID:            line.ID,
+			Name:          line.Name,
+			Category:      line.Category,
+			PlannedAmount: line.PlannedAmount,
+			LaborCost:     money.Round(laborCost, currency),
+			ExpenseCost:   money.Round(expenseCost, currency),
+			ActualCost:    money.Round(actualCost, currency),
+			Variance:      money.Round(variance, currency),
+			VarianceRate:  money.Percentage(variance, line.PlannedAmount),
+			IsOverBudget:  actualCost.GreaterThan(line.PlannedAmount),
+		}
+		plannedTotal = plannedTotal.Add(line.PlannedAmount)
+		actualTotal = actualTotal.Add(actualCost)
+	}
+
+	summary.PlannedAmount = money.Round(plannedTotal, currency)
+	summary.ActualCost = money.Round(actualTotal, currency)
+	summary.Variance = money.Round(plannedTotal.Sub(actualTotal), currency)
+	summary.UnallocatedCost = money.Round(totalCost.Sub(actualTotal), currency)
+
+	return summary, nil
+}
+
+// toTaskCostResponse converts a task cost summary to TaskCostResponse DTO.
+// The planned cost is valued at the task's own average rate, falling back to
+// the project average rate for tasks without recorded hours.
+func toTaskCostResponse(ts repository.TaskCostSummary, projectAverageRate, totalCost decimal.Decimal, currency string) dto.TaskCostResponse {
+	rate := projectAverageRate
+	if ts.Hours.IsPositive() {
+		rate = ts.Cost.Div(ts.Hours)
+	}
+	plannedCost := ts.PlannedHours.Mul(rate)
+
+	return dto.TaskCostResponse{
+		TaskID:        ts.TaskID,
+		TaskName:      ts.TaskName,
+		Hours:         money.RoundHours(ts.Hours),
+		Cost:          money.Round(ts.Cost, currency),
+		PlannedHours:  money.RoundHours(ts.PlannedHours),
+		VarianceHours: money.RoundHours(ts.Hours.Sub(ts.PlannedHours)),
+		PlannedCost:   money.Round(plannedCost, currency),
+		CostVariance:  money.Round(ts.Cost.Sub(plannedCost), currency),
+		Percentage:    money.Percentage(ts.Cost, totalCost),
+	}
+}
+
+// burnRateWindowDays is the length of the trailing window used to measure the current burn rate
+const burnRateWindowDays = 28
+
+// burnRate is the average daily labor cost of a project over a trailing window
+type burnRate struct {
+	windowStart time.Time
+	windowDays  int
+	windowCost  decimal.Decimal
+	daily       decimal.Decimal
+}
+
+// weekly returns the burn rate over a week
+func (b *burnRate) weekly() decimal.Decimal {
+	return b.daily.Mul(decimal.NewFromInt(7))
+}
+
+// getBurnRate measures the burn rate of a project over the trailing window up to the given date,
+// shortened when the project started inside it
+func (s *BudgetService) getBurnRate(project *models.Project, asOf time.Time, rates repository.CurrencyRates) (*burnRate, error) {
+	windowStart := asOf.AddDate(0, 0, -(burnRateWindowDays - 1))
+	if project.StartDate != nil && project.StartDate.After(windowStart) && !project.StartDate.After(asOf) {
+		windowStart = truncateToDate(*project.StartDate)
+	}
+	windowDays := daysBetween(windowStart, asOf) + 1
+
+	dailyCosts, err := s.timeEntryRepo.GetDailyCosts(project.ID, repository.DateRange{From: &windowStart, To: &asOf}, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	windowCost := decimal.Zero
+	for _, dc := range dailyCosts {
+		windowCost = windowCost.Add(dc.Cost)
+	}
+
+	return &burnRate{
+		windowStart: windowStart,
+		windowDays:  windowDays,
+		windowCost:  windowCost,
+		daily:       windowCost.Div(decimal.NewFromInt(int64(windowDays))),
 	}, nil
 }
 
+// GetBudgetComparison compares the planned budget with the actual cost as of the given date
+// and projects when the budget will be exhausted at the current burn rate
+func (s *BudgetService) GetBudgetComparison(projectID uuid.UUID, asOf time.Time) (*dto.BudgetComparisonResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	asOf = truncateToDate(asOf)
+	toDate := repository.DateRange{To: &asOf}
+
+	// Costs are converted into the budget currency at the as-of rates
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := resolveProjectRates(s.db, projectID, currency, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Actual cost to date
+	laborSummary, err := s.timeEntryRepo.GetSummaryByProject(projectID, toDate, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	expenseSummary, err := s.expenseRepo.GetSummaryByProject(projectID, toDate, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	actualCost := laborSummary.TotalCost.Add(expenseSummary.TotalAmount)
+
+	burn, err := s.getBurnRate(&project, asOf, rates)
+	if err != nil {
+		return nil, err
+	}
+	dailyBurn := burn.daily
+
+	response := &dto.BudgetComparisonResponse{
+		ProjectID:   projectID,
+		AsOf:        asOf.Format("2006-01-02"),
+		Currency:    currency,
+		ActualCost:  money.Round(actualCost, currency),
+		LaborCost:   money.Round(laborSummary.TotalCost, currency),
+		ExpenseCost: money.Round(expenseSummary.TotalAmount, currency),
+		BurnRate: dto.BurnRateResponse{
+			WindowStart: burn.windowStart.Format("2006-01-02"),
+			WindowDays:  burn.windowDays,
+			WindowCost:  money.Round(burn.windowCost, currency),
+			Daily:       money.Round(dailyBurn, currency),
+			Weekly:      money.Round(burn.weekly(), currency),
+		},
+	}
+
+	if project.BudgetAmount != nil {
+		planned := *project.BudgetAmount
+		variance := planned.Sub(actualCost)
+		response.PlannedBudget = money.Round(planned, currency)
+		response.Variance = money.Round(variance, currency)
+		response.VarianceRate = money.Percentage(variance, planned)
+		response.IsOverBudget = actualCost.GreaterThan(planned)
+
+		// Projected exhaustion date at the current burn rate
+		if !variance.IsPositive() {
+			exhaustion := asOf.Format("2006-01-02")
+			response.Projection.ExhaustionDate = &exhaustion
+		} else if dailyBurn.IsPositive() {
+			days := int(variance.Div(dailyBurn).Ceil().IntPart())
+			exhaustion := asOf.AddDate(0, 0, days).Format("2006-01-02")
+			response.Projection.ExhaustionDate = &exhaustion
+		}
+	}
+
+	if project.EndDate != nil {
+		endDate := truncateToDate(*project.EndDate)
+		endDateStr := endDate.Format("2006-01-02")
+		daysUntilEnd := daysBetween(asOf, endDate)
+		if daysUntilEnd < 0 {
+			daysUntilEnd = 0
+		}
+		projectedCost := money.Round(actualCost.Add(dailyBurn.Mul(decimal.NewFromInt(int64(daysUntilEnd)))), currency)
+
+		response.Projection.EndDate = &endDateStr
+		response.Projection.DaysUntilEndDate = &daysUntilEnd
+		response.Projection.ProjectedCostAtEnd = &projectedCost
+		if response.Projection.ExhaustionDate != nil {
+			response.Projection.ExhaustsBeforeEndDate = *response.Projection.ExhaustionDate < endDateStr
+		}
+	}
+
+	return response, nil
+}
+
+// forecastHoursPerDay is the working hours of a fully allocated member on a working day
+var forecastHoursPerDay = decimal.NewFromInt(8)
+
+// GetCostForecast projects the labor cost of the working days after the as-of date until the
+// project end date from the allocation and hourly rate of each assigned member, and adds it to
+// the actual cost to date to estimate the total cost and final profit of the project
+func (s *BudgetService) GetCostForecast(projectID uuid.UUID, asOf time.Time) (*dto.CostForecastResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if project.EndDate == nil {
+		return nil, apperrors.ErrValidationFailed("Project has no end date to forecast until")
+	}
+
+	// The forecast is a read, so the budget is recalculated without being saved
+	budget, _, err := s.calculateBudget(projectID)
+	if err != nil {
+		return nil, err
+	}
+	currency := budget.Currency
+
+	asOf = truncateToDate(asOf)
+	endDate := truncateToDate(*project.EndDate)
+	toDate := repository.DateRange{To: &asOf}
+
+	// Actual cost to date, converted into the budget currency at the as-of rates
+	rates, err := resolveProjectRates(s.db, projectID, currency, asOf)
+	if err != nil {
+		return nil, err
+	}
+	laborSummary, err := s.timeEntryRepo.GetSummaryByProject(projectID, toDate, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	expenseSummary, err := s.expenseRepo.GetSummaryByProject(projectID, toDate, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	actualCost := laborSummary.TotalCost.Add(expenseSummary.TotalAmount)
+
+	// Remaining labor cost of the members assigned after the as-of date
+	forecastStart := asOf.AddDate(0, 0, 1)
+	calendar, err := loadWorkingCalendar(s.db, forecastStart, endDate)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := s.memberRepo.GetProjectMembersActiveAfter(projectID, asOf)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	forecastCost := decimal.Zero
+	memberForecasts := make([]dto.MemberForecastResponse, 0, len(assignments))
+	for i := range assignments {
+		pm := &assignments[i]
+		// Deleted members are no longer scheduled
+		if pm.Member.ID == uuid.Nil {
+			continue
+		}
+
+		from := forecastStart
+		if joinedAt := truncateToDate(pm.JoinedAt); joinedAt.After(from) {
+			from = joinedAt
+		}
+		to := endDate
+		if pm.LeftAt != nil {
+			if leftAt := truncateToDate(*pm.LeftAt); leftAt.Before(to) {
+				to = leftAt
+			}
+		}
+		if to.Before(from) {
+			continue
+		}
+
+		rate, err := resolveHourlyRate(s.db, projectID, &pm.Member, from, nil)
+		if err != nil {
+			return nil, err
+		}
+		memberRates, err := resolveRates(s.db, []string{rate.Currency}, currency, asOf)
+		if err != nil {
+			return nil, err
+		}
+
+		workDays := calendar.WorkingDays(from, to)
+		hours := money.RoundHours(forecastHoursPerDay.
+			Mul(decimal.NewFromFloat(pm.AllocationRate)).
+			Mul(decimal.NewFromInt(int64(workDays))))
+		cost := memberRates.Convert(hours.Mul(rate.HourlyRate), rate.Currency)
+		forecastCost = forecastCost.Add(cost)
+
+		memberForecasts = append(memberForecasts, dto.MemberForecastResponse{
+			MemberID:       pm.MemberID,
+			MemberName:     pm.Member.Name,
+			AllocationRate: pm.AllocationRate,
+			From:           from.Format("2006-01-02"),
+			To:             to.Format("2006-01-02"),
+			WorkDays:       workDays,
+			Hours:          hours,
+			HourlyRate:     rate.HourlyRate,
+			RateCurrency:   rate.Currency,
+			RateSource:     rate.Source,
+			Cost:           money.Round(cost, currency),
+		})
+	}
+
+	estimatedCost := actualCost.Add(forecastCost)
+	estimatedProfit := budget.Revenue.Sub(estimatedCost)
+
+	response := &dto.CostForecastResponse{
+		ProjectID:           projectID,
+		AsOf:                asOf.Format("2006-01-02"),
+		EndDate:             endDate.Format("2006-01-02"),
+		Currency:            currency,
+		HoursPerDay:         forecastHoursPerDay,
+		RemainingWorkDays:   calendar.WorkingDays(forecastStart, endDate),
+		ActualCost:          money.Round(actualCost, currency),
+		LaborCost:           money.Round(laborSummary.TotalCost, currency),
+		ExpenseCost:         money.Round(expenseSummary.TotalAmount, currency),
+		ForecastLaborCost:   money.Round(forecastCost, currency),
+		EstimatedTotalCost:  money.Round(estimatedCost, currency),
+		Revenue:             budget.Revenue,
+		EstimatedProfit:     money.Round(estimatedProfit, currency),
+		EstimatedProfitRate: profitRate(estimatedProfit, budget.Revenue),
+		Members:             memberForecasts,
+	}
+
+	if project.BudgetAmount != nil {
+		planned := money.Round(*project.BudgetAmount, currency)
+		variance := money.Round(project.BudgetAmount.Sub(estimatedCost), currency)
+		response.PlannedBudget = &planned
+		response.EstimatedVariance = &variance
+	}
+
+	return response, nil
+}
+
+// Responses to time recorded beyond a member's allocation for the day
+const (
+	OverAllocationWarn   = "warn"
+	OverAllocationReject = "reject"
+)
+
+// Daily limits the time of a member on one work date is checked against
+const (
+	// DailyLimitMaxHours is the maximum hours per day of every member
+	DailyLimitMaxHours = "max_hours"
+	// DailyLimitAllocation is the share of a working day the member is allocated to projects
+	DailyLimitAllocation = "allocation"
+)
+
+// DailyHoursLimit bounds the hours a member records on one work date across all projects
+type DailyHoursLimit struct {
+	// MaxHours is the most hours a member can record per work date; time beyond it is rejected
+	MaxHours decimal.Decimal
+	// OverAllocation is warn or reject for time beyond the allocation of the member on the date
+	OverAllocation string
+}
+
+// DefaultDailyHoursLimit returns the limit used when nothing is configured
+func DefaultDailyHoursLimit() DailyHoursLimit {
+	return DailyHoursLimit{
+		MaxHours:       maxTimeEntryHours,
+		OverAllocation: OverAllocationWarn,
+	}
+}
+
+// ParseDailyHoursLimit builds the daily limit from its configured values, e.g. "12" and "reject"
+func ParseDailyHoursLimit(maxHours, overAllocation string) (DailyHoursLimit, error) {
+	limit := DefaultDailyHoursLimit()
+
+	hours, err := decimal.NewFromString(maxHours)
+	if err != nil || !hours.IsPositive() || hours.GreaterThan(maxTimeEntryHours) {
+		return limit, fmt.Errorf("max daily hours must be more than 0 and at most 24: %q", maxHours)
+	}
+	limit.MaxHours = hours
+
+	switch overAllocation {
+	case OverAllocationWarn, OverAllocationReject:
+		limit.OverAllocation = overAllocation
+	default:
+		return limit, fmt.Errorf("over allocation must be one of warn, reject: %q", overAllocation)
+	}
+
+	return limit, nil
+}
+
 // CreateTimeEntry creates a new time entry
 func (s *BudgetService) CreateTimeEntry(userID uuid.UUID, req *dto.CreateTimeEntryRequest) (*dto.TimeEntryResponse, error) {
+	response, task, err := s.createTimeEntry(userID, req, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.reevaluateAlerts(task.ProjectID)
+
+	return response, nil
+}
+
+// createTimeEntry creates a new time entry and updates the actual hours of its task, leaving
+// the alerts to the caller so that a caller creating several entries checks them once. The
+// entry is checked and saved in one transaction that holds the member's row, so concurrent
+// entries of the member are checked against the daily limit and its other time ranges in turn.
+func (s *BudgetService) createTimeEntry(userID uuid.UUID, req *dto.CreateTimeEntryRequest, rounded bool) (*dto.TimeEntryResponse, *models.Task, error) {
+	var response *dto.TimeEntryResponse
+	var task *models.Task
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		txService := s.withDB(tx)
+		if err := txService.lockMember(req.MemberID); err != nil {
+			return err
+		}
+
+		timeEntry, entryTask, err := txService.newTimeEntry(userID, req, rounded)
+		if err != nil {
+			return err
+		}
+
+		warnings, err := txService.checkDailyHours(timeEntry.MemberID, timeEntry.WorkDate, timeEntry.Hours, decimal.Zero, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := txService.timeEntryRepo.Create(timeEntry); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+
+		// Update task actual hours
+		entryTask.ActualHours = entryTask.ActualHours.Add(timeEntry.Hours)
+		if err := tx.Save(entryTask).Error; err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+
+		// Reload time entry with relations
+		entry, err := txService.timeEntryRepo.GetByID(timeEntry.ID)
+		if err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+
+		response = txService.toTimeEntryResponse(entry)
+		response.Warnings = warnings
+		task = entryTask
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return response, task, nil
+}
+
+// lockMember locks the row of a member until the transaction of the service ends. A missing
+// member is left to the checks that follow.
+func (s *BudgetService) lockMember(memberID uuid.UUID) error {
+	var member models.Member
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&member, "id = ?", memberID).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return apperrors.ErrDatabaseError(err)
+	}
+	return nil
+}
+
+// newTimeEntry checks a request for a new time entry against the task, the member, closed
+// periods, submitted timesheets and the other time ranges of the member, and builds the entry with the rates in effect on the
+// work date. Nothing is saved; the task of the entry is returned along with it. Rounded hours, as
+// measured by a timer, are kept as given instead of being checked against the time range.
+func (s *BudgetService) newTimeEntry(userID uuid.UUID, req *dto.CreateTimeEntryRequest, rounded bool) (*models.TimeEntry, *models.Task, error) {
 	// Verify task exists
 	var task models.Task
 	if err := s.db.First(&task, "id = ?", req.TaskID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, apperrors.ErrNotFound("Task")
+			return nil, nil, apperrors.ErrNotFound("Task")
+		}
+		return nil, nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Verify member exists
+	member, err := s.memberRepo.GetByID(req.MemberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, apperrors.ErrNotFound("Member")
+		}
+		return nil, nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// The work date and hours follow the start and end time when there are ones
+	timeRange, err := newEntryTimeRange(req.StartedAt, req.EndedAt, req.Timezone)
+	if err != nil {
+		return nil, nil, err
+	}
+	hours := money.RoundHours(req.Hours)
+	var workDate time.Time
+	if timeRange == nil || req.WorkDate != "" {
+		workDate, err = time.Parse("2006-01-02", req.WorkDate)
+		if err != nil {
+			return nil, nil, apperrors.ErrInvalidInput(err)
+		}
+	}
+	if timeRange != nil {
+		var givenDate *time.Time
+		if req.WorkDate != "" {
+			givenDate = &workDate
+		}
+		var givenHours *decimal.Decimal
+		if !req.Hours.IsZero() && !rounded {
+			givenHours = &hours
+		}
+		var rangeHours decimal.Decimal
+		if workDate, rangeHours, err = timeRange.resolve(givenDate, givenHours); err != nil {
+			return nil, nil, err
+		}
+		if !rounded {
+			hours = rangeHours
 		}
-		return nil, apperrors.ErrDatabaseError(err)
 	}
 
-	// Verify member exists and get hourly rate
-	member, err := s.memberRepo.GetByID(req.MemberID)
-	if err != nil {
+	if err := ensurePeriodsOpen(s.db, workDate); err != nil {
+		return nil, nil, err
+	}
+	if err := ensureTimesheetsOpen(s.db, req.MemberID, workDate); err != nil {
+		return nil, nil, err
+	}
+	if timeRange != nil {
+		if err := s.ensureNoOverlap(req.MemberID, timeRange, nil); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Entries follow the project's billable default unless specified
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", task.ProjectID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, apperrors.ErrNotFound("Member")
+			return nil, nil, apperrors.ErrNotFound("Project")
 		}
-		return nil, apperrors.ErrDatabaseError(err)
+		return nil, nil, apperrors.ErrDatabaseError(err)
+	}
+	isBillable := project.IsBillableByDefault()
+	if req.IsBillable != nil {
+		isBillable = *req.IsBillable
 	}
 
-	// Parse work date
-	workDate, err := time.Parse("2006-01-02", req.WorkDate)
+	billRate, err := s.resolveBillRate(task.ProjectID, member)
 	if err != nil {
-		return nil, apperrors.ErrInvalidInput(err)
+		return nil, nil, err
 	}
 
-	// Create time entry with hourly rate snapshot
-	hourlyRate := member.HourlyRate
+	// Build the time entry with the resolved cost rate and the bill rate
+	rate, err := resolveHourlyRate(s.db, task.ProjectID, member, workDate, req.HourlyRate)
+	if err != nil {
+		return nil, nil, err
+	}
 	timeEntry := &models.TimeEntry{
 		TaskID:             req.TaskID,
 		MemberID:           req.MemberID,
 		UserID:             userID,
 		WorkDate:           workDate,
-		Hours:              req.Hours,
-		HourlyRateSnapshot: &hourlyRate,
+		Hours:              hours,
+		HourlyRateSnapshot: &rate.HourlyRate,
+		RateSource:         rate.Source,
+		BillRateSnapshot:   &billRate,
+		IsBillable:         isBillable,
+		Currency:           rate.Currency,
 		Comment:            req.Comment,
 	}
+	if timeRange != nil {
+		timeRange.apply(timeEntry)
+	}
 
-	if err := s.timeEntryRepo.Create(timeEntry); err != nil {
+	return timeEntry, &task, nil
+}
+
+// checkDailyHours checks the hours a member would have on a work date once the given hours are
+// added to the ones already recorded, other than the time entry being changed, and the unsaved
+// hours of the same batch. Time beyond the maximum hours per day is rejected. Time beyond the
+// allocation of the member is rejected or returned as warnings, as configured; a member with
+// no assignment on the date has no allocation to go beyond.
+func (s *BudgetService) checkDailyHours(memberID uuid.UUID, workDate time.Time, hours, unsavedHours decimal.Decimal, excludeID *uuid.UUID) ([]dto.DailyHoursViolation, error) {
+	recorded, err := s.timeEntryRepo.GetHoursByMemberAndDate(memberID, workDate, excludeID)
+	if err != nil {
 		return nil, apperrors.ErrDatabaseError(err)
 	}
+	recorded = recorded.Add(unsavedHours)
+	total := recorded.Add(hours)
 
-	// Update task actual hours
-	task.ActualHours += req.Hours
-	if err := s.db.Save(&task).Error; err != nil {
-		return nil, apperrors.ErrDatabaseError(err)
+	violation := func(limit string, limitHours decimal.Decimal) dto.DailyHoursViolation {
+		return dto.DailyHoursViolation{
+			MemberID:       memberID,
+			WorkDate:       workDate.Format("2006-01-02"),
+			Limit:          limit,
+			LimitHours:     money.RoundHours(limitHours),
+			RecordedHours:  money.RoundHours(recorded),
+			RequestedHours: money.RoundHours(hours),
+			TotalHours:     money.RoundHours(total),
+			ExcessHours:    money.RoundHours(total.Sub(limitHours)),
+		}
+	}
+
+	if total.GreaterThan(s.dailyLimit.MaxHours) {
+		return nil, apperrors.ErrDailyHoursExceeded([]dto.DailyHoursViolation{violation(DailyLimitMaxHours, s.dailyLimit.MaxHours)})
 	}
 
-	// Reload time entry with relations
-	entry, err := s.timeEntryRepo.GetByID(timeEntry.ID)
+	assignments, err := s.memberRepo.GetAssignmentsOn(memberID, workDate)
 	if err != nil {
 		return nil, apperrors.ErrDatabaseError(err)
 	}
+	if len(assignments) == 0 {
+		return nil, nil
+	}
+	allocation := 0.0
+	for _, pm := range assignments {
+		allocation += pm.AllocationRate
+	}
+	allocatedHours := forecastHoursPerDay.Mul(decimal.NewFromFloat(allocation))
+	if !total.GreaterThan(allocatedHours) {
+		return nil, nil
+	}
 
-	return s.toTimeEntryResponse(entry), nil
+	violations := []dto.DailyHoursViolation{violation(DailyLimitAllocation, allocatedHours)}
+	if s.dailyLimit.OverAllocation == OverAllocationReject {
+		return nil, apperrors.ErrDailyHoursExceeded(violations)
+	}
+	return violations, nil
+}
+
+// entryTimeRange is when the work of a time entry happened, in the timezone it was recorded in
+type entryTimeRange struct {
+	start    time.Time
+	end      time.Time
+	timezone string
+}
+
+// newEntryTimeRange checks the start and end time of a time entry and puts them in its timezone,
+// an IANA name such as Asia/Tokyo. An entry without any of them has no time range.
+func newEntryTimeRange(startedAt, endedAt *time.Time, timezone *string) (*entryTimeRange, error) {
+	if startedAt == nil && endedAt == nil && timezone == nil {
+		return nil, nil
+	}
+	if startedAt == nil || endedAt == nil || timezone == nil || *timezone == "" {
+		return nil, apperrors.ErrValidationFailed("started_at, ended_at and timezone must be given together")
+	}
+
+	location, err := time.LoadLocation(*timezone)
+	if err != nil {
+		return nil, apperrors.ErrValidationFailed(fmt.Sprintf("Unknown timezone %q", *timezone))
+	}
+
+	// Times are kept to the second
+	start := startedAt.Truncate(time.Second).In(location)
+	end := endedAt.Truncate(time.Second).In(location)
+	if !end.After(start) {
+		return nil, apperrors.ErrValidationFailed("ended_at must be after started_at")
+	}
+	if end.Sub(start) > 24*time.Hour {
+		return nil, apperrors.ErrValidationFailed("A time entry must not span more than 24 hours")
+	}
+
+	return &entryTimeRange{start: start, end: end, timezone: *timezone}, nil
+}
+
+// workDate is the date the work started on in the timezone; night work past midnight stays on it
+func (r *entryTimeRange) workDate() time.Time {
+	return truncateToDate(r.start)
+}
+
+// hours is the time between the start and the end
+func (r *entryTimeRange) hours() decimal.Decimal {
+	return money.RoundHours(decimal.NewFromInt(int64(r.end.Sub(r.start))).Div(decimal.NewFromInt(int64(time.Hour))))
+}
+
+// resolve returns the work date and hours of the range, checking that the ones given agree with it
+func (r *entryTimeRange) resolve(workDate *time.Time, hours *decimal.Decimal) (time.Time, decimal.Decimal, error) {
+	if workDate != nil && !workDate.Equal(r.workDate()) {
+		return time.Time{}, decimal.Zero, apperrors.ErrValidationFailed(fmt.Sprintf(
+			"work_date must be %s, the date of started_at in %s", r.workDate().Format("2006-01-02"), r.timezone))
+	}
+	if hours != nil && !hours.Equal(r.hours()) {
+		return time.Time{}, decimal.Zero, apperrors.ErrValidationFailed(fmt.Sprintf(
+			"hours must be %s, the time from started_at to ended_at", r.hours()))
+	}
+	return r.workDate(), r.hours(), nil
+}
+
+// apply records the range on a time entry, in UTC with its clock times in the timezone
+func (r *entryTimeRange) apply(entry *models.TimeEntry) {
+	startedAt, endedAt := r.start.UTC(), r.end.UTC()
+	timezone := r.timezone
+	localStart, localEnd := r.start.Format("15:04"), r.end.Format("15:04")
+	entry.StartedAt = &startedAt
+	entry.EndedAt = &endedAt
+	entry.Timezone = &timezone
+	entry.LocalStartTime = &localStart
+	entry.LocalEndTime = &localEnd
+}
+
+// ensureNoOverlap rejects a time range that overlaps another time entry of the member
+func (s *BudgetService) ensureNoOverlap(memberID uuid.UUID, timeRange *entryTimeRange, excludeID *uuid.UUID) error {
+	overlapping, err := s.timeEntryRepo.GetOverlapping(memberID, timeRange.start.UTC(), timeRange.end.UTC(), excludeID)
+	if err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+	if len(overlapping) == 0 {
+		return nil
+	}
+
+	details := make([]dto.TimeEntryOverlap, len(overlapping))
+	for i, entry := range overlapping {
+		details[i] = dto.TimeEntryOverlap{
+			ID:        entry.ID,
+			WorkDate:  entry.WorkDate.Format("2006-01-02"),
+			StartedAt: inTimezone(*entry.StartedAt, entry.Timezone),
+			EndedAt:   inTimezone(*entry.EndedAt, entry.Timezone),
+		}
+	}
+	return apperrors.ErrTimeEntryOverlap(details)
+}
+
+// inTimezone shows a time in the timezone it was recorded in, or in UTC if that is unknown
+func inTimezone(t time.Time, timezone *string) time.Time {
+	if timezone != nil {
+		if location, err := time.LoadLocation(*timezone); err == nil {
+			return t.In(location)
+		}
+	}
+	return t.UTC()
+}
+
+// resolveBillRate returns the rate a member's time is billed at on a project: the
+// project-specific bill rate, then the member's bill rate, then the member's cost rate
+func (s *BudgetService) resolveBillRate(projectID uuid.UUID, member *models.Member) (decimal.Decimal, error) {
+	pm, err := s.memberRepo.GetProjectMember(projectID, member.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return decimal.Zero, apperrors.ErrDatabaseError(err)
+	}
+	if pm != nil && pm.BillRate != nil {
+		return *pm.BillRate, nil
+	}
+	if member.BillRate != nil {
+		return *member.BillRate, nil
+	}
+	return member.HourlyRate, nil
 }
 
 // GetTimeEntry retrieves a time entry by ID
@@ -264,11 +1393,9 @@ func (s *BudgetService) ListTimeEntries(params repository.TimeEntryListParams) (
 		totalPages++
 	}
 
-	// Calculate summary
-	var totalHours, totalCost float64
-	for _, entry := range entries {
-		totalHours += entry.Hours
-		totalCost += entry.Cost()
+	summary, err := s.summarizeTimeEntries(entries, params.ProjectID)
+	if err != nil {
+		return nil, err
 	}
 
 	return &dto.TimeEntryListResponse{
@@ -279,13 +1406,52 @@ func (s *BudgetService) ListTimeEntries(params repository.TimeEntryListParams) (
 			Total:      total,
 			TotalPages: totalPages,
 		},
-		Summary: &dto.TimeEntrySummary{
-			TotalHours: totalHours,
-			TotalCost:  totalCost,
-		},
+		Summary: summary,
 	}, nil
 }
 
+// summarizeTimeEntries totals the hours and cost of listed time entries. The cost of entries of
+// one project is converted into its budget currency at today's rates; entries across projects
+// are totalled per currency instead.
+func (s *BudgetService) summarizeTimeEntries(entries []models.TimeEntry, projectID *uuid.UUID) (*dto.TimeEntrySummary, error) {
+	totalHours := decimal.Zero
+	for _, entry := range entries {
+		totalHours = totalHours.Add(entry.Hours)
+	}
+	summary := &dto.TimeEntrySummary{TotalHours: money.RoundHours(totalHours)}
+
+	if projectID != nil {
+		currency, err := projectCurrency(s.db, *projectID)
+		if err != nil {
+			return nil, err
+		}
+		rates, err := resolveProjectRates(s.db, *projectID, currency, truncateToDate(time.Now()))
+		if err != nil {
+			return nil, err
+		}
+		totalCost := decimal.Zero
+		for _, entry := range entries {
+			totalCost = totalCost.Add(rates.Convert(entry.Cost(), entry.Currency))
+		}
+		totalCost = money.Round(totalCost, currency)
+		summary.TotalCost = &totalCost
+		summary.Currency = currency
+		return summary, nil
+	}
+
+	costs := make(map[string]decimal.Decimal)
+	for _, entry := range entries {
+		costs[entry.Currency] = costs[entry.Currency].Add(entry.Cost())
+	}
+	for currency, cost := range costs {
+		summary.Costs = append(summary.Costs, dto.TimeEntryCostTotal{Currency: currency, Cost: money.Round(cost, currency)})
+	}
+	sort.Slice(summary.Costs, func(i, j int) bool {
+		return summary.Costs[i].Currency < summary.Costs[j].Currency
+	})
+	return summary, nil
+}
+
 // UpdateTimeEntry updates a time entry
 func (s *BudgetService) UpdateTimeEntry(id uuid.UUID, req *dto.UpdateTimeEntryRequest) (*dto.TimeEntryResponse, error) {
 	entry, err := s.timeEntryRepo.GetByID(id)
@@ -296,38 +1462,138 @@ func (s *BudgetService) UpdateTimeEntry(id uuid.UUID, req *dto.UpdateTimeEntryRe
 		return nil, apperrors.ErrDatabaseError(err)
 	}
 
+	if err := s.ensureTimeEntryUnlocked(entry); err != nil {
+		return nil, err
+	}
+
 	// Track hours change for task update
 	oldHours := entry.Hours
+	oldWorkDate := entry.WorkDate
+	oldInvoiceID := entry.InvoiceID
 
 	// Update fields
+	var workDate *time.Time
 	if req.WorkDate != nil {
-		workDate, err := time.Parse("2006-01-02", *req.WorkDate)
+		parsed, err := time.Parse("2006-01-02", *req.WorkDate)
 		if err != nil {
 			return nil, apperrors.ErrInvalidInput(err)
 		}
-		entry.WorkDate = workDate
+		workDate = &parsed
 	}
+	var hours *decimal.Decimal
 	if req.Hours != nil {
-		entry.Hours = *req.Hours
+		rounded := money.RoundHours(*req.Hours)
+		hours = &rounded
+	}
+
+	// The work date and hours of an entry with a time range keep following it
+	startedAt, endedAt, timezone := entry.StartedAt, entry.EndedAt, entry.Timezone
+	if req.StartedAt != nil {
+		startedAt = req.StartedAt
+	}
+	if req.EndedAt != nil {
+		endedAt = req.EndedAt
+	}
+	if req.Timezone != nil {
+		timezone = req.Timezone
 	}
+	timeRange, err := newEntryTimeRange(startedAt, endedAt, timezone)
+	if err != nil {
+		return nil, err
+	}
+	if timeRange != nil {
+		date, rangeHours, err := timeRange.resolve(workDate, hours)
+		if err != nil {
+			return nil, err
+		}
+		workDate, hours = &date, &rangeHours
+	}
+
+	if workDate != nil {
+		// Moving an entry into a closed period would change its reported cost too
+		if err := ensurePeriodsOpen(s.db, *workDate); err != nil {
+			return nil, err
+		}
+		// as would moving it into a submitted timesheet
+		if err := ensureTimesheetsOpen(s.db, entry.MemberID, *workDate); err != nil {
+			return nil, err
+		}
+		entry.WorkDate = *workDate
+	}
+	if hours != nil {
+		entry.Hours = *hours
+	}
+	if timeRange != nil {
+		if err := s.ensureNoOverlap(entry.MemberID, timeRange, &entry.ID); err != nil {
+			return nil, err
+		}
+		timeRange.apply(entry)
+	}
+
+	// Only more hours or another work date can go beyond a daily limit
+	var warnings []dto.DailyHoursViolation
+	if entry.Hours.GreaterThan(oldHours) || !entry.WorkDate.Equal(oldWorkDate) {
+		warnings, err = s.checkDailyHours(entry.MemberID, entry.WorkDate, entry.Hours, decimal.Zero, &entry.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if req.Comment != nil {
 		entry.Comment = req.Comment
 	}
+	if req.HourlyRate != nil {
+		hourlyRate := money.RoundRate(*req.HourlyRate)
+		entry.HourlyRateSnapshot = &hourlyRate
+		entry.RateSource = models.RateSourceEntry
+	} else if !entry.WorkDate.Equal(oldWorkDate) && entry.RateSource != models.RateSourceEntry {
+		// An entry moved to another work date takes the rate in effect on it, unless its
+		// rate was set for the entry itself
+		rate, err := resolveHourlyRate(s.db, entry.Task.ProjectID, &entry.Member, entry.WorkDate, nil)
+		if err != nil {
+			return nil, err
+		}
+		entry.HourlyRateSnapshot = &rate.HourlyRate
+		entry.RateSource = rate.Source
+		entry.Currency = rate.Currency
+	}
+	if req.IsBillable != nil {
+		entry.IsBillable = *req.IsBillable
+		// Non-billable time is taken off the draft invoice it was reserved for
+		if !entry.IsBillable {
+			entry.InvoiceID = nil
+		}
+	}
 
-	if err := s.timeEntryRepo.Update(entry); err != nil {
-		return nil, apperrors.ErrDatabaseError(err)
+	// A draft invoice the entry was reserved for is recalculated along with it, as the
+	// entry may have changed its billed value or left the invoice
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := repository.NewTimeEntryRepository(tx).Update(entry); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		if oldInvoiceID != nil {
+			return NewInvoiceService(tx).recalculateDraft(*oldInvoiceID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Update task actual hours if hours changed
-	if req.Hours != nil && *req.Hours != oldHours {
+	if !entry.Hours.Equal(oldHours) {
 		var task models.Task
 		if err := s.db.First(&task, "id = ?", entry.TaskID).Error; err == nil {
-			task.ActualHours = task.ActualHours - oldHours + *req.Hours
+			task.ActualHours = task.ActualHours.Sub(oldHours).Add(entry.Hours)
 			s.db.Save(&task)
 		}
 	}
 
-	return s.toTimeEntryResponse(entry), nil
+	s.reevaluateAlerts(entry.Task.ProjectID)
+
+	response := s.toTimeEntryResponse(entry)
+	response.Warnings = warnings
+	return response, nil
 }
 
 // DeleteTimeEntry deletes a time entry
@@ -340,34 +1606,325 @@ func (s *BudgetService) DeleteTimeEntry(id uuid.UUID) error {
 		return apperrors.ErrDatabaseError(err)
 	}
 
+	if err := s.ensureTimeEntryUnlocked(entry); err != nil {
+		return err
+	}
+
 	// Update task actual hours
 	var task models.Task
 	if err := s.db.First(&task, "id = ?", entry.TaskID).Error; err == nil {
-		task.ActualHours -= entry.Hours
-		if task.ActualHours < 0 {
-			task.ActualHours = 0
+		task.ActualHours = task.ActualHours.Sub(entry.Hours)
+		if task.ActualHours.IsNegative() {
+			task.ActualHours = decimal.Zero
 		}
 		s.db.Save(&task)
 	}
 
-	if err := s.timeEntryRepo.Delete(id); err != nil {
-		return apperrors.ErrDatabaseError(err)
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := repository.NewTimeEntryRepository(tx).Delete(id); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		if entry.InvoiceID != nil {
+			return NewInvoiceService(tx).recalculateDraft(*entry.InvoiceID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	s.reevaluateAlerts(entry.Task.ProjectID)
+
 	return nil
 }
 
+// ensureTimeEntryUnlocked rejects changes to a time entry billed by an issued invoice,
+// dated in a closed accounting period or on a submitted or approved timesheet
+func (s *BudgetService) ensureTimeEntryUnlocked(entry *models.TimeEntry) error {
+	locked, err := s.isTimeEntryLocked(entry)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return apperrors.ErrConflict("Time entry is on an issued invoice and cannot be changed")
+	}
+
+	if err := ensurePeriodsOpen(s.db, entry.WorkDate); err != nil {
+		return err
+	}
+	return ensureTimesheetsOpen(s.db, entry.MemberID, entry.WorkDate)
+}
+
+// isTimeEntryLocked checks if a time entry is billed by an issued invoice
+func (s *BudgetService) isTimeEntryLocked(entry *models.TimeEntry) (bool, error) {
+	if entry.InvoiceID == nil {
+		return false, nil
+	}
+
+	var invoice models.Invoice
+	if err := s.db.Select("id", "status").First(&invoice, "id = ?", *entry.InvoiceID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, apperrors.ErrDatabaseError(err)
+	}
+
+	return invoice.IsLocked(), nil
+}
+
+// EvaluateAlerts refreshes the budget of a project and checks its alert rules against
+// the current cost, profit rate and burn rate. The newly triggered alerts are returned.
+func (s *BudgetService) EvaluateAlerts(projectID uuid.UUID) ([]dto.AlertResponse, error) {
+	budget, _, err := s.refreshBudget(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return s.evaluateBudgetAlerts(budget)
+}
+
+// evaluateBudgetAlerts checks the alert rules of a project against its freshly recalculated
+// budget, so that only the burn rate is measured on top of the budget figures
+func (s *BudgetService) evaluateBudgetAlerts(budget *models.Budget) ([]dto.AlertResponse, error) {
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", budget.ProjectID).Error; err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	today := truncateToDate(time.Now())
+	rates, err := resolveProjectRates(s.db, project.ID, budget.Currency, today)
+	if err != nil {
+		return nil, err
+	}
+	burn, err := s.getBurnRate(&project, today, rates)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := AlertMetrics{
+		Currency:       budget.Currency,
+		WeeklyBurnRate: money.Round(burn.weekly(), budget.Currency),
+	}
+	if project.BudgetAmount != nil && project.BudgetAmount.IsPositive() {
+		costRatio := money.RoundRate(budget.TotalCost.Div(*project.BudgetAmount).Mul(decimal.NewFromInt(100)))
+		metrics.CostRatio = &costRatio
+	}
+	// The profit rate is undefined until revenue is set
+	if budget.Revenue.IsPositive() {
+		profitRate := budget.ProfitRate
+		metrics.ProfitRate = &profitRate
+	}
+
+	return s.alertService.EvaluateAlerts(project.ID, metrics)
+}
+
+// reevaluateAlerts checks the alert rules after a change to the costs or revenue of a project.
+// Writes of several entries check them once after the change is saved. The change has
+// already been saved, so a failure is only logged.
+func (s *BudgetService) reevaluateAlerts(projectID uuid.UUID) {
+	if _, err := s.EvaluateAlerts(projectID); err != nil {
+		log.Printf("Failed to evaluate budget alerts of project %s: %v", projectID, err)
+	}
+}
+
+// recordRevenueChange recalculates the budget after a change to the revenue items of a
+// project, keeps the new figures in the history and checks the alert rules against them.
+// The change has already been saved, so a failure is only logged.
+func (s *BudgetService) recordRevenueChange(projectID uuid.UUID) {
+	budget, _, err := s.refreshBudget(projectID)
+	if err != nil {
+		log.Printf("Failed to recalculate the budget of project %s: %v", projectID, err)
+		return
+	}
+
+	if err := s.snapshotRepo.Create(newBudgetSnapshot(budget, models.BudgetSnapshotSourceRevenueChange)); err != nil {
+		log.Printf("Failed to record a budget snapshot of project %s: %v", projectID, err)
+	}
+
+	if _, err := s.evaluateBudgetAlerts(budget); err != nil {
+		log.Printf("Failed to evaluate budget alerts of project %s: %v", projectID, err)
+	}
+}
+
+// Budget history granularities
+const (
+	HistoryGranularityDay   = "day"
+	HistoryGranularityWeek  = "week"
+	HistoryGranularityMonth = "month"
+)
+
+// GetBudgetHistory returns the budget trend of a project, one point per day, week or month.
+// Each point holds the latest snapshot taken within the period. The baseline set before any
+// approved change order is returned along with the current budget.
+func (s *BudgetService) GetBudgetHistory(projectID uuid.UUID, granularity string, period repository.DateRange) (*dto.BudgetHistoryResponse, error) {
+	switch granularity {
+	case "":
+		granularity = HistoryGranularityDay
+	case HistoryGranularityDay, HistoryGranularityWeek, HistoryGranularityMonth:
+	default:
+		return nil, apperrors.ErrValidationFailed("granularity must be one of day, week, month")
+	}
+
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := s.snapshotRepo.ListByProject(projectID, period)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	points := make([]dto.BudgetHistoryPointResponse, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		point := dto.BudgetHistoryPointResponse{
+			PeriodStart:  periodStart(snapshot.SnapshotDate, granularity).Format("2006-01-02"),
+			SnapshotDate: snapshot.SnapshotDate.Format("2006-01-02"),
+			Revenue:      snapshot.Revenue,
+			TotalCost:    snapshot.TotalCost,
+			Profit:       snapshot.Profit,
+			ProfitRate:   snapshot.ProfitRate,
+			Currency:     snapshot.Currency,
+			IsDeficit:    snapshot.Profit.IsNegative(),
+		}
+
+		// Snapshots are in chronological order, so a later one closes the period
+		if n := len(points); n > 0 && points[n-1].PeriodStart == point.PeriodStart {
+			points[n-1] = point
+			continue
+		}
+		points = append(points, point)
+	}
+
+	baseline, err := s.getBudgetBaseline(&project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.BudgetHistoryResponse{
+		ProjectID:   projectID,
+		Granularity: granularity,
+		Currency:    currency,
+		Points:      points,
+		Baseline:    *baseline,
+	}, nil
+}
+
+// getBudgetBaseline compares the current budget amount, revenue and end date of a project with
+// their values before the first approved change order that revised each of them
+func (s *BudgetService) getBudgetBaseline(project *models.Project) (*dto.BudgetBaselineResponse, error) {
+	var budget models.Budget
+	if err := s.db.First(&budget, "project_id = ?", project.ID).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	orders, err := s.changeOrderRepo.ListApprovedByProject(project.ID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	current := dto.BudgetFiguresResponse{
+		BudgetAmount: project.BudgetAmount,
+		Revenue:      budget.Revenue,
+		EndDate:      formatOptionalDate(project.EndDate),
+	}
+	response := &dto.BudgetBaselineResponse{
+		Baseline:         current,
+		Current:          current,
+		ChangeOrderCount: len(orders),
+		CostImpact:       decimal.Zero,
+	}
+
+	// Walking back from the latest change order leaves the values before the first one
+	for i := len(orders) - 1; i >= 0; i-- {
+		order := orders[i]
+		if order.BudgetAmount != nil {
+			response.Baseline.BudgetAmount = order.PreviousBudgetAmount
+		}
+		if order.Revenue != nil && order.PreviousRevenue != nil {
+			response.Baseline.Revenue = *order.PreviousRevenue
+		}
+		if order.EndDate != nil {
+			response.Baseline.EndDate = formatOptionalDate(order.PreviousEndDate)
+		}
+		response.CostImpact = response.CostImpact.Add(order.CostImpact)
+	}
+
+	return response, nil
+}
+
+// CaptureDailySnapshots refreshes the budgets of all projects and records today's snapshots.
+// A failure on one project does not stop the others; the errors are returned together.
+func (s *BudgetService) CaptureDailySnapshots() (int, error) {
+	var projectIDs []uuid.UUID
+	if err := s.db.Model(&models.Project{}).Pluck("id", &projectIDs).Error; err != nil {
+		return 0, apperrors.ErrDatabaseError(err)
+	}
+
+	captured := 0
+	var errs []error
+	for _, projectID := range projectIDs {
+		if _, err := s.GetBudget(projectID); err != nil {
+			errs = append(errs, fmt.Errorf("project %s: %w", projectID, err))
+			continue
+		}
+		captured++
+	}
+
+	return captured, errors.Join(errs...)
+}
+
+// newBudgetSnapshot copies the current figures of a budget into a snapshot dated today
+func newBudgetSnapshot(budget *models.Budget, source string) *models.BudgetSnapshot {
+	return &models.BudgetSnapshot{
+		ProjectID:    budget.ProjectID,
+		SnapshotDate: truncateToDate(time.Now()),
+		Source:       source,
+		Revenue:      budget.Revenue,
+		TotalCost:    budget.TotalCost,
+		Profit:       budget.Profit,
+		ProfitRate:   budget.ProfitRate,
+		Currency:     budget.Currency,
+	}
+}
+
+// periodStart returns the first day of the day, week (from Monday) or month containing the date
+func periodStart(date time.Time, granularity string) time.Time {
+	date = truncateToDate(date)
+	switch granularity {
+	case HistoryGranularityWeek:
+		return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	case HistoryGranularityMonth:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return date
+	}
+}
+
 // toBudgetResponse converts a Budget model to BudgetResponse DTO
-func (s *BudgetService) toBudgetResponse(budget *models.Budget) *dto.BudgetResponse {
+func (s *BudgetService) toBudgetResponse(budget *models.Budget, contract *contractRevenue) *dto.BudgetResponse {
 	return &dto.BudgetResponse{
-		ID:         budget.ID,
-		ProjectID:  budget.ProjectID,
-		Revenue:    budget.Revenue,
-		TotalCost:  budget.TotalCost,
-		Profit:     budget.Profit,
-		ProfitRate: budget.ProfitRate,
-		Currency:   budget.Currency,
-		IsDeficit:  budget.Profit < 0,
+		ID:                budget.ID,
+		ProjectID:         budget.ProjectID,
+		Revenue:           budget.Revenue,
+		RecognizedRevenue: budget.RecognizedRevenue,
+		PlannedRevenue:    budget.PlannedRevenue,
+		TotalCost:         budget.TotalCost,
+		Profit:            budget.Profit,
+		ProfitRate:        budget.ProfitRate,
+		Currency:          budget.Currency,
+		IsDeficit:         budget.Profit.IsNegative(),
+		ContractType:      contract.contractType,
+		HoursBasis:        budget.HoursBasis,
+		Retainer:          contract.retainer,
 	}
 }
 
@@ -381,11 +1938,24 @@ func (s *BudgetService) toTimeEntryResponse(entry *models.TimeEntry) *dto.TimeEn
 		WorkDate:           entry.WorkDate.Format("2006-01-02"),
 		Hours:              entry.Hours,
 		HourlyRateSnapshot: entry.HourlyRateSnapshot,
-		Cost:               entry.Cost(),
+		RateSource:         entry.RateSource,
+		BillRateSnapshot:   entry.BillRateSnapshot,
+		IsBillable:         entry.IsBillable,
+		Currency:           entry.Currency,
+		Cost:               money.Round(entry.Cost(), entry.Currency),
+		BillValue:          money.Round(entry.BillValue(), entry.Currency),
 		Comment:            entry.Comment,
+		InvoiceID:          entry.InvoiceID,
 		CreatedAt:          entry.CreatedAt,
 		UpdatedAt:          entry.UpdatedAt,
 	}
+	if entry.HasTimeRange() {
+		startedAt := inTimezone(*entry.StartedAt, entry.Timezone)
+		endedAt := inTimezone(*entry.EndedAt, entry.Timezone)
+		response.StartedAt = &startedAt
+		response.EndedAt = &endedAt
+		response.Timezone = entry.Timezone
+	}
 
 	if entry.Member.ID != uuid.Nil {
 		response.Member = &dto.MemberBriefResponse{
@@ -396,3 +1966,26 @@ func (s *BudgetService) toTimeEntryResponse(entry *models.TimeEntry) *dto.TimeEn
 
 	return response
 }
+
+// truncateToDate drops the time of day, keeping the calendar date in UTC
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// profitRate returns the profit as a percentage of the revenue, or zero without revenue
+func profitRate(profit, revenue decimal.Decimal) decimal.Decimal {
+	if !revenue.IsPositive() {
+		return decimal.Zero
+	}
+	return money.RoundRate(profit.Div(revenue).Mul(decimal.NewFromInt(100)))
+}
+
+// monthsBetween returns the number of calendar months from the month of start to the month of end
+func monthsBetween(start, end time.Time) int {
+	return (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+}
+
+// daysBetween returns the number of calendar days from start to end
+func daysBetween(start, end time.Time) int {
+	return int(truncateToDate(end).Sub(truncateToDate(start)).Hours() / 24)
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// ChangeOrderService handles business logic for change orders, which revise the budget amount,
+// revenue or end date of a project once approved
+type ChangeOrderService struct {
+	db              *gorm.DB
+	changeOrderRepo *repository.ChangeOrderRepository
+	budgetService   *BudgetService
+}
+
+// NewChangeOrderService creates a new ChangeOrderService
+func NewChangeOrderService(db *gorm.DB, dailyLimit DailyHoursLimit) *ChangeOrderService {
+	return &ChangeOrderService{
+		db:              db,
+		changeOrderRepo: repository.NewChangeOrderRepository(db),
+		budgetService:   NewBudgetService(db, dailyLimit),
+	}
+}
+
+// CreateChangeOrder submits a change order for a project
+func (s *ChangeOrderService) CreateChangeOrder(projectID, userID uuid.UUID, req *dto.CreateChangeOrderRequest) (*dto.ChangeOrderResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if req.BudgetAmount == nil && req.Revenue == nil && req.EndDate == nil {
+		return nil, apperrors.ErrValidationFailed("budget_amount, revenue or end_date is required")
+	}
+
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &models.ChangeOrder{
+		ProjectID:   projectID,
+		Title:       req.Title,
+		Reason:      req.Reason,
+		CostImpact:  money.Round(req.CostImpact, currency),
+		Status:      models.ChangeOrderStatusSubmitted,
+		RequestedBy: userID,
+	}
+
+	if req.BudgetAmount != nil {
+		budgetAmount := money.Round(*req.BudgetAmount, currency)
+		order.BudgetAmount = &budgetAmount
+	}
+
+	if req.Revenue != nil {
+		// Only a revenue figure set directly can be revised
+		if err := ensureRevenueEditable(s.db, &project); err != nil {
+			return nil, err
+		}
+		revenue := money.Round(*req.Revenue, currency)
+		order.Revenue = &revenue
+	}
+
+	if req.EndDate != nil {
+		endDate, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			return nil, apperrors.ErrInvalidInput(err)
+		}
+		if project.StartDate != nil && endDate.Before(*project.StartDate) {
+			return nil, apperrors.ErrValidationFailed("end_date must be on or after the start date of the project")
+		}
+		order.EndDate = &endDate
+	}
+
+	if err := s.changeOrderRepo.Create(order); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return toChangeOrderResponse(order, currency), nil
+}
+
+// ListChangeOrders retrieves the change orders of a project, optionally narrowed to one status
+func (s *ChangeOrderService) ListChangeOrders(projectID uuid.UUID, status string) (*dto.ChangeOrderListResponse, error) {
+	switch status {
+	case "", models.ChangeOrderStatusSubmitted, models.ChangeOrderStatusApproved, models.ChangeOrderStatusRejected:
+	default:
+		return nil, apperrors.ErrValidationFailed("status must be one of submitted, approved, rejected")
+	}
+
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.changeOrderRepo.ListByProject(projectID, status)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	responses := make([]dto.ChangeOrderResponse, len(orders))
+	for i := range orders {
+		responses[i] = *toChangeOrderResponse(&orders[i], currency)
+	}
+
+	return &dto.ChangeOrderListResponse{ChangeOrders: responses}, nil
+}
+
+// GetChangeOrder retrieves a change order of a project
+func (s *ChangeOrderService) GetChangeOrder(projectID, orderID uuid.UUID) (*dto.ChangeOrderResponse, error) {
+	order, err := s.getProjectChangeOrder(projectID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toChangeOrderResponse(order, currency), nil
+}
+
+// ApproveChangeOrder approves a submitted change order and applies it to the project and budget
+// in one transaction. The values it replaces are kept on the change order, and the revised budget
+// is recorded in the history.
+func (s *ChangeOrderService) ApproveChangeOrder(projectID, orderID, reviewerID uuid.UUID, req *dto.ReviewChangeOrderRequest) (*dto.ChangeOrderResponse, error) {
+	order, err := s.getProjectChangeOrder(projectID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.IsReviewed() {
+		return nil, apperrors.ErrConflict("Change order has already been reviewed")
+	}
+
+	var currency string
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var project models.Project
+		if err := tx.First(&project, "id = ?", projectID).Error; err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+
+		var budget models.Budget
+		if err := tx.FirstOrCreate(&budget, models.Budget{ProjectID: projectID}).Error; err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		currency = budget.Currency
+
+		if order.BudgetAmount != nil {
+			order.PreviousBudgetAmount = project.BudgetAmount
+			project.BudgetAmount = order.BudgetAmount
+		}
+		if order.EndDate != nil {
+			order.PreviousEndDate = project.EndDate
+			project.EndDate = order.EndDate
+		}
+		if err := tx.Model(&project).Select("budget_amount", "end_date").Updates(&project).Error; err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+
+		if order.Revenue != nil {
+			// The contract or revenue items may have changed since the change order was submitted
+			if err := ensureRevenueEditable(tx, &project); err != nil {
+				return err
+			}
+			previousRevenue := budget.Revenue
+			order.PreviousRevenue = &previousRevenue
+
+			budget.Revenue = *order.Revenue
+			budget.RecognizedRevenue = decimal.Zero
+			budget.PlannedRevenue = budget.Revenue
+			budget.CalculateProfit()
+			if err := tx.Save(&budget).Error; err != nil {
+				return apperrors.ErrDatabaseError(err)
+			}
+		}
+
+		// Every approved change order is kept in the history
+		snapshot := newBudgetSnapshot(&budget, models.BudgetSnapshotSourceChangeOrder)
+		if err := repository.NewBudgetSnapshotRepository(tx).Create(snapshot); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+
+		markReviewed(order, models.ChangeOrderStatusApproved, reviewerID, req)
+		if err := repository.NewChangeOrderRepository(tx).Update(order); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A revised budget amount or revenue changes the cost ratio and profit rate
+	s.budgetService.reevaluateAlerts(projectID)
+
+	return toChangeOrderResponse(order, currency), nil
+}
+
+// RejectChangeOrder rejects a submitted change order, leaving the project unchanged
+func (s *ChangeOrderService) RejectChangeOrder(projectID, orderID, reviewerID uuid.UUID, req *dto.ReviewChangeOrderRequest) (*dto.ChangeOrderResponse, error) {
+	order, err := s.getProjectChangeOrder(projectID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.IsReviewed() {
+		return nil, apperrors.ErrConflict("Change order has already been reviewed")
+	}
+
+	markReviewed(order, models.ChangeOrderStatusRejected, reviewerID, req)
+	if err := s.changeOrderRepo.Update(order); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toChangeOrderResponse(order, currency), nil
+}
+
+// getProjectChangeOrder retrieves a change order and checks that it belongs to the project
+func (s *ChangeOrderService) getProjectChangeOrder(projectID, orderID uuid.UUID) (*models.ChangeOrder, error) {
+	order, err := s.changeOrderRepo.GetByID(orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Change order")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if order.ProjectID != projectID {
+		return nil, apperrors.ErrNotFound("Change order")
+	}
+	return order, nil
+}
+
+// markReviewed records the decision on a change order
+func markReviewed(order *models.ChangeOrder, status string, reviewerID uuid.UUID, req *dto.ReviewChangeOrderRequest) {
+	now := time.Now()
+	order.Status = status
+	order.ReviewedBy = &reviewerID
+	order.ReviewedAt = &now
+	if req != nil {
+		order.ReviewComment = req.Comment
+	}
+}
+
+// toChangeOrderResponse converts a change order to a response
+func toChangeOrderResponse(order *models.ChangeOrder, currency string) *dto.ChangeOrderResponse {
+	return &dto.ChangeOrderResponse{
+		ID:                   order.ID,
+		ProjectID:            order.ProjectID,
+		Title:                order.Title,
+		Reason:               order.Reason,
+		BudgetAmount:         order.BudgetAmount,
+		Revenue:              order.Revenue,
+		EndDate:              formatOptionalDate(order.EndDate),
+		CostImpact:           order.CostImpact,
+		Currency:             currency,
+		Status:               order.Status,
+		RequestedBy:          order.RequestedBy,
+		ReviewedBy:           order.ReviewedBy,
+		ReviewedAt:           order.ReviewedAt,
+		ReviewComment:        order.ReviewComment,
+		PreviousBudgetAmount: order.PreviousBudgetAmount,
+		PreviousRevenue:      order.PreviousRevenue,
+		PreviousEndDate:      formatOptionalDate(order.PreviousEndDate),
+		CreatedAt:            order.CreatedAt,
+		UpdatedAt:            order.UpdatedAt,
+	}
+}
+
+// formatOptionalDate formats a date as YYYY-MM-DD, or returns nil when it is not set
+func formatOptionalDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format("2006-01-02")
+	return &formatted
+}
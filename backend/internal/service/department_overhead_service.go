@@ -0,0 +1,125 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// overheadMultiplierPlaces is the number of decimal places an overhead multiplier is kept to
+const overheadMultiplierPlaces = 3
+
+// DepartmentOverheadService handles business logic for the overhead multipliers of departments
+type DepartmentOverheadService struct {
+	departmentOverheadRepo *repository.DepartmentOverheadRepository
+}
+
+// NewDepartmentOverheadService creates a new DepartmentOverheadService
+func NewDepartmentOverheadService(db *gorm.DB) *DepartmentOverheadService {
+	return &DepartmentOverheadService{
+		departmentOverheadRepo: repository.NewDepartmentOverheadRepository(db),
+	}
+}
+
+// CreateDepartmentOverhead sets the overhead multiplier of a department from its effective date
+func (s *DepartmentOverheadService) CreateDepartmentOverhead(req *dto.CreateDepartmentOverheadRequest) (*dto.DepartmentOverheadResponse, error) {
+	effectiveFrom, err := time.Parse("2006-01-02", req.EffectiveFrom)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+
+	existing, err := s.departmentOverheadRepo.GetByEffectiveFrom(req.Department, effectiveFrom)
+	if err == nil && existing != nil {
+		return nil, apperrors.ErrConflict("Overhead for this department already takes effect on this date")
+	}
+
+	overhead := &models.DepartmentOverhead{
+		Department:    req.Department,
+		Multiplier:    money.RoundTo(req.Multiplier, overheadMultiplierPlaces),
+		EffectiveFrom: effectiveFrom,
+	}
+	if err := s.departmentOverheadRepo.Create(overhead); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toDepartmentOverheadResponse(overhead), nil
+}
+
+// ListDepartmentOverheads retrieves the overhead multipliers, optionally of one department
+func (s *DepartmentOverheadService) ListDepartmentOverheads(department *string) (*dto.DepartmentOverheadListResponse, error) {
+	overheads, err := s.departmentOverheadRepo.List(department)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	overheadResponses := make([]dto.DepartmentOverheadResponse, len(overheads))
+	for i := range overheads {
+		overheadResponses[i] = *s.toDepartmentOverheadResponse(&overheads[i])
+	}
+
+	return &dto.DepartmentOverheadListResponse{Overheads: overheadResponses}, nil
+}
+
+// UpdateDepartmentOverhead updates a department overhead multiplier
+func (s *DepartmentOverheadService) UpdateDepartmentOverhead(id uuid.UUID, req *dto.UpdateDepartmentOverheadRequest) (*dto.DepartmentOverheadResponse, error) {
+	overhead, err := s.getDepartmentOverhead(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields
+	if req.Multiplier != nil {
+		overhead.Multiplier = money.RoundTo(*req.Multiplier, overheadMultiplierPlaces)
+	}
+
+	if err := s.departmentOverheadRepo.Update(overhead); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toDepartmentOverheadResponse(overhead), nil
+}
+
+// DeleteDepartmentOverhead deletes a department overhead multiplier
+func (s *DepartmentOverheadService) DeleteDepartmentOverhead(id uuid.UUID) error {
+	if _, err := s.getDepartmentOverhead(id); err != nil {
+		return err
+	}
+
+	if err := s.departmentOverheadRepo.Delete(id); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	return nil
+}
+
+// getDepartmentOverhead retrieves a department overhead by ID
+func (s *DepartmentOverheadService) getDepartmentOverhead(id uuid.UUID) (*models.DepartmentOverhead, error) {
+	overhead, err := s.departmentOverheadRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Department overhead")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	return overhead, nil
+}
+
+// toDepartmentOverheadResponse converts a DepartmentOverhead model to DepartmentOverheadResponse DTO
+func (s *DepartmentOverheadService) toDepartmentOverheadResponse(overhead *models.DepartmentOverhead) *dto.DepartmentOverheadResponse {
+	return &dto.DepartmentOverheadResponse{
+		ID:            overhead.ID,
+		Department:    overhead.Department,
+		Multiplier:    overhead.Multiplier,
+		EffectiveFrom: overhead.EffectiveFrom.Format("2006-01-02"),
+		CreatedAt:     overhead.CreatedAt,
+		UpdatedAt:     overhead.UpdatedAt,
+	}
+}
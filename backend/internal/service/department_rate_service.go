@@ -0,0 +1,123 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// DepartmentRateService handles business logic for the default rates of departments
+type DepartmentRateService struct {
+	departmentRateRepo *repository.DepartmentRateRepository
+}
+
+// NewDepartmentRateService creates a new DepartmentRateService
+func NewDepartmentRateService(db *gorm.DB) *DepartmentRateService {
+	return &DepartmentRateService{
+		departmentRateRepo: repository.NewDepartmentRateRepository(db),
+	}
+}
+
+// CreateDepartmentRate sets the default rate of a department
+func (s *DepartmentRateService) CreateDepartmentRate(req *dto.CreateDepartmentRateRequest) (*dto.DepartmentRateResponse, error) {
+	existing, err := s.departmentRateRepo.GetByDepartment(req.Department)
+	if err == nil && existing != nil {
+		return nil, apperrors.ErrConflict("Rate for this department already exists")
+	}
+
+	rate := &models.DepartmentRate{
+		Department: req.Department,
+		HourlyRate: money.RoundRate(req.HourlyRate),
+		Currency:   req.Currency,
+	}
+	if rate.Currency == "" {
+		rate.Currency = defaultCurrency
+	}
+
+	if err := s.departmentRateRepo.Create(rate); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toDepartmentRateResponse(rate), nil
+}
+
+// ListDepartmentRates retrieves the default rates of all departments
+func (s *DepartmentRateService) ListDepartmentRates() (*dto.DepartmentRateListResponse, error) {
+	rates, err := s.departmentRateRepo.List()
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	rateResponses := make([]dto.DepartmentRateResponse, len(rates))
+	for i := range rates {
+		rateResponses[i] = *s.toDepartmentRateResponse(&rates[i])
+	}
+
+	return &dto.DepartmentRateListResponse{Rates: rateResponses}, nil
+}
+
+// UpdateDepartmentRate updates the default rate of a department
+func (s *DepartmentRateService) UpdateDepartmentRate(id uuid.UUID, req *dto.UpdateDepartmentRateRequest) (*dto.DepartmentRateResponse, error) {
+	rate, err := s.getDepartmentRate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update fields
+	if req.HourlyRate != nil {
+		rate.HourlyRate = money.RoundRate(*req.HourlyRate)
+	}
+	if req.Currency != nil {
+		rate.Currency = *req.Currency
+	}
+
+	if err := s.departmentRateRepo.Update(rate); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toDepartmentRateResponse(rate), nil
+}
+
+// DeleteDepartmentRate deletes the default rate of a department
+func (s *DepartmentRateService) DeleteDepartmentRate(id uuid.UUID) error {
+	if _, err := s.getDepartmentRate(id); err != nil {
+		return err
+	}
+
+	if err := s.departmentRateRepo.Delete(id); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	return nil
+}
+
+// getDepartmentRate retrieves a department rate by ID
+func (s *DepartmentRateService) getDepartmentRate(id uuid.UUID) (*models.DepartmentRate, error) {
+	rate, err := s.departmentRateRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Department rate")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	return rate, nil
+}
+
+// toDepartmentRateResponse converts a DepartmentRate model to DepartmentRateResponse DTO
+func (s *DepartmentRateService) toDepartmentRateResponse(rate *models.DepartmentRate) *dto.DepartmentRateResponse {
+	return &dto.DepartmentRateResponse{
+		ID:         rate.ID,
+		Department: rate.Department,
+		HourlyRate: rate.HourlyRate,
+		Currency:   rate.Currency,
+		CreatedAt:  rate.CreatedAt,
+		UpdatedAt:  rate.UpdatedAt,
+	}
+}
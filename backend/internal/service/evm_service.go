@@ -0,0 +1,277 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// EVMService calculates earned value management metrics for projects
+type EVMService struct {
+	db            *gorm.DB
+	timeEntryRepo *repository.TimeEntryRepository
+	expenseRepo   *repository.ExpenseRepository
+}
+
+// NewEVMService creates a new EVMService
+func NewEVMService(db *gorm.DB) *EVMService {
+	return &EVMService{
+		db:            db,
+		timeEntryRepo: repository.NewTimeEntryRepository(db),
+		expenseRepo:   repository.NewExpenseRepository(db),
+	}
+}
+
+// GetEVM calculates PV, EV, AC and the derived indices of a project as of the given date,
+// together with the daily cumulative series from the project start (S-curve).
+//
+// The budget at completion (BAC) is Project.BudgetAmount, allocated to tasks in proportion
+// to their planned hours. A task's planned value accrues linearly over its schedule, falling
+// back to the project schedule when the task has no dates. Its earned value follows recorded
+// hours against planned hours, and a completed task earns its full value by its last entry.
+func (s *EVMService) GetEVM(projectID uuid.UUID, asOf time.Time) (*dto.EVMResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	asOf = truncateToDate(asOf)
+
+	// Costs are converted into the budget currency at the as-of rates
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := resolveProjectRates(s.db, projectID, currency, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []models.Task
+	if err := s.db.Where("project_id = ?", projectID).Order("created_at ASC").Find(&tasks).Error; err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// All recorded hours are needed to tell how far a completed task was on a past date
+	taskDaily, err := s.timeEntryRepo.GetDailyHoursByTask(projectID, repository.DateRange{}, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	expenseDaily, err := s.expenseRepo.GetDailyAmounts(projectID, repository.DateRange{To: &asOf}, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	bac := decimal.Zero
+	if project.BudgetAmount != nil {
+		bac = *project.BudgetAmount
+	}
+	evmTasks, taskIndex := newEVMTasks(tasks, &project, bac)
+
+	// Index daily hours and costs by date, and find where the series starts
+	start := asOf
+	if project.StartDate != nil && project.StartDate.Before(start) {
+		start = truncateToDate(*project.StartDate)
+	}
+	for _, t := range evmTasks {
+		if t.start != nil && t.start.Before(start) {
+			start = *t.start
+		}
+	}
+
+	hoursByDate := make(map[string][]repository.TaskDailySummary)
+	costByDate := make(map[string]decimal.Decimal)
+	for _, td := range taskDaily {
+		if t, ok := taskIndex[td.TaskID]; ok {
+			t.totalHours = t.totalHours.Add(td.Hours)
+		}
+		workDate := truncateToDate(td.WorkDate)
+		if workDate.After(asOf) {
+			continue
+		}
+		if workDate.Before(start) {
+			start = workDate
+		}
+		key := workDate.Format("2006-01-02")
+		hoursByDate[key] = append(hoursByDate[key], td)
+		costByDate[key] = costByDate[key].Add(td.Cost)
+	}
+	for _, ed := range expenseDaily {
+		incurredDate := truncateToDate(ed.IncurredDate)
+		if incurredDate.Before(start) {
+			start = incurredDate
+		}
+		key := incurredDate.Format("2006-01-02")
+		costByDate[key] = costByDate[key].Add(ed.Amount)
+	}
+
+	// Build the cumulative daily series up to the as-of date
+	series := make([]dto.EVMPointResponse, 0, daysBetween(start, asOf)+1)
+	pv, ev, ac := decimal.Zero, decimal.Zero, decimal.Zero
+	for d := start; !d.After(asOf); d = d.AddDate(0, 0, 1) {
+		key := d.Format("2006-01-02")
+		for _, td := range hoursByDate[key] {
+			if t, ok := taskIndex[td.TaskID]; ok {
+				t.cumulativeHours = t.cumulativeHours.Add(td.Hours)
+			}
+		}
+		ac = ac.Add(costByDate[key])
+
+		pv, ev = decimal.Zero, decimal.Zero
+		for _, t := range evmTasks {
+			pv = pv.Add(t.plannedValue.Mul(t.scheduledFraction(d)))
+			ev = ev.Add(t.plannedValue.Mul(t.progress(d)))
+		}
+
+		series = append(series, dto.EVMPointResponse{
+			Date:         key,
+			PlannedValue: money.Round(pv, currency),
+			EarnedValue:  money.Round(ev, currency),
+			ActualCost:   money.Round(ac, currency),
+		})
+	}
+
+	response := &dto.EVMResponse{
+		ProjectID:          projectID,
+		AsOf:               asOf.Format("2006-01-02"),
+		Currency:           currency,
+		BudgetAtCompletion: money.Round(bac, currency),
+		PlannedValue:       money.Round(pv, currency),
+		EarnedValue:        money.Round(ev, currency),
+		ActualCost:         money.Round(ac, currency),
+		CostVariance:       money.Round(ev.Sub(ac), currency),
+		ScheduleVariance:   money.Round(ev.Sub(pv), currency),
+		PercentComplete:    money.Percentage(ev, bac),
+		Series:             series,
+	}
+
+	if ac.IsPositive() {
+		cpi := ev.Div(ac).InexactFloat64()
+		response.CPI = &cpi
+	}
+	if pv.IsPositive() {
+		spi := ev.Div(pv).InexactFloat64()
+		response.SPI = &spi
+	}
+
+	// EAC assumes the current cost performance continues; without it the
+	// remaining work is estimated at budget
+	var eac decimal.Decimal
+	if ac.IsPositive() && ev.IsPositive() {
+		eac = bac.Mul(ac).Div(ev)
+	} else {
+		eac = ac.Add(bac.Sub(ev))
+	}
+	response.EstimateAtCompletion = money.Round(eac, currency)
+	response.EstimateToComplete = money.Round(decimal.Max(eac.Sub(ac), decimal.Zero), currency)
+	response.VarianceAtCompletion = money.Round(bac.Sub(eac), currency)
+
+	return response, nil
+}
+
+// evmTask holds the planned value, schedule and progress of a single task
+type evmTask struct {
+	plannedValue    decimal.Decimal
+	plannedHours    decimal.Decimal
+	completed       bool
+	start           *time.Time
+	end             *time.Time
+	totalHours      decimal.Decimal
+	cumulativeHours decimal.Decimal
+}
+
+// newEVMTasks allocates the budget at completion to tasks by planned hours,
+// or evenly when no task has planned hours
+func newEVMTasks(tasks []models.Task, project *models.Project, bac decimal.Decimal) ([]*evmTask, map[uuid.UUID]*evmTask) {
+	totalPlannedHours := decimal.Zero
+	for _, task := range tasks {
+		totalPlannedHours = totalPlannedHours.Add(task.PlannedHours)
+	}
+
+	evmTasks := make([]*evmTask, len(tasks))
+	index := make(map[uuid.UUID]*evmTask, len(tasks))
+	for i, task := range tasks {
+		t := &evmTask{
+			plannedHours: task.PlannedHours,
+			completed:    task.Status == "completed",
+		}
+
+		if totalPlannedHours.IsPositive() {
+			t.plannedValue = bac.Mul(task.PlannedHours).Div(totalPlannedHours)
+		} else {
+			t.plannedValue = bac.Div(decimal.NewFromInt(int64(len(tasks))))
+		}
+
+		start, end := task.StartDate, task.EndDate
+		if start == nil {
+			start = project.StartDate
+		}
+		if end == nil {
+			end = project.EndDate
+		}
+		if start == nil {
+			start = end
+		}
+		if end == nil || (start != nil && end.Before(*start)) {
+			end = start
+		}
+		if start != nil {
+			s, e := truncateToDate(*start), truncateToDate(*end)
+			t.start, t.end = &s, &e
+		}
+
+		evmTasks[i] = t
+		index[task.ID] = t
+	}
+
+	return evmTasks, index
+}
+
+// scheduledFraction returns the share of the task planned to be done by the given date.
+// Unscheduled tasks are treated as due from the start.
+func (t *evmTask) scheduledFraction(date time.Time) decimal.Decimal {
+	if t.start == nil {
+		return decimal.NewFromInt(1)
+	}
+	if date.Before(*t.start) {
+		return decimal.Zero
+	}
+	if !date.Before(*t.end) {
+		return decimal.NewFromInt(1)
+	}
+	elapsed := decimal.NewFromInt(int64(daysBetween(*t.start, date) + 1))
+	return elapsed.Div(decimal.NewFromInt(int64(daysBetween(*t.start, *t.end) + 1)))
+}
+
+// progress returns the share of the task completed by the given date, based on
+// the hours recorded so far
+func (t *evmTask) progress(date time.Time) decimal.Decimal {
+	if t.completed {
+		if t.totalHours.IsPositive() {
+			return t.cumulativeHours.Div(t.totalHours)
+		}
+		// Completed without recorded hours: counted as done at its scheduled end
+		if t.end == nil || !date.Before(*t.end) {
+			return decimal.NewFromInt(1)
+		}
+		return decimal.Zero
+	}
+
+	if !t.plannedHours.IsPositive() {
+		return decimal.Zero
+	}
+	return decimal.Min(t.cumulativeHours.Div(t.plannedHours), decimal.NewFromInt(1))
+}
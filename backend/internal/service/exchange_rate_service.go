@@ -0,0 +1,303 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// defaultCurrency is used when a project has no budget currency yet
+const defaultCurrency = "JPY"
+
+// ExchangeRateService handles business logic for exchange rates
+type ExchangeRateService struct {
+	db               *gorm.DB
+	exchangeRateRepo *repository.ExchangeRateRepository
+}
+
+// NewExchangeRateService creates a new ExchangeRateService
+func NewExchangeRateService(db *gorm.DB) *ExchangeRateService {
+	return &ExchangeRateService{
+		db:               db,
+		exchangeRateRepo: repository.NewExchangeRateRepository(db),
+	}
+}
+
+// CreateExchangeRate creates a new exchange rate
+func (s *ExchangeRateService) CreateExchangeRate(req *dto.CreateExchangeRateRequest) (*dto.ExchangeRateResponse, error) {
+	rate, err := toExchangeRateModel(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureUnique(rate); err != nil {
+		return nil, err
+	}
+
+	if err := s.exchangeRateRepo.Create(rate); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toExchangeRateResponse(rate), nil
+}
+
+// GetExchangeRate retrieves an exchange rate by ID
+func (s *ExchangeRateService) GetExchangeRate(id uuid.UUID) (*dto.ExchangeRateResponse, error) {
+	rate, err := s.exchangeRateRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("ExchangeRate")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toExchangeRateResponse(rate), nil
+}
+
+// ListExchangeRates retrieves exchange rates with filtering
+func (s *ExchangeRateService) ListExchangeRates(params repository.ExchangeRateListParams) (*dto.ExchangeRateListResponse, error) {
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PerPage < 1 || params.PerPage > 100 {
+		params.PerPage = 20
+	}
+
+	rates, total, err := s.exchangeRateRepo.List(params)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Convert to response
+	rateResponses := make([]dto.ExchangeRateResponse, len(rates))
+	for i, rate := range rates {
+		rateResponses[i] = *s.toExchangeRateResponse(&rate)
+	}
+
+	totalPages := int(total) / params.PerPage
+	if int(total)%params.PerPage > 0 {
+		totalPages++
+	}
+
+	return &dto.ExchangeRateListResponse{
+		ExchangeRates: rateResponses,
+		Pagination: dto.Pagination{
+			Page:       params.Page,
+			PerPage:    params.PerPage,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// UpdateExchangeRate updates an exchange rate
+func (s *ExchangeRateService) UpdateExchangeRate(id uuid.UUID, req *dto.UpdateExchangeRateRequest) (*dto.ExchangeRateResponse, error) {
+	rate, err := s.exchangeRateRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("ExchangeRate")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Update fields
+	if req.RateDate != nil {
+		rateDate, err := time.Parse("2006-01-02", *req.RateDate)
+		if err != nil {
+			return nil, apperrors.ErrInvalidInput(err)
+		}
+		rate.RateDate = rateDate
+		if err := s.ensureUnique(rate); err != nil {
+			return nil, err
+		}
+	}
+	if req.Rate != nil {
+		rate.Rate = *req.Rate
+	}
+
+	if err := s.exchangeRateRepo.Update(rate); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toExchangeRateResponse(rate), nil
+}
+
+// DeleteExchangeRate deletes an exchange rate
+func (s *ExchangeRateService) DeleteExchangeRate(id uuid.UUID) error {
+	if _, err := s.exchangeRateRepo.GetByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.ErrNotFound("ExchangeRate")
+		}
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	if err := s.exchangeRateRepo.Delete(id); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	return nil
+}
+
+// ImportExchangeRates creates or overwrites exchange rates in bulk.
+// Nothing is imported when any row is invalid; later rows win over earlier
+// rows for the same date and currency pair.
+func (s *ExchangeRateService) ImportExchangeRates(req *dto.ImportExchangeRatesRequest) (*dto.ImportExchangeRatesResponse, error) {
+	type rateKey struct {
+		date     string
+		from, to string
+	}
+
+	index := make(map[rateKey]int, len(req.Rates))
+	rates := make([]models.ExchangeRate, 0, len(req.Rates))
+	for i := range req.Rates {
+		rate, err := toExchangeRateModel(&req.Rates[i])
+		if err != nil {
+			return nil, err
+		}
+
+		key := rateKey{date: req.Rates[i].RateDate, from: rate.FromCurrency, to: rate.ToCurrency}
+		if j, ok := index[key]; ok {
+			rates[j].Rate = rate.Rate
+			continue
+		}
+		index[key] = len(rates)
+		rates = append(rates, *rate)
+	}
+
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		return repository.NewExchangeRateRepository(tx).Upsert(rates)
+	}); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return &dto.ImportExchangeRatesResponse{Imported: len(rates)}, nil
+}
+
+// ensureUnique verifies no other rate exists for the same date and currency pair
+func (s *ExchangeRateService) ensureUnique(rate *models.ExchangeRate) error {
+	var count int64
+	if err := s.db.Model(&models.ExchangeRate{}).
+		Where("rate_date = ? AND from_currency = ? AND to_currency = ? AND id <> ?",
+			rate.RateDate, rate.FromCurrency, rate.ToCurrency, rate.ID).
+		Count(&count).Error; err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+	if count > 0 {
+		return apperrors.ErrAlreadyExists("ExchangeRate")
+	}
+	return nil
+}
+
+// toExchangeRateModel converts a create request to an ExchangeRate model
+func toExchangeRateModel(req *dto.CreateExchangeRateRequest) (*models.ExchangeRate, error) {
+	rateDate, err := time.Parse("2006-01-02", req.RateDate)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	if req.FromCurrency == req.ToCurrency {
+		return nil, apperrors.ErrValidationFailed("from_currency and to_currency must differ")
+	}
+
+	return &models.ExchangeRate{
+		RateDate:     rateDate,
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		Rate:         req.Rate,
+	}, nil
+}
+
+// toExchangeRateResponse converts an ExchangeRate model to ExchangeRateResponse DTO
+func (s *ExchangeRateService) toExchangeRateResponse(rate *models.ExchangeRate) *dto.ExchangeRateResponse {
+	return &dto.ExchangeRateResponse{
+		ID:           rate.ID,
+		RateDate:     rate.RateDate.Format("2006-01-02"),
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate,
+		CreatedAt:    rate.CreatedAt,
+		UpdatedAt:    rate.UpdatedAt,
+	}
+}
+
+// resolveRates resolves the closing rates converting each currency into the reporting
+// currency on the given date. The latest direct rate on or before the date is used,
+// falling back to the inverse of the latest reverse rate.
+func resolveRates(db *gorm.DB, currencies []string, reportingCurrency string, date time.Time) (repository.CurrencyRates, error) {
+	exchangeRateRepo := repository.NewExchangeRateRepository(db)
+
+	rates := make(repository.CurrencyRates)
+	for _, currency := range currencies {
+		if currency == reportingCurrency {
+			continue
+		}
+		if _, ok := rates[currency]; ok {
+			continue
+		}
+
+		direct, err := exchangeRateRepo.FindLatest(currency, reportingCurrency, date)
+		if err == nil {
+			rates[currency] = direct.Rate
+			continue
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrDatabaseError(err)
+		}
+
+		inverse, err := exchangeRateRepo.FindLatest(reportingCurrency, currency, date)
+		if err == nil {
+			rates[currency] = decimal.NewFromInt(1).Div(inverse.Rate)
+			continue
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrDatabaseError(err)
+		}
+
+		return nil, apperrors.ErrExchangeRateNotFound(currency, reportingCurrency)
+	}
+
+	return rates, nil
+}
+
+// resolveProjectRates resolves the rates converting every currency used by the
+// time entries, expenses and revenue items of a project into the reporting currency
+func resolveProjectRates(db *gorm.DB, projectID uuid.UUID, reportingCurrency string, date time.Time) (repository.CurrencyRates, error) {
+	laborCurrencies, err := repository.NewTimeEntryRepository(db).GetCurrencies(projectID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	expenseCurrencies, err := repository.NewExpenseRepository(db).GetCurrencies(projectID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	revenueCurrencies, err := repository.NewRevenueItemRepository(db).GetCurrencies(projectID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	currencies := append(laborCurrencies, expenseCurrencies...)
+	return resolveRates(db, append(currencies, revenueCurrencies...), reportingCurrency, date)
+}
+
+// projectCurrency returns the budget currency of a project, which is the
+// currency its costs are reported in
+func projectCurrency(db *gorm.DB, projectID uuid.UUID) (string, error) {
+	var budget models.Budget
+	if err := db.Select("currency").First(&budget, "project_id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return defaultCurrency, nil
+		}
+		return "", apperrors.ErrDatabaseError(err)
+	}
+	if budget.Currency == "" {
+		return defaultCurrency, nil
+	}
+	return budget.Currency, nil
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// ExpenseService handles business logic for non-labor expenses
+type ExpenseService struct {
+	db            *gorm.DB
+	expenseRepo   *repository.ExpenseRepository
+	budgetService *BudgetService
+}
+
+// NewExpenseService creates a new ExpenseService
+func NewExpenseService(db *gorm.DB, dailyLimit DailyHoursLimit) *ExpenseService {
+	return &ExpenseService{
+		db:            db,
+		expenseRepo:   repository.NewExpenseRepository(db),
+		budgetService: NewBudgetService(db, dailyLimit),
+	}
+}
+
+// CreateExpense creates a new expense for a project
+func (s *ExpenseService) CreateExpense(projectID, userID uuid.UUID, req *dto.CreateExpenseRequest) (*dto.ExpenseResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Parse incurred date
+	incurredDate, err := time.Parse("2006-01-02", req.IncurredDate)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	if err := ensurePeriodsOpen(s.db, incurredDate); err != nil {
+		return nil, err
+	}
+
+	// Default to the project's budget currency
+	currency := req.Currency
+	if currency == "" {
+		if currency, err = projectCurrency(s.db, projectID); err != nil {
+			return nil, err
+		}
+	}
+
+	expense := &models.Expense{
+		ProjectID:    projectID,
+		UserID:       userID,
+		Category:     req.Category,
+		Amount:       money.Round(req.Amount, currency),
+		Currency:     currency,
+		Vendor:       req.Vendor,
+		IncurredDate: incurredDate,
+		Note:         req.Note,
+	}
+
+	if err := s.expenseRepo.Create(expense); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	s.budgetService.reevaluateAlerts(projectID)
+
+	return s.toExpenseResponse(expense), nil
+}
+
+// GetExpense retrieves an expense of a project by ID
+func (s *ExpenseService) GetExpense(projectID, id uuid.UUID) (*dto.ExpenseResponse, error) {
+	expense, err := s.getProjectExpense(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExpenseResponse(expense), nil
+}
+
+// ListExpenses retrieves expenses of a project with filtering
+func (s *ExpenseService) ListExpenses(params repository.ExpenseListParams) (*dto.ExpenseListResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", params.ProjectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PerPage < 1 || params.PerPage > 100 {
+		params.PerPage = 20
+	}
+
+	expenses, total, err := s.expenseRepo.List(params)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Convert to response
+	expenseResponses := make([]dto.ExpenseResponse, len(expenses))
+	for i, expense := range expenses {
+		expenseResponses[i] = *s.toExpenseResponse(&expense)
+	}
+
+	// Amounts are totalled in the budget currency at today's rates
+	currency, err := projectCurrency(s.db, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := resolveProjectRates(s.db, project.ID, currency, truncateToDate(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	totalAmount := decimal.Zero
+	for _, expense := range expenses {
+		totalAmount = totalAmount.Add(rates.Convert(expense.Amount, expense.Currency))
+	}
+
+	totalPages := int(total) / params.PerPage
+	if int(total)%params.PerPage > 0 {
+		totalPages++
+	}
+
+	return &dto.ExpenseListResponse{
+		Expenses: expenseResponses,
+		Pagination: dto.Pagination{
+			Page:       params.Page,
+			PerPage:    params.PerPage,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+		Summary: &dto.ExpenseSummary{
+			TotalAmount: money.Round(totalAmount, currency),
+			Currency:    currency,
+		},
+	}, nil
+}
+
+// UpdateExpense updates an expense of a project
+func (s *ExpenseService) UpdateExpense(projectID, id uuid.UUID, req *dto.UpdateExpenseRequest) (*dto.ExpenseResponse, error) {
+	expense, err := s.getProjectExpense(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePeriodsOpen(s.db, expense.IncurredDate); err != nil {
+		return nil, err
+	}
+
+	// Update fields
+	if req.Category != nil {
+		expense.Category = *req.Category
+	}
+	if req.Amount != nil {
+		expense.Amount = *req.Amount
+	}
+	if req.Currency != nil {
+		expense.Currency = *req.Currency
+	}
+	if req.Vendor != nil {
+		expense.Vendor = req.Vendor
+	}
+	if req.IncurredDate != nil {
+		incurredDate, err := time.Parse("2006-01-02", *req.IncurredDate)
+		if err != nil {
+			return nil, apperrors.ErrInvalidInput(err)
+		}
+		if err := ensurePeriodsOpen(s.db, incurredDate); err != nil {
+			return nil, err
+		}
+		expense.IncurredDate = incurredDate
+	}
+	if req.Note != nil {
+		expense.Note = req.Note
+	}
+	expense.Amount = money.Round(expense.Amount, expense.Currency)
+
+	if err := s.expenseRepo.Update(expense); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	s.budgetService.reevaluateAlerts(projectID)
+
+	return s.toExpenseResponse(expense), nil
+}
+
+// DeleteExpense deletes an expense of a project
+func (s *ExpenseService) DeleteExpense(projectID, id uuid.UUID) error {
+	expense, err := s.getProjectExpense(projectID, id)
+	if err != nil {
+		return err
+	}
+	if err := ensurePeriodsOpen(s.db, expense.IncurredDate); err != nil {
+		return err
+	}
+
+	if err := s.expenseRepo.Delete(id); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	s.budgetService.reevaluateAlerts(projectID)
+
+	return nil
+}
+
+// getProjectExpense retrieves an expense and ensures it belongs to the project
+func (s *ExpenseService) getProjectExpense(projectID, id uuid.UUID) (*models.Expense, error) {
+	expense, err := s.expenseRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Expense")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if expense.ProjectID != projectID {
+		return nil, apperrors.ErrNotFound("Expense")
+	}
+
+	return expense, nil
+}
+
+// toExpenseResponse converts an Expense model to ExpenseResponse DTO
+func (s *ExpenseService) toExpenseResponse(expense *models.Expense) *dto.ExpenseResponse {
+	return &dto.ExpenseResponse{
+		ID:           expense.ID,
+		ProjectID:    expense.ProjectID,
+		UserID:       expense.UserID,
+		Category:     expense.Category,
+		Amount:       expense.Amount,
+		Currency:     expense.Currency,
+		Vendor:       expense.Vendor,
+		IncurredDate: expense.IncurredDate.Format("2006-01-02"),
+		Note:         expense.Note,
+		CreatedAt:    expense.CreatedAt,
+		UpdatedAt:    expense.UpdatedAt,
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// HolidayService handles business logic for the holiday calendar
+type HolidayService struct {
+	holidayRepo *repository.HolidayRepository
+}
+
+// NewHolidayService creates a new HolidayService
+func NewHolidayService(db *gorm.DB) *HolidayService {
+	return &HolidayService{
+		holidayRepo: repository.NewHolidayRepository(db),
+	}
+}
+
+// CreateHoliday registers a holiday
+func (s *HolidayService) CreateHoliday(req *dto.CreateHolidayRequest) (*dto.HolidayResponse, error) {
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+
+	existing, err := s.holidayRepo.GetByDate(date)
+	if err == nil && existing != nil {
+		return nil, apperrors.ErrConflict("Holiday on this date already exists")
+	}
+
+	holiday := &models.Holiday{
+		Date: date,
+		Name: req.Name,
+	}
+	if err := s.holidayRepo.Create(holiday); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toHolidayResponse(holiday), nil
+}
+
+// ListHolidays retrieves the holidays within the period
+func (s *HolidayService) ListHolidays(period repository.DateRange) (*dto.HolidayListResponse, error) {
+	holidays, err := s.holidayRepo.List(period)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	holidayResponses := make([]dto.HolidayResponse, len(holidays))
+	for i := range holidays {
+		holidayResponses[i] = *s.toHolidayResponse(&holidays[i])
+	}
+
+	return &dto.HolidayListResponse{Holidays: holidayResponses}, nil
+}
+
+// DeleteHoliday deletes a holiday
+func (s *HolidayService) DeleteHoliday(id uuid.UUID) error {
+	if _, err := s.holidayRepo.GetByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.ErrNotFound("Holiday")
+		}
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	if err := s.holidayRepo.Delete(id); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	return nil
+}
+
+// toHolidayResponse converts a Holiday model to HolidayResponse DTO
+func (s *HolidayService) toHolidayResponse(holiday *models.Holiday) *dto.HolidayResponse {
+	return &dto.HolidayResponse{
+		ID:        holiday.ID,
+		Date:      holiday.Date.Format("2006-01-02"),
+		Name:      holiday.Name,
+		CreatedAt: holiday.CreatedAt,
+		UpdatedAt: holiday.UpdatedAt,
+	}
+}
+
+// workingCalendar tells working days apart from weekends and registered holidays
+type workingCalendar map[string]bool
+
+// loadWorkingCalendar loads the holidays within the period
+func loadWorkingCalendar(db *gorm.DB, from, to time.Time) (workingCalendar, error) {
+	holidays, err := repository.NewHolidayRepository(db).List(repository.DateRange{From: &from, To: &to})
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	calendar := make(workingCalendar, len(holidays))
+	for _, holiday := range holidays {
+		calendar[holiday.Date.Format("2006-01-02")] = true
+	}
+	return calendar, nil
+}
+
+// IsWorkingDay checks if the date is neither a weekend nor a holiday
+func (wc workingCalendar) IsWorkingDay(date time.Time) bool {
+	if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+		return false
+	}
+	return !wc[date.Format("2006-01-02")]
+}
+
+// WorkingDays counts the working days from start to end inclusive
+func (wc workingCalendar) WorkingDays(start, end time.Time) int {
+	days := 0
+	for date := truncateToDate(start); !date.After(end); date = date.AddDate(0, 0, 1) {
+		if wc.IsWorkingDay(date) {
+			days++
+		}
+	}
+	return days
+}
@@ -0,0 +1,423 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// InvoiceService handles business logic for invoicing time entries
+type InvoiceService struct {
+	db          *gorm.DB
+	invoiceRepo *repository.InvoiceRepository
+}
+
+// NewInvoiceService creates a new InvoiceService
+func NewInvoiceService(db *gorm.DB) *InvoiceService {
+	return &InvoiceService{
+		db:          db,
+		invoiceRepo: repository.NewInvoiceRepository(db),
+	}
+}
+
+// CreateInvoice creates a draft invoice for the billable time entries of a project within
+// the date range that are not on another invoice yet. The entries are reserved for the
+// invoice until it is deleted.
+func (s *InvoiceService) CreateInvoice(projectID uuid.UUID, req *dto.CreateInvoiceRequest) (*dto.InvoiceResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Parse the billing period
+	from, err := time.Parse("2006-01-02", req.From)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	to, err := time.Parse("2006-01-02", req.To)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	if from.After(to) {
+		return nil, apperrors.ErrValidationFailed("from must be on or before to")
+	}
+
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = models.InvoiceGroupByMember
+	}
+
+	// Invoices are billed in the budget currency
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := &models.Invoice{
+		ProjectID:   projectID,
+		Status:      models.InvoiceStatusDraft,
+		PeriodStart: from,
+		PeriodEnd:   to,
+		GroupBy:     groupBy,
+		Currency:    currency,
+		Note:        req.Note,
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		timeEntryRepo := repository.NewTimeEntryRepository(tx)
+
+		entries, err := timeEntryRepo.GetUnbilledByProject(projectID, repository.DateRange{From: &from, To: &to})
+		if err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		if len(entries) == 0 {
+			return apperrors.ErrValidationFailed("No unbilled time entries in the period")
+		}
+
+		if err := s.applyLines(tx, invoice, entries); err != nil {
+			return err
+		}
+		if err := repository.NewInvoiceRepository(tx).Create(invoice); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+
+		ids := make([]uuid.UUID, len(entries))
+		for i, entry := range entries {
+			ids[i] = entry.ID
+		}
+		if err := timeEntryRepo.AssignInvoice(ids, invoice.ID); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetInvoice(projectID, invoice.ID)
+}
+
+// GetInvoice retrieves an invoice of a project with its lines
+func (s *InvoiceService) GetInvoice(projectID, id uuid.UUID) (*dto.InvoiceResponse, error) {
+	invoice, err := s.getProjectInvoice(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toInvoiceResponse(invoice), nil
+}
+
+// ListInvoices retrieves invoices of a project with filtering
+func (s *InvoiceService) ListInvoices(params repository.InvoiceListParams) (*dto.InvoiceListResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", params.ProjectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PerPage < 1 || params.PerPage > 100 {
+		params.PerPage = 20
+	}
+
+	invoices, total, err := s.invoiceRepo.List(params)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Convert to response
+	invoiceResponses := make([]dto.InvoiceResponse, len(invoices))
+	for i := range invoices {
+		invoiceResponses[i] = *s.toInvoiceResponse(&invoices[i])
+	}
+
+	totalPages := int(total) / params.PerPage
+	if int(total)%params.PerPage > 0 {
+		totalPages++
+	}
+
+	return &dto.InvoiceListResponse{
+		Invoices: invoiceResponses,
+		Pagination: dto.Pagination{
+			Page:       params.Page,
+			PerPage:    params.PerPage,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// IssueInvoice issues a draft invoice. The lines are recalculated from the reserved
+// time entries, which are locked from then on, and the next invoice number is assigned.
+func (s *InvoiceService) IssueInvoice(projectID, id uuid.UUID) (*dto.InvoiceResponse, error) {
+	invoice, err := s.getProjectInvoice(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		invoiceRepo := repository.NewInvoiceRepository(tx)
+
+		// The status is checked on the locked row, so an invoice issued twice at once is
+		// numbered only once
+		locked, err := invoiceRepo.GetByIDForUpdate(invoice.ID)
+		if err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		if locked.Status != models.InvoiceStatusDraft {
+			return apperrors.ErrConflict("Only draft invoices can be issued")
+		}
+		invoice = locked
+
+		// The reserved entries may have been edited while the invoice was a draft
+		entries, err := repository.NewTimeEntryRepository(tx).GetByInvoice(invoice.ID)
+		if err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		if len(entries) == 0 {
+			return apperrors.ErrValidationFailed("The invoice has no time entries")
+		}
+		if err := s.applyLines(tx, invoice, entries); err != nil {
+			return err
+		}
+		if err := invoiceRepo.ReplaceLines(invoice.ID, invoice.Lines); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+
+		sequence, err := invoiceRepo.NextSequenceNumber()
+		if err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		number := fmt.Sprintf("INV-%06d", sequence)
+		now := time.Now()
+		invoice.SequenceNumber = &sequence
+		invoice.InvoiceNumber = &number
+		invoice.Status = models.InvoiceStatusIssued
+		invoice.IssuedAt = &now
+
+		if err := invoiceRepo.Update(invoice); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetInvoice(projectID, invoice.ID)
+}
+
+// PayInvoice marks an issued invoice as paid
+func (s *InvoiceService) PayInvoice(projectID, id uuid.UUID) (*dto.InvoiceResponse, error) {
+	invoice, err := s.getProjectInvoice(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.Status != models.InvoiceStatusIssued {
+		return nil, apperrors.ErrConflict("Only issued invoices can be marked as paid")
+	}
+
+	now := time.Now()
+	invoice.Status = models.InvoiceStatusPaid
+	invoice.PaidAt = &now
+
+	if err := s.invoiceRepo.Update(invoice); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return s.toInvoiceResponse(invoice), nil
+}
+
+// DeleteInvoice deletes a draft invoice and releases its time entries
+func (s *InvoiceService) DeleteInvoice(projectID, id uuid.UUID) error {
+	invoice, err := s.getProjectInvoice(projectID, id)
+	if err != nil {
+		return err
+	}
+	if invoice.Status != models.InvoiceStatusDraft {
+		return apperrors.ErrConflict("Only draft invoices can be deleted")
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := repository.NewTimeEntryRepository(tx).ReleaseInvoice(invoice.ID); err != nil {
+			return err
+		}
+		return repository.NewInvoiceRepository(tx).Delete(invoice.ID)
+	})
+	if err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	return nil
+}
+
+// recalculateDraft recalculates the lines and totals of a draft invoice from the time entries
+// still reserved for it, after one of them has changed or left the invoice
+func (s *InvoiceService) recalculateDraft(invoiceID uuid.UUID) error {
+	// The row is locked so that an invoice being issued is not turned back into a draft
+	invoice, err := s.invoiceRepo.GetByIDForUpdate(invoiceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return apperrors.ErrDatabaseError(err)
+	}
+	if invoice.IsLocked() {
+		return nil
+	}
+
+	entries, err := repository.NewTimeEntryRepository(s.db).GetByInvoice(invoice.ID)
+	if err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+	if err := s.applyLines(s.db, invoice, entries); err != nil {
+		return err
+	}
+	if err := s.invoiceRepo.ReplaceLines(invoice.ID, invoice.Lines); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+	if err := s.invoiceRepo.Update(invoice); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+	return nil
+}
+
+// applyLines sets the lines and totals of an invoice from its time entries, one line per
+// member or task, valued at their bill rates. Amounts in other currencies are converted at
+// the rate of the period end.
+func (s *InvoiceService) applyLines(db *gorm.DB, invoice *models.Invoice, entries []models.TimeEntry) error {
+	currencies := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		currencies = append(currencies, entry.Currency)
+	}
+	rates, err := resolveRates(db, currencies, invoice.Currency, invoice.PeriodEnd)
+	if err != nil {
+		return err
+	}
+
+	type lineKey struct {
+		memberID uuid.UUID
+		taskID   uuid.UUID
+	}
+	linesByKey := make(map[lineKey]*models.InvoiceLine)
+	for _, entry := range entries {
+		key := lineKey{memberID: entry.MemberID}
+		description := entry.Member.Name
+		if invoice.GroupBy == models.InvoiceGroupByTask {
+			key = lineKey{taskID: entry.TaskID}
+			description = entry.Task.Name
+		}
+
+		line, ok := linesByKey[key]
+		if !ok {
+			line = &models.InvoiceLine{Description: description}
+			if invoice.GroupBy == models.InvoiceGroupByTask {
+				taskID := entry.TaskID
+				line.TaskID = &taskID
+			} else {
+				memberID := entry.MemberID
+				line.MemberID = &memberID
+			}
+			linesByKey[key] = line
+		}
+		line.Hours = line.Hours.Add(entry.Hours)
+		line.Amount = line.Amount.Add(rates.Convert(entry.BillValue(), entry.Currency))
+	}
+
+	lines := make([]models.InvoiceLine, 0, len(linesByKey))
+	for _, line := range linesByKey {
+		lines = append(lines, *line)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return lines[i].Description < lines[j].Description
+	})
+
+	totalHours := decimal.Zero
+	totalAmount := decimal.Zero
+	for i := range lines {
+		lines[i].SortOrder = i + 1
+		lines[i].Hours = money.RoundHours(lines[i].Hours)
+		lines[i].Amount = money.Round(lines[i].Amount, invoice.Currency)
+		if lines[i].Hours.IsPositive() {
+			lines[i].UnitPrice = money.Round(lines[i].Amount.Div(lines[i].Hours), invoice.Currency)
+		}
+		totalHours = totalHours.Add(lines[i].Hours)
+		totalAmount = totalAmount.Add(lines[i].Amount)
+	}
+
+	invoice.Lines = lines
+	invoice.TotalHours = totalHours
+	invoice.TotalAmount = totalAmount
+	return nil
+}
+
+// getProjectInvoice retrieves an invoice and ensures it belongs to the project
+func (s *InvoiceService) getProjectInvoice(projectID, id uuid.UUID) (*models.Invoice, error) {
+	invoice, err := s.invoiceRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Invoice")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if invoice.ProjectID != projectID {
+		return nil, apperrors.ErrNotFound("Invoice")
+	}
+
+	return invoice, nil
+}
+
+// toInvoiceResponse converts an Invoice model to InvoiceResponse DTO
+func (s *InvoiceService) toInvoiceResponse(invoice *models.Invoice) *dto.InvoiceResponse {
+	response := &dto.InvoiceResponse{
+		ID:            invoice.ID,
+		ProjectID:     invoice.ProjectID,
+		InvoiceNumber: invoice.InvoiceNumber,
+		Status:        invoice.Status,
+		PeriodStart:   invoice.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:     invoice.PeriodEnd.Format("2006-01-02"),
+		GroupBy:       invoice.GroupBy,
+		Currency:      invoice.Currency,
+		TotalHours:    invoice.TotalHours,
+		TotalAmount:   invoice.TotalAmount,
+		Note:          invoice.Note,
+		IssuedAt:      invoice.IssuedAt,
+		PaidAt:        invoice.PaidAt,
+		CreatedAt:     invoice.CreatedAt,
+		UpdatedAt:     invoice.UpdatedAt,
+	}
+
+	for _, line := range invoice.Lines {
+		response.Lines = append(response.Lines, dto.InvoiceLineResponse{
+			MemberID:    line.MemberID,
+			TaskID:      line.TaskID,
+			Description: line.Description,
+			Hours:       line.Hours,
+			UnitPrice:   line.UnitPrice,
+			Amount:      line.Amount,
+		})
+	}
+
+	return response
+}
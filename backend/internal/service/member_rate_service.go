@@ -0,0 +1,377 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// MemberRateService handles business logic for the hourly rate history of members
+type MemberRateService struct {
+	db             *gorm.DB
+	memberRepo     *repository.MemberRepository
+	memberRateRepo *repository.MemberRateRepository
+	timeEntryRepo  *repository.TimeEntryRepository
+	budgetService  *BudgetService
+}
+
+// NewMemberRateService creates a new MemberRateService
+func NewMemberRateService(db *gorm.DB, dailyLimit DailyHoursLimit) *MemberRateService {
+	return &MemberRateService{
+		db:             db,
+		memberRepo:     repository.NewMemberRepository(db),
+		memberRateRepo: repository.NewMemberRateRepository(db),
+		timeEntryRepo:  repository.NewTimeEntryRepository(db),
+		budgetService:  NewBudgetService(db, dailyLimit),
+	}
+}
+
+// ListMemberRates retrieves the rate history of a member, oldest first
+func (s *MemberRateService) ListMemberRates(memberID uuid.UUID) (*dto.MemberRateListResponse, error) {
+	if _, err := s.getMember(memberID); err != nil {
+		return nil, err
+	}
+
+	rates, err := s.memberRateRepo.ListByMember(memberID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	rateResponses := make([]dto.MemberRateResponse, len(rates))
+	for i := range rates {
+		rateResponses[i] = *s.toMemberRateResponse(&rates[i])
+	}
+
+	return &dto.MemberRateListResponse{Rates: rateResponses}, nil
+}
+
+// CreateMemberRate changes the hourly rate of a member from a date, which may lie in the
+// past or the future. Existing time entries keep their snapshots until recomputed.
+func (s *MemberRateService) CreateMemberRate(memberID uuid.UUID, req *dto.CreateMemberRateRequest) (*dto.MemberRateResponse, error) {
+	member, err := s.getMember(memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveFrom, err := time.Parse("2006-01-02", req.EffectiveFrom)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = member.Currency
+	}
+
+	var rate *models.MemberRate
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		rate, err = applyMemberRate(tx, member, money.RoundRate(req.HourlyRate), currency, effectiveFrom)
+		if err != nil {
+			return err
+		}
+		if err := repository.NewMemberRepository(tx).Update(member); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toMemberRateResponse(rate), nil
+}
+
+// RecomputeRateSnapshots recalculates the cost rate snapshots of the time entries within
+// the period, e.g. after a retroactive raise. Rates entered for a time entry are kept.
+// A dry run only reports the differences. Entries on issued invoices or in closed accounting
+// periods are never changed.
+func (s *MemberRateService) RecomputeRateSnapshots(req *dto.RecomputeRatesRequest) (*dto.RecomputeRatesResponse, error) {
+	from, err := time.Parse("2006-01-02", req.From)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	to, err := time.Parse("2006-01-02", req.To)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	if from.After(to) {
+		return nil, apperrors.ErrValidationFailed("from must be on or before to")
+	}
+
+	if req.MemberID != nil {
+		if _, err := s.getMember(*req.MemberID); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := s.timeEntryRepo.GetByPeriod(repository.DateRange{From: &from, To: &to}, req.MemberID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	response := &dto.RecomputeRatesResponse{
+		DryRun:       req.DryRun,
+		CheckedCount: len(entries),
+		Changes:      []dto.TimeEntryRateChange{},
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		if entry.RateSource == models.RateSourceEntry {
+			continue
+		}
+		rate, err := resolveHourlyRate(s.db, entry.Task.ProjectID, &entry.Member, entry.WorkDate, nil)
+		if err != nil {
+			return nil, err
+		}
+		if entry.HourlyRateSnapshot != nil && entry.HourlyRateSnapshot.Equal(rate.HourlyRate) &&
+			entry.Currency == rate.Currency && entry.RateSource == rate.Source {
+			continue
+		}
+
+		locked, err := s.budgetService.isTimeEntryLocked(entry)
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			response.SkippedCount++
+			continue
+		}
+		closed, err := findClosedPeriod(s.db, entry.WorkDate)
+		if err != nil {
+			return nil, err
+		}
+		if closed != nil {
+			response.SkippedCount++
+			continue
+		}
+
+		response.Changes = append(response.Changes, dto.TimeEntryRateChange{
+			TimeEntryID: entry.ID,
+			ProjectID:   entry.Task.ProjectID,
+			MemberID:    entry.MemberID,
+			MemberName:  entry.Member.Name,
+			WorkDate:    entry.WorkDate.Format("2006-01-02"),
+			Hours:       entry.Hours,
+			OldRate:     entry.HourlyRateSnapshot,
+			NewRate:     rate.HourlyRate,
+			OldCurrency: entry.Currency,
+			NewCurrency: rate.Currency,
+			OldSource:   entry.RateSource,
+			NewSource:   rate.Source,
+			OldCost:     money.Round(entry.Cost(), entry.Currency),
+			NewCost:     money.Round(entry.Hours.Mul(rate.HourlyRate), rate.Currency),
+		})
+	}
+	response.ChangedCount = len(response.Changes)
+
+	if req.DryRun || len(response.Changes) == 0 {
+		return response, nil
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		timeEntryRepo := repository.NewTimeEntryRepository(tx)
+		for _, change := range response.Changes {
+			if err := timeEntryRepo.UpdateRateSnapshot(change.TimeEntryID, change.NewRate, change.NewCurrency, change.NewSource); err != nil {
+				return apperrors.ErrDatabaseError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Budgets and alerts follow the recomputed costs
+	recomputed := make(map[uuid.UUID]bool)
+	for _, change := range response.Changes {
+		if !recomputed[change.ProjectID] {
+			recomputed[change.ProjectID] = true
+			s.budgetService.reevaluateAlerts(change.ProjectID)
+		}
+	}
+
+	return response, nil
+}
+
+// getMember retrieves a member by ID
+func (s *MemberRateService) getMember(id uuid.UUID) (*models.Member, error) {
+	member, err := s.memberRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Member")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	return member, nil
+}
+
+// toMemberRateResponse converts a MemberRate model to MemberRateResponse DTO
+func (s *MemberRateService) toMemberRateResponse(rate *models.MemberRate) *dto.MemberRateResponse {
+	response := &dto.MemberRateResponse{
+		ID:            rate.ID,
+		MemberID:      rate.MemberID,
+		HourlyRate:    rate.HourlyRate,
+		Currency:      rate.Currency,
+		EffectiveFrom: rate.EffectiveFrom.Format("2006-01-02"),
+		CreatedAt:     rate.CreatedAt,
+		UpdatedAt:     rate.UpdatedAt,
+	}
+	if rate.EffectiveTo != nil {
+		effectiveTo := rate.EffectiveTo.Format("2006-01-02")
+		response.EffectiveTo = &effectiveTo
+	}
+	return response
+}
+
+// applyMemberRate records the hourly rate of a member from a date in the rate history.
+// The neighbouring periods are cut so that they stay contiguous, and the member's own
+// rate is set to the one valid today. Saving the member is left to the caller.
+func applyMemberRate(tx *gorm.DB, member *models.Member, hourlyRate decimal.Decimal, currency string, from time.Time) (*models.MemberRate, error) {
+	rateRepo := repository.NewMemberRateRepository(tx)
+	from = truncateToDate(from)
+
+	rates, err := rateRepo.ListByMember(member.ID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Members without a history so far have had their own rate since they were created
+	if len(rates) == 0 && truncateToDate(member.CreatedAt).Before(from) {
+		initial := models.MemberRate{
+			MemberID:      member.ID,
+			HourlyRate:    member.HourlyRate,
+			Currency:      member.Currency,
+			EffectiveFrom: truncateToDate(member.CreatedAt),
+		}
+		if initial.Currency == "" {
+			initial.Currency = defaultCurrency
+		}
+		if err := rateRepo.Create(&initial); err != nil {
+			return nil, apperrors.ErrDatabaseError(err)
+		}
+		rates = append(rates, initial)
+	}
+
+	var applied, previous, next *models.MemberRate
+	for i := range rates {
+		effectiveFrom := truncateToDate(rates[i].EffectiveFrom)
+		switch {
+		case effectiveFrom.Equal(from):
+			applied = &rates[i]
+		case effectiveFrom.Before(from):
+			previous = &rates[i]
+		case next == nil:
+			next = &rates[i]
+		}
+	}
+
+	if applied == nil {
+		applied = &models.MemberRate{MemberID: member.ID, EffectiveFrom: from}
+	}
+	applied.HourlyRate = hourlyRate
+	applied.Currency = currency
+	applied.EffectiveTo = nil
+	if next != nil {
+		effectiveTo := truncateToDate(next.EffectiveFrom).AddDate(0, 0, -1)
+		applied.EffectiveTo = &effectiveTo
+	}
+
+	if applied.ID == uuid.Nil {
+		err = rateRepo.Create(applied)
+	} else {
+		err = rateRepo.Update(applied)
+	}
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if previous != nil {
+		effectiveTo := from.AddDate(0, 0, -1)
+		previous.EffectiveTo = &effectiveTo
+		if err := rateRepo.Update(previous); err != nil {
+			return nil, apperrors.ErrDatabaseError(err)
+		}
+	}
+
+	current, err := rateRepo.GetEffective(member.ID, truncateToDate(time.Now()))
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	member.HourlyRate = current.HourlyRate
+	member.Currency = current.Currency
+
+	return applied, nil
+}
+
+// effectiveHourlyRate returns the cost rate and currency of a member valid on a date.
+// Members without a rate history fall back to their own rate.
+func effectiveHourlyRate(db *gorm.DB, member *models.Member, date time.Time) (decimal.Decimal, string, error) {
+	hourlyRate, currency := member.HourlyRate, member.Currency
+
+	rate, err := repository.NewMemberRateRepository(db).GetEffective(member.ID, date)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return decimal.Zero, "", apperrors.ErrDatabaseError(err)
+	}
+	if rate != nil {
+		hourlyRate, currency = rate.HourlyRate, rate.Currency
+	}
+
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	return hourlyRate, currency, nil
+}
+
+// resolvedRate is a cost rate together with where it came from
+type resolvedRate struct {
+	HourlyRate decimal.Decimal
+	Currency   string
+	Source     string
+}
+
+// resolveHourlyRate determines the cost rate of a member's time on a project. The first
+// rate found wins: the override given for the time entry, the rate of the member's
+// assignment to the project, the member's rate valid on the work date, and finally the
+// default rate of the member's department. A member rate of zero counts as unset.
+func resolveHourlyRate(db *gorm.DB, projectID uuid.UUID, member *models.Member, workDate time.Time, override *decimal.Decimal) (*resolvedRate, error) {
+	memberRate, currency, err := effectiveHourlyRate(db, member, workDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if override != nil {
+		return &resolvedRate{HourlyRate: money.RoundRate(*override), Currency: currency, Source: models.RateSourceEntry}, nil
+	}
+
+	pm, err := repository.NewMemberRepository(db).GetProjectMemberOn(projectID, member.ID, workDate)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if pm != nil && pm.HourlyRateSnapshot != nil {
+		return &resolvedRate{HourlyRate: *pm.HourlyRateSnapshot, Currency: currency, Source: models.RateSourceProject}, nil
+	}
+
+	if memberRate.IsPositive() || member.Department == nil {
+		return &resolvedRate{HourlyRate: memberRate, Currency: currency, Source: models.RateSourceMember}, nil
+	}
+
+	departmentRate, err := repository.NewDepartmentRateRepository(db).GetByDepartment(*member.Department)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &resolvedRate{HourlyRate: memberRate, Currency: currency, Source: models.RateSourceMember}, nil
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	return &resolvedRate{HourlyRate: departmentRate.HourlyRate, Currency: departmentRate.Currency, Source: models.RateSourceDepartment}, nil
+}
@@ -2,13 +2,16 @@ package service
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"github.com/your-org/project-budget-tracker/backend/internal/dto"
 	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
 	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
 	"github.com/your-org/project-budget-tracker/backend/internal/repository"
 )
 
@@ -38,13 +41,26 @@ func (s *MemberService) CreateMember(req *dto.CreateMemberRequest) (*dto.MemberR
 		Name:       req.Name,
 		Email:      req.Email,
 		Role:       req.Role,
-		HourlyRate: req.HourlyRate,
+		HourlyRate: money.RoundRate(req.HourlyRate),
+		BillRate:   roundRatePtr(req.BillRate),
+		Currency:   req.Currency,
 		Department: req.Department,
 		UserID:     req.UserID,
 	}
+	if member.Currency == "" {
+		member.Currency = defaultCurrency
+	}
 
-	if err := s.memberRepo.Create(member); err != nil {
-		return nil, apperrors.ErrDatabaseError(err)
+	// The rate history starts with the initial rate
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := repository.NewMemberRepository(tx).Create(member); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		_, err := applyMemberRate(tx, member, member.HourlyRate, member.Currency, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return s.toMemberResponse(member), nil
@@ -131,15 +147,36 @@ func (s *MemberService) UpdateMember(id uuid.UUID, req *dto.UpdateMemberRequest)
 	if req.Role != nil {
 		member.Role = req.Role
 	}
-	if req.HourlyRate != nil {
-		member.HourlyRate = *req.HourlyRate
+	if req.BillRate != nil {
+		member.BillRate = roundRatePtr(req.BillRate)
 	}
 	if req.Department != nil {
 		member.Department = req.Department
 	}
 
-	if err := s.memberRepo.Update(member); err != nil {
-		return nil, apperrors.ErrDatabaseError(err)
+	// A rate change applies from today; past time entries keep their snapshots
+	hourlyRate, currency := member.HourlyRate, member.Currency
+	if req.HourlyRate != nil {
+		hourlyRate = money.RoundRate(*req.HourlyRate)
+	}
+	if req.Currency != nil {
+		currency = *req.Currency
+	}
+	rateChanged := !hourlyRate.Equal(member.HourlyRate) || currency != member.Currency
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if rateChanged {
+			if _, err := applyMemberRate(tx, member, hourlyRate, currency, time.Now()); err != nil {
+				return err
+			}
+		}
+		if err := repository.NewMemberRepository(tx).Update(member); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return s.toMemberResponse(member), nil
@@ -198,8 +235,7 @@ func (s *MemberService) AssignMemberToProject(projectID uuid.UUID, req *dto.Assi
 	}
 
 	// Verify member exists
-	member, err := s.memberRepo.GetByID(req.MemberID)
-	if err != nil {
+	if _, err := s.memberRepo.GetByID(req.MemberID); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, apperrors.ErrNotFound("Member")
 		}
@@ -218,16 +254,14 @@ func (s *MemberService) AssignMemberToProject(projectID uuid.UUID, req *dto.Assi
 		allocationRate = *req.AllocationRate
 	}
 
-	hourlyRateSnapshot := member.HourlyRate
-	if req.HourlyRateSnapshot != nil {
-		hourlyRateSnapshot = *req.HourlyRateSnapshot
-	}
-
+	// The assignment keeps a rate only when it is overridden for the project,
+	// otherwise time is costed at the member's own rate
 	projectMember := &models.ProjectMember{
 		ProjectID:          projectID,
 		MemberID:           req.MemberID,
 		AllocationRate:     allocationRate,
-		HourlyRateSnapshot: &hourlyRateSnapshot,
+		HourlyRateSnapshot: roundRatePtr(req.HourlyRateSnapshot),
+		BillRate:           roundRatePtr(req.BillRate),
 	}
 
 	if err := s.memberRepo.AssignToProject(projectMember); err != nil {
@@ -270,6 +304,8 @@ func (s *MemberService) toMemberResponse(member *models.Member) *dto.MemberRespo
 		Email:      member.Email,
 		Role:       member.Role,
 		HourlyRate: member.HourlyRate,
+		BillRate:   member.BillRate,
+		Currency:   member.Currency,
 		Department: member.Department,
 		CreatedAt:  member.CreatedAt,
 		UpdatedAt:  member.UpdatedAt,
@@ -285,6 +321,7 @@ func (s *MemberService) toProjectMemberResponse(pm *models.ProjectMember) *dto.P
 		JoinedAt:           pm.JoinedAt.Format("2006-01-02"),
 		AllocationRate:     pm.AllocationRate,
 		HourlyRateSnapshot: pm.HourlyRateSnapshot,
+		BillRate:           pm.BillRate,
 	}
 
 	if pm.LeftAt != nil {
@@ -299,9 +336,19 @@ func (s *MemberService) toProjectMemberResponse(pm *models.ProjectMember) *dto.P
 			Email:      pm.Member.Email,
 			Role:       pm.Member.Role,
 			HourlyRate: pm.Member.HourlyRate,
+			BillRate:   pm.Member.BillRate,
 			Department: pm.Member.Department,
 		}
 	}
 
 	return response
 }
+
+// roundRatePtr rounds an optional rate, keeping nil as unset
+func roundRatePtr(rate *decimal.Decimal) *decimal.Decimal {
+	if rate == nil {
+		return nil
+	}
+	rounded := money.RoundRate(*rate)
+	return &rounded
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// Reasons for a portfolio project to be at risk
+const (
+	// PortfolioRiskActiveAlerts means the project has unresolved budget alerts
+	PortfolioRiskActiveAlerts = "active_alerts"
+	// PortfolioRiskCostRatio means the total cost has reached PortfolioRiskCostRatioThreshold of BudgetAmount
+	PortfolioRiskCostRatio = "cost_ratio"
+)
+
+// PortfolioRiskCostRatioThreshold is the cost ratio (%) from which a project is at risk
+var PortfolioRiskCostRatioThreshold = decimal.NewFromInt(80)
+
+// PortfolioService handles business logic for the portfolio of an owner's projects
+type PortfolioService struct {
+	db          *gorm.DB
+	projectRepo *repository.ProjectRepository
+}
+
+// NewPortfolioService creates a new PortfolioService
+func NewPortfolioService(db *gorm.DB) *PortfolioService {
+	return &PortfolioService{
+		db:          db,
+		projectRepo: repository.NewProjectRepository(db),
+	}
+}
+
+// GetPortfolioSummary returns the totals, status counts, deficit projects and at-risk projects
+// across the projects of an owner. The figures are those of the stored budgets as last
+// recalculated, which happens when a budget is read or its alerts are evaluated, so they may
+// lag behind the latest costs and revenue. Projects in deficit have a negative profit; the other
+// projects are at risk when they have unresolved alerts or their cost nears the budget amount.
+// When a reporting currency is given, the figures are also converted into it at today's rates
+// and totalled across all projects.
+func (s *PortfolioService) GetPortfolioSummary(params repository.PortfolioParams, reportingCurrency string) (*dto.PortfolioSummaryResponse, error) {
+	projects, err := s.projectRepo.ListPortfolio(params)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	var rates repository.CurrencyRates
+	var reportingTotal *dto.PortfolioTotalResponse
+	if reportingCurrency != "" {
+		currencies := make([]string, len(projects))
+		for i, project := range projects {
+			currencies[i] = project.Currency
+		}
+		rates, err = resolveRates(s.db, currencies, reportingCurrency, truncateToDate(time.Now()))
+		if err != nil {
+			return nil, err
+		}
+		reportingTotal = &dto.PortfolioTotalResponse{Currency: reportingCurrency}
+	}
+
+	response := &dto.PortfolioSummaryResponse{
+		OwnerID:         params.OwnerID,
+		ProjectCount:    len(projects),
+		StatusCounts:    make(map[string]int),
+		Totals:          []dto.PortfolioTotalResponse{},
+		DeficitProjects: []dto.PortfolioProjectResponse{},
+		AtRiskProjects:  []dto.PortfolioProjectResponse{},
+	}
+
+	totals := make(map[string]*dto.PortfolioTotalResponse)
+	for _, project := range projects {
+		response.StatusCounts[project.Status]++
+
+		total, ok := totals[project.Currency]
+		if !ok {
+			total = &dto.PortfolioTotalResponse{Currency: project.Currency}
+			totals[project.Currency] = total
+		}
+		total.ProjectCount++
+		total.Revenue = total.Revenue.Add(project.Revenue)
+		total.TotalCost = total.TotalCost.Add(project.TotalCost)
+
+		item := toPortfolioProjectResponse(project)
+		if reportingTotal != nil {
+			reportingTotal.ProjectCount++
+			reportingTotal.Revenue = reportingTotal.Revenue.Add(rates.Convert(project.Revenue, project.Currency))
+			reportingTotal.TotalCost = reportingTotal.TotalCost.Add(rates.Convert(project.TotalCost, project.Currency))
+
+			reportingProfit := money.Round(rates.Convert(project.Profit, project.Currency), reportingCurrency)
+			item.ReportingProfit = &reportingProfit
+		}
+		if project.Profit.IsNegative() {
+			response.DeficitProjects = append(response.DeficitProjects, item)
+			continue
+		}
+		if project.ActiveAlerts > 0 {
+			item.RiskReasons = append(item.RiskReasons, PortfolioRiskActiveAlerts)
+		}
+		if item.CostRatio != nil && item.CostRatio.GreaterThanOrEqual(PortfolioRiskCostRatioThreshold) {
+			item.RiskReasons = append(item.RiskReasons, PortfolioRiskCostRatio)
+		}
+		if len(item.RiskReasons) > 0 {
+			response.AtRiskProjects = append(response.AtRiskProjects, item)
+		}
+	}
+
+	for _, total := range totals {
+		total.Profit = total.Revenue.Sub(total.TotalCost)
+		total.ProfitRate = profitRate(total.Profit, total.Revenue)
+		response.Totals = append(response.Totals, *total)
+	}
+	sort.Slice(response.Totals, func(i, j int) bool {
+		return response.Totals[i].Currency < response.Totals[j].Currency
+	})
+
+	if reportingTotal != nil {
+		reportingTotal.Revenue = money.Round(reportingTotal.Revenue, reportingCurrency)
+		reportingTotal.TotalCost = money.Round(reportingTotal.TotalCost, reportingCurrency)
+		reportingTotal.Profit = reportingTotal.Revenue.Sub(reportingTotal.TotalCost)
+		reportingTotal.ProfitRate = profitRate(reportingTotal.Profit, reportingTotal.Revenue)
+		response.ReportingTotal = reportingTotal
+	}
+
+	// The largest deficits come first. Profits in different currencies are only compared
+	// once converted into the reporting currency; without one, deficits are grouped by currency.
+	sort.SliceStable(response.DeficitProjects, func(i, j int) bool {
+		a, b := response.DeficitProjects[i], response.DeficitProjects[j]
+		if a.ReportingProfit != nil && b.ReportingProfit != nil {
+			return a.ReportingProfit.LessThan(*b.ReportingProfit)
+		}
+		if a.Currency != b.Currency {
+			return a.Currency < b.Currency
+		}
+		return a.Profit.LessThan(b.Profit)
+	})
+
+	return response, nil
+}
+
+// toPortfolioProjectResponse converts the budget figures of a project to a response
+func toPortfolioProjectResponse(project repository.PortfolioProject) dto.PortfolioProjectResponse {
+	response := dto.PortfolioProjectResponse{
+		ID:           project.ProjectID,
+		Name:         project.Name,
+		Status:       project.Status,
+		StartDate:    project.StartDate,
+		EndDate:      project.EndDate,
+		Currency:     project.Currency,
+		BudgetAmount: project.BudgetAmount,
+		Revenue:      project.Revenue,
+		TotalCost:    project.TotalCost,
+		Profit:       project.Profit,
+		ProfitRate:   project.ProfitRate,
+		ActiveAlerts: project.ActiveAlerts,
+	}
+	if project.BudgetAmount != nil && project.BudgetAmount.IsPositive() {
+		costRatio := money.RoundRate(project.TotalCost.Div(*project.BudgetAmount).Mul(decimal.NewFromInt(100)))
+		response.CostRatio = &costRatio
+	}
+	return response
+}
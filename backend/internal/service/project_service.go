@@ -61,6 +61,20 @@ func (s *ProjectService) CreateProject(userIDStr string, req dto.CreateProjectRe
 		project.BudgetAmount = req.BudgetAmount
 	}
 
+	if req.DefaultBillable != nil {
+		project.DefaultBillable = req.DefaultBillable
+	}
+
+	project.ContractType = req.ContractType
+	if project.ContractType == "" {
+		project.ContractType = models.ContractTypeFixedPrice
+	}
+	project.RetainerFee = req.RetainerFee
+	project.RetainerHours = req.RetainerHours
+	if req.RetainerRolloverMonths != nil {
+		project.RetainerRolloverMonths = *req.RetainerRolloverMonths
+	}
+
 	if req.StartDate != nil {
 		startDate, err := time.Parse("2006-01-02", *req.StartDate)
 		if err == nil {
@@ -75,6 +89,10 @@ func (s *ProjectService) CreateProject(userIDStr string, req dto.CreateProjectRe
 		}
 	}
 
+	if err := validateContract(project); err != nil {
+		return nil, err
+	}
+
 	if err := s.projectRepo.Create(project); err != nil {
 		return nil, err
 	}
@@ -217,6 +235,10 @@ func (s *ProjectService) UpdateProject(projectIDStr, userIDStr string, req dto.U
 		return nil, apperrors.ErrForbidden()
 	}
 
+	if err := ensureChangeOrderFields(project, req); err != nil {
+		return nil, err
+	}
+
 	// Update fields
 	if req.Name != nil {
 		project.Name = *req.Name
@@ -230,6 +252,21 @@ func (s *ProjectService) UpdateProject(projectIDStr, userIDStr string, req dto.U
 	if req.BudgetAmount != nil {
 		project.BudgetAmount = req.BudgetAmount
 	}
+	if req.DefaultBillable != nil {
+		project.DefaultBillable = req.DefaultBillable
+	}
+	if req.ContractType != nil {
+		project.ContractType = *req.ContractType
+	}
+	if req.RetainerFee != nil {
+		project.RetainerFee = req.RetainerFee
+	}
+	if req.RetainerHours != nil {
+		project.RetainerHours = req.RetainerHours
+	}
+	if req.RetainerRolloverMonths != nil {
+		project.RetainerRolloverMonths = *req.RetainerRolloverMonths
+	}
 	if req.StartDate != nil {
 		startDate, err := time.Parse("2006-01-02", *req.StartDate)
 		if err == nil {
@@ -243,6 +280,10 @@ func (s *ProjectService) UpdateProject(projectIDStr, userIDStr string, req dto.U
 		}
 	}
 
+	if err := validateContract(project); err != nil {
+		return nil, err
+	}
+
 	if err := s.projectRepo.Update(project); err != nil {
 		return nil, err
 	}
@@ -283,15 +324,65 @@ func (s *ProjectService) DeleteProject(projectIDStr, userIDStr string) error {
 	return s.projectRepo.Delete(projectID)
 }
 
+// ensureChangeOrderFields checks that the budget amount and end date of a project are left as they
+// are once the project has left planning. From then on they are revised by approved change orders,
+// which keep a record of the change. A project cannot return to planning, and its status is
+// changed on its own so it cannot be used to slip a revision past the check.
+func ensureChangeOrderFields(project *models.Project, req dto.UpdateProjectRequest) error {
+	budgetChanged := req.BudgetAmount != nil && (project.BudgetAmount == nil || !project.BudgetAmount.Equal(*req.BudgetAmount))
+	endDateChanged := false
+	if req.EndDate != nil {
+		endDate, err := time.Parse("2006-01-02", *req.EndDate)
+		endDateChanged = err == nil && (project.EndDate == nil || !project.EndDate.Equal(endDate))
+	}
+
+	if req.Status != nil && *req.Status != project.Status {
+		if *req.Status == "planning" {
+			return apperrors.ErrConflict("A project that has left planning cannot return to planning")
+		}
+		if budgetChanged || endDateChanged {
+			return apperrors.ErrConflict("Status cannot be changed together with the budget amount or end date")
+		}
+	}
+
+	if project.Status == "planning" {
+		return nil
+	}
+
+	if budgetChanged {
+		return apperrors.ErrConflict("Budget amount of a project under way is changed by a change order")
+	}
+	if endDateChanged {
+		return apperrors.ErrConflict("End date of a project under way is changed by a change order")
+	}
+	return nil
+}
+
+// validateContract checks that a retainer project has the terms its revenue is derived from
+func validateContract(project *models.Project) error {
+	if project.Contract() != models.ContractTypeRetainer {
+		return nil
+	}
+	if project.RetainerFee == nil || project.RetainerHours == nil {
+		return apperrors.ErrValidationFailed("retainer_fee and retainer_hours are required for a retainer contract")
+	}
+	if project.StartDate == nil {
+		return apperrors.ErrValidationFailed("start_date is required for a retainer contract")
+	}
+	return nil
+}
+
 // toProjectResponse converts a Project model to ProjectResponse DTO
 func (s *ProjectService) toProjectResponse(project *models.Project) *dto.ProjectResponse {
 	response := &dto.ProjectResponse{
-		ID:        project.ID,
-		UserID:    project.UserID,
-		Name:      project.Name,
-		Status:    project.Status,
-		CreatedAt: project.CreatedAt,
-		UpdatedAt: project.UpdatedAt,
+		ID:              project.ID,
+		UserID:          project.UserID,
+		Name:            project.Name,
+		Status:          project.Status,
+		DefaultBillable: project.IsBillableByDefault(),
+		ContractType:    project.Contract(),
+		CreatedAt:       project.CreatedAt,
+		UpdatedAt:       project.UpdatedAt,
 	}
 
 	if project.Description != nil && *project.Description != "" {
@@ -302,6 +393,12 @@ func (s *ProjectService) toProjectResponse(project *models.Project) *dto.Project
 		response.BudgetAmount = project.BudgetAmount
 	}
 
+	if project.Contract() == models.ContractTypeRetainer {
+		response.RetainerFee = project.RetainerFee
+		response.RetainerHours = project.RetainerHours
+		response.RetainerRolloverMonths = project.RetainerRolloverMonths
+	}
+
 	if project.StartDate != nil {
 		formatted := project.StartDate.Format("2006-01-02")
 		response.StartDate = &formatted
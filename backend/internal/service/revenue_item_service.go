@@ -0,0 +1,288 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// RevenueItemService handles business logic for the milestone revenue schedule of projects
+type RevenueItemService struct {
+	db              *gorm.DB
+	revenueItemRepo *repository.RevenueItemRepository
+	budgetService   *BudgetService
+}
+
+// NewRevenueItemService creates a new RevenueItemService
+func NewRevenueItemService(db *gorm.DB, dailyLimit DailyHoursLimit) *RevenueItemService {
+	return &RevenueItemService{
+		db:              db,
+		revenueItemRepo: repository.NewRevenueItemRepository(db),
+		budgetService:   NewBudgetService(db, dailyLimit),
+	}
+}
+
+// CreateRevenueItem creates a new revenue item for a project
+func (s *RevenueItemService) CreateRevenueItem(projectID uuid.UUID, req *dto.CreateRevenueItemRequest) (*dto.RevenueItemResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Only fixed-price contracts are billed in milestones
+	if project.Contract() != models.ContractTypeFixedPrice {
+		return nil, apperrors.ErrConflict("Revenue items are only available for fixed-price contracts")
+	}
+
+	// Parse planned date
+	plannedDate, err := time.Parse("2006-01-02", req.PlannedDate)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	if err := ensurePeriodsOpen(s.db, plannedDate); err != nil {
+		return nil, err
+	}
+
+	if !req.Amount.IsPositive() {
+		return nil, apperrors.ErrValidationFailed("amount must be greater than 0")
+	}
+
+	if req.TaskID != nil {
+		if err := s.verifyProjectTask(projectID, *req.TaskID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Default to the project's budget currency
+	currency := req.Currency
+	if currency == "" {
+		if currency, err = projectCurrency(s.db, projectID); err != nil {
+			return nil, err
+		}
+	}
+
+	status := req.Status
+	if status == "" {
+		status = models.RevenueStatusPlanned
+	}
+
+	item := &models.RevenueItem{
+		ProjectID:   projectID,
+		TaskID:      req.TaskID,
+		Name:        req.Name,
+		PlannedDate: plannedDate,
+		Amount:      money.Round(req.Amount, currency),
+		Currency:    currency,
+		Status:      status,
+		Description: req.Description,
+	}
+
+	if err := s.revenueItemRepo.Create(item); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	s.budgetService.recordRevenueChange(projectID)
+
+	return s.GetRevenueItem(projectID, item.ID)
+}
+
+// GetRevenueItem retrieves a revenue item of a project by ID
+func (s *RevenueItemService) GetRevenueItem(projectID, id uuid.UUID) (*dto.RevenueItemResponse, error) {
+	item, err := s.getProjectRevenueItem(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toRevenueItemResponse(item), nil
+}
+
+// ListRevenueItems retrieves the revenue items of a project in order of planned date.
+// The totals cover all items of the project and are converted into the budget currency.
+func (s *RevenueItemService) ListRevenueItems(projectID uuid.UUID, status string) (*dto.RevenueItemListResponse, error) {
+	// Verify project exists
+	var project models.Project
+	if err := s.db.First(&project, "id = ?", projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Project")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	items, err := s.revenueItemRepo.ListByProject(projectID, status)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	currency, err := projectCurrency(s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := resolveProjectRates(s.db, projectID, currency, truncateToDate(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.revenueItemRepo.GetSummaryByProject(projectID, rates)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	itemResponses := make([]dto.RevenueItemResponse, len(items))
+	for i := range items {
+		itemResponses[i] = *s.toRevenueItemResponse(&items[i])
+	}
+
+	return &dto.RevenueItemListResponse{
+		Items:             itemResponses,
+		Currency:          currency,
+		TotalRevenue:      money.Round(summary.TotalAmount, currency),
+		RecognizedRevenue: money.Round(summary.RecognizedAmount, currency),
+		PlannedRevenue:    money.Round(summary.PlannedAmount, currency),
+	}, nil
+}
+
+// UpdateRevenueItem updates a revenue item of a project
+func (s *RevenueItemService) UpdateRevenueItem(projectID, id uuid.UUID, req *dto.UpdateRevenueItemRequest) (*dto.RevenueItemResponse, error) {
+	item, err := s.getProjectRevenueItem(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePeriodsOpen(s.db, item.PlannedDate); err != nil {
+		return nil, err
+	}
+
+	// Update fields
+	if req.Name != nil {
+		item.Name = *req.Name
+	}
+	if req.PlannedDate != nil {
+		plannedDate, err := time.Parse("2006-01-02", *req.PlannedDate)
+		if err != nil {
+			return nil, apperrors.ErrInvalidInput(err)
+		}
+		if err := ensurePeriodsOpen(s.db, plannedDate); err != nil {
+			return nil, err
+		}
+		item.PlannedDate = plannedDate
+	}
+	if req.Amount != nil {
+		if !req.Amount.IsPositive() {
+			return nil, apperrors.ErrValidationFailed("amount must be greater than 0")
+		}
+		item.Amount = *req.Amount
+	}
+	if req.Currency != nil {
+		item.Currency = *req.Currency
+	}
+	if req.Status != nil {
+		item.Status = *req.Status
+	}
+	if req.TaskID != nil {
+		// A nil UUID unlinks the task
+		if *req.TaskID == uuid.Nil {
+			item.TaskID = nil
+		} else {
+			if err := s.verifyProjectTask(projectID, *req.TaskID); err != nil {
+				return nil, err
+			}
+			item.TaskID = req.TaskID
+		}
+	}
+	if req.Description != nil {
+		item.Description = req.Description
+	}
+	item.Amount = money.Round(item.Amount, item.Currency)
+
+	if err := s.revenueItemRepo.Update(item); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	s.budgetService.recordRevenueChange(projectID)
+
+	return s.GetRevenueItem(projectID, item.ID)
+}
+
+// DeleteRevenueItem deletes a revenue item of a project
+func (s *RevenueItemService) DeleteRevenueItem(projectID, id uuid.UUID) error {
+	item, err := s.getProjectRevenueItem(projectID, id)
+	if err != nil {
+		return err
+	}
+	if err := ensurePeriodsOpen(s.db, item.PlannedDate); err != nil {
+		return err
+	}
+
+	if err := s.revenueItemRepo.Delete(id); err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	s.budgetService.recordRevenueChange(projectID)
+
+	return nil
+}
+
+// verifyProjectTask ensures the task exists and belongs to the project
+func (s *RevenueItemService) verifyProjectTask(projectID, taskID uuid.UUID) error {
+	var task models.Task
+	if err := s.db.First(&task, "id = ?", taskID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.ErrNotFound("Task")
+		}
+		return apperrors.ErrDatabaseError(err)
+	}
+
+	if task.ProjectID != projectID {
+		return apperrors.ErrValidationFailed("task does not belong to the project")
+	}
+
+	return nil
+}
+
+// getProjectRevenueItem retrieves a revenue item and ensures it belongs to the project
+func (s *RevenueItemService) getProjectRevenueItem(projectID, id uuid.UUID) (*models.RevenueItem, error) {
+	item, err := s.revenueItemRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Revenue item")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if item.ProjectID != projectID {
+		return nil, apperrors.ErrNotFound("Revenue item")
+	}
+
+	return item, nil
+}
+
+// toRevenueItemResponse converts a RevenueItem model to RevenueItemResponse DTO
+func (s *RevenueItemService) toRevenueItemResponse(item *models.RevenueItem) *dto.RevenueItemResponse {
+	response := &dto.RevenueItemResponse{
+		ID:          item.ID,
+		ProjectID:   item.ProjectID,
+		TaskID:      item.TaskID,
+		Name:        item.Name,
+		PlannedDate: item.PlannedDate.Format("2006-01-02"),
+		Amount:      item.Amount,
+		Currency:    item.Currency,
+		Status:      item.Status,
+		Description: item.Description,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+	if item.Task != nil && item.TaskID != nil {
+		response.TaskName = &item.Task.Name
+	}
+	return response
+}
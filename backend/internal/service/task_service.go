@@ -5,11 +5,13 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"github.com/your-org/project-budget-tracker/backend/internal/dto"
 	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
 	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
 	"github.com/your-org/project-budget-tracker/backend/internal/repository"
 )
 
@@ -66,8 +68,8 @@ func (s *TaskService) CreateTask(projectID uuid.UUID, req *dto.CreateTaskRequest
 		AssignedTo:   req.AssignedTo,
 		Name:         req.Name,
 		Description:  req.Description,
-		PlannedHours: req.PlannedHours,
-		ActualHours:  0,
+		PlannedHours: money.RoundHours(req.PlannedHours),
+		ActualHours:  decimal.Zero,
 		Status:       status,
 		StartDate:    startDate,
 		EndDate:      endDate,
@@ -160,10 +162,10 @@ func (s *TaskService) UpdateTask(id uuid.UUID, req *dto.UpdateTaskRequest) (*dto
 		task.AssignedTo = req.AssignedTo
 	}
 	if req.PlannedHours != nil {
-		task.PlannedHours = *req.PlannedHours
+		task.PlannedHours = money.RoundHours(*req.PlannedHours)
 	}
 	if req.ActualHours != nil {
-		task.ActualHours = *req.ActualHours
+		task.ActualHours = money.RoundHours(*req.ActualHours)
 	}
 	if req.Status != nil {
 		task.Status = *req.Status
@@ -236,7 +238,7 @@ func (s *TaskService) GetProjectSummary(projectID uuid.UUID) (*dto.ProjectSummar
 		TotalActualHours:   summary.TotalActualHours,
 		VarianceHours:      summary.VarianceHours,
 		VariancePercentage: summary.VariancePercentage,
-		IsOverBudget:       summary.VarianceHours > 0,
+		IsOverBudget:       summary.VarianceHours.IsPositive(),
 		CompletedTasks:     summary.CompletedTasks,
 		InProgressTasks:    summary.InProgressTasks,
 		TodoTasks:          summary.TodoTasks,
@@ -0,0 +1,436 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	govalidator "github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	customvalidator "github.com/your-org/project-budget-tracker/backend/internal/validator"
+)
+
+// MaxTimeEntryImportRows is the most rows a single CSV import may contain
+const MaxTimeEntryImportRows = 5000
+
+// Columns of an imported CSV file. The project column is optional and narrows task names
+// that are used in more than one project.
+const (
+	importColumnProject = "project"
+	importColumnTask    = "task"
+	importColumnMember  = "member_email"
+	importColumnDate    = "date"
+	importColumnHours   = "hours"
+	importColumnComment = "comment"
+)
+
+// importColumnAliases maps the accepted header names to their columns
+var importColumnAliases = map[string]string{
+	"project":      importColumnProject,
+	"project_id":   importColumnProject,
+	"task":         importColumnTask,
+	"task_id":      importColumnTask,
+	"task_name":    importColumnTask,
+	"member_email": importColumnMember,
+	"email":        importColumnMember,
+	"date":         importColumnDate,
+	"work_date":    importColumnDate,
+	"hours":        importColumnHours,
+	"comment":      importColumnComment,
+}
+
+// importRequestColumns maps the fields of CreateTimeEntryRequest to the columns they are read from
+var importRequestColumns = map[string]string{
+	"TaskID":   importColumnTask,
+	"MemberID": importColumnMember,
+	"WorkDate": importColumnDate,
+	"Hours":    importColumnHours,
+	"Comment":  importColumnComment,
+}
+
+// TimeEntryImportService handles importing time entries kept in spreadsheets
+type TimeEntryImportService struct {
+	db            *gorm.DB
+	budgetService *BudgetService
+}
+
+// NewTimeEntryImportService creates a new TimeEntryImportService that checks the rows against
+// the given daily limit
+func NewTimeEntryImportService(db *gorm.DB, dailyLimit DailyHoursLimit) *TimeEntryImportService {
+	return &TimeEntryImportService{
+		db:            db,
+		budgetService: NewBudgetService(db, dailyLimit),
+	}
+}
+
+// importRow is a row of an imported CSV file that passed every check
+type importRow struct {
+	entry *models.TimeEntry
+	task  *models.Task
+}
+
+// ImportTimeEntries reads time entries from CSV with a header row. Tasks are given by ID or name
+// and members by email. Every row is checked by the same rules as a time entry created through
+// the API. A dry run only reports the rows with errors. Otherwise all rows are saved in one
+// transaction, and only when none has errors.
+func (s *TimeEntryImportService) ImportTimeEntries(userID uuid.UUID, r io.Reader, dryRun bool) (*dto.TimeEntryImportResponse, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, apperrors.ErrValidationFailed("CSV file is empty")
+		}
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	columns, err := parseImportHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput(err)
+	}
+	if len(records) == 0 {
+		return nil, apperrors.ErrValidationFailed("CSV file has no rows")
+	}
+	if len(records) > MaxTimeEntryImportRows {
+		return nil, apperrors.ErrValidationFailed(fmt.Sprintf("CSV file must not have more than %d rows", MaxTimeEntryImportRows))
+	}
+
+	report := &dto.TimeEntryImportResponse{
+		DryRun:     dryRun,
+		TotalRows:  len(records),
+		TotalHours: decimal.Zero,
+		Errors:     []dto.TimeEntryImportRowError{},
+	}
+
+	projectIDs := make(map[uuid.UUID]struct{})
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		resolver := newImportResolver(tx)
+		budgetService := s.budgetService.withDB(tx)
+
+		rows := make([]importRow, 0, len(records))
+		// Hours of the rows checked so far by member and work date, which count toward the daily limit
+		dailyHours := make(map[string]decimal.Decimal)
+		for i, record := range records {
+			// Line numbers count the header as line 1
+			line := i + 2
+			row, rowErrors, err := s.checkRow(budgetService, resolver, userID, columns, record, line)
+			if err != nil {
+				return err
+			}
+			if len(rowErrors) > 0 {
+				report.Errors = append(report.Errors, rowErrors...)
+				continue
+			}
+
+			key := row.entry.MemberID.String() + row.entry.WorkDate.Format("2006-01-02")
+			warnings, err := budgetService.checkDailyHours(row.entry.MemberID, row.entry.WorkDate, row.entry.Hours, dailyHours[key], nil)
+			if err != nil {
+				var appErr *apperrors.AppError
+				if isDatabaseError(err) || !errors.As(err, &appErr) {
+					return err
+				}
+				report.Errors = append(report.Errors, dailyHoursRowErrors(line, appErr.Details)...)
+				continue
+			}
+			report.Warnings = append(report.Warnings, dailyHoursRowErrors(line, warnings)...)
+			dailyHours[key] = dailyHours[key].Add(row.entry.Hours)
+
+			rows = append(rows, *row)
+			report.TotalHours = report.TotalHours.Add(row.entry.Hours)
+		}
+		report.ValidRows = len(rows)
+
+		if dryRun || len(report.Errors) > 0 {
+			return nil
+		}
+
+		// Actual hours of each task are updated once for all of its rows
+		taskHours := make(map[uuid.UUID]decimal.Decimal)
+		for _, row := range rows {
+			if err := tx.Create(row.entry).Error; err != nil {
+				return apperrors.ErrDatabaseError(err)
+			}
+			taskHours[row.task.ID] = taskHours[row.task.ID].Add(row.entry.Hours)
+		}
+		for taskID, hours := range taskHours {
+			if err := tx.Model(&models.Task{}).Where("id = ?", taskID).
+				Update("actual_hours", gorm.Expr("actual_hours + ?", hours)).Error; err != nil {
+				return apperrors.ErrDatabaseError(err)
+			}
+		}
+
+		for _, row := range rows {
+			projectIDs[row.task.ProjectID] = struct{}{}
+		}
+		report.ImportedRows = len(rows)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Alerts are checked once per project after the rows are saved
+	for projectID := range projectIDs {
+		s.budgetService.reevaluateAlerts(projectID)
+	}
+
+	report.TotalHours = money.RoundHours(report.TotalHours)
+	return report, nil
+}
+
+// checkRow resolves the task and member of a row and checks it as a new time entry.
+// Problems with the row are reported as row errors; only a failing database returns an error.
+func (s *TimeEntryImportService) checkRow(budgetService *BudgetService, resolver *importResolver, userID uuid.UUID, columns map[string]int, record []string, line int) (*importRow, []dto.TimeEntryImportRowError, error) {
+	value := func(column string) string {
+		index, ok := columns[column]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+	rowError := func(column, message string) dto.TimeEntryImportRowError {
+		return dto.TimeEntryImportRowError{Row: line, Column: column, Message: message}
+	}
+
+	var rowErrors []dto.TimeEntryImportRowError
+	req := &dto.CreateTimeEntryRequest{WorkDate: value(importColumnDate)}
+
+	taskID, err := resolver.task(value(importColumnProject), value(importColumnTask))
+	if isDatabaseError(err) {
+		return nil, nil, err
+	} else if err != nil {
+		rowErrors = append(rowErrors, rowError(importColumnTask, err.Error()))
+	}
+	req.TaskID = taskID
+
+	memberID, err := resolver.member(value(importColumnMember))
+	if isDatabaseError(err) {
+		return nil, nil, err
+	} else if err != nil {
+		rowErrors = append(rowErrors, rowError(importColumnMember, err.Error()))
+	}
+	req.MemberID = memberID
+
+	if req.WorkDate != "" {
+		if _, err := time.Parse("2006-01-02", req.WorkDate); err != nil {
+			rowErrors = append(rowErrors, rowError(importColumnDate, "must be a date in YYYY-MM-DD format"))
+		}
+	}
+
+	if hours := value(importColumnHours); hours != "" {
+		parsed, err := decimal.NewFromString(hours)
+		if err != nil {
+			rowErrors = append(rowErrors, rowError(importColumnHours, "must be a number"))
+		} else {
+			req.Hours = parsed
+		}
+	}
+
+	if comment := value(importColumnComment); comment != "" {
+		req.Comment = &comment
+	}
+
+	// The same rules as a time entry created through the API
+	if err := customvalidator.Validate(req); err != nil {
+		var validationErrors govalidator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			for _, fieldError := range validationErrors {
+				column := importRequestColumns[fieldError.Field()]
+				if hasImportError(rowErrors, column) {
+					continue
+				}
+				rowErrors = append(rowErrors, rowError(column, validationMessage(fieldError)))
+			}
+		} else {
+			rowErrors = append(rowErrors, rowError("", err.Error()))
+		}
+	}
+	if len(rowErrors) > 0 {
+		return nil, rowErrors, nil
+	}
+
+	entry, task, err := budgetService.newTimeEntry(userID, req, false)
+	if isDatabaseError(err) {
+		return nil, nil, err
+	}
+	if err != nil {
+		message := err.Error()
+		column := ""
+		var appErr *apperrors.AppError
+		if errors.As(err, &appErr) {
+			message = appErr.Message
+			// Closed periods and submitted timesheets are about the work date
+			if appErr.Code == "PERIOD_CLOSED" || appErr.Code == "TIMESHEET_LOCKED" {
+				column = importColumnDate
+			}
+		}
+		return nil, []dto.TimeEntryImportRowError{rowError(column, message)}, nil
+	}
+
+	return &importRow{entry: entry, task: task}, nil, nil
+}
+
+// dailyHoursRowErrors describes the daily limits a row goes beyond as errors of its hours
+func dailyHoursRowErrors(line int, details interface{}) []dto.TimeEntryImportRowError {
+	violations, _ := details.([]dto.DailyHoursViolation)
+	rowErrors := make([]dto.TimeEntryImportRowError, len(violations))
+	for i, violation := range violations {
+		rowErrors[i] = dto.TimeEntryImportRowError{
+			Row:    line,
+			Column: importColumnHours,
+			Message: fmt.Sprintf("%s hours of the member on %s exceed the %s limit of %s hours",
+				violation.TotalHours, violation.WorkDate, violation.Limit, violation.LimitHours),
+		}
+	}
+	return rowErrors
+}
+
+// isDatabaseError reports whether an error is a failure of the database rather than of the data
+func isDatabaseError(err error) bool {
+	var appErr *apperrors.AppError
+	return errors.As(err, &appErr) && appErr.Code == "DATABASE_ERROR"
+}
+
+// parseImportHeader maps the columns of a CSV header to their positions
+func parseImportHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// Spreadsheets often save CSV with a byte order mark
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if column, ok := importColumnAliases[name]; ok {
+			columns[column] = i
+		}
+	}
+
+	var missing []string
+	for _, column := range []string{importColumnTask, importColumnMember, importColumnDate, importColumnHours} {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, apperrors.ErrValidationFailed("CSV header is missing columns: " + strings.Join(missing, ", "))
+	}
+	return columns, nil
+}
+
+// hasImportError reports whether a column already has an error
+func hasImportError(rowErrors []dto.TimeEntryImportRowError, column string) bool {
+	for _, rowError := range rowErrors {
+		if rowError.Column == column {
+			return true
+		}
+	}
+	return false
+}
+
+// validationMessage describes a failed validation rule
+func validationMessage(fieldError govalidator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required", "required_without":
+		return "is required"
+	case "min":
+		return "must be at least " + fieldError.Param()
+	case "max":
+		return "must be at most " + fieldError.Param()
+	default:
+		return "is invalid"
+	}
+}
+
+// importResolver resolves the task and member names of an import to IDs, looking each name up once
+type importResolver struct {
+	db      *gorm.DB
+	tasks   map[string]uuid.UUID
+	members map[string]uuid.UUID
+}
+
+// newImportResolver creates a new importResolver
+func newImportResolver(db *gorm.DB) *importResolver {
+	return &importResolver{
+		db:      db,
+		tasks:   make(map[string]uuid.UUID),
+		members: make(map[string]uuid.UUID),
+	}
+}
+
+// task resolves a task given by ID or by name, optionally within a project given by ID or name
+func (r *importResolver) task(project, task string) (uuid.UUID, error) {
+	if task == "" {
+		return uuid.Nil, errors.New("is required")
+	}
+	if id, err := uuid.Parse(task); err == nil {
+		return id, nil
+	}
+
+	key := project + "\x00" + task
+	if id, ok := r.tasks[key]; ok {
+		return id, nil
+	}
+
+	query := r.db.Model(&models.Task{}).Where("tasks.name = ?", task)
+	if project != "" {
+		query = query.Joins("JOIN projects ON projects.id = tasks.project_id")
+		if projectID, err := uuid.Parse(project); err == nil {
+			query = query.Where("projects.id = ?", projectID)
+		} else {
+			query = query.Where("projects.name = ?", project)
+		}
+	}
+
+	var ids []uuid.UUID
+	if err := query.Limit(2).Pluck("tasks.id", &ids).Error; err != nil {
+		return uuid.Nil, apperrors.ErrDatabaseError(err)
+	}
+	switch len(ids) {
+	case 0:
+		return uuid.Nil, fmt.Errorf("task %q not found", task)
+	case 1:
+		r.tasks[key] = ids[0]
+		return ids[0], nil
+	default:
+		return uuid.Nil, fmt.Errorf("task %q is used in more than one project; add the project column or use the task ID", task)
+	}
+}
+
+// member resolves a member by email, ignoring case
+func (r *importResolver) member(email string) (uuid.UUID, error) {
+	if email == "" {
+		return uuid.Nil, errors.New("is required")
+	}
+
+	key := strings.ToLower(email)
+	if id, ok := r.members[key]; ok {
+		return id, nil
+	}
+
+	var ids []uuid.UUID
+	if err := r.db.Model(&models.Member{}).Where("LOWER(email) = ?", key).Limit(2).Pluck("id", &ids).Error; err != nil {
+		return uuid.Nil, apperrors.ErrDatabaseError(err)
+	}
+	switch len(ids) {
+	case 0:
+		return uuid.Nil, fmt.Errorf("member %q not found", email)
+	case 1:
+		r.members[key] = ids[0]
+		return ids[0], nil
+	default:
+		return uuid.Nil, fmt.Errorf("more than one member has the email %q", email)
+	}
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// Rounding modes of the time measured by a timer
+const (
+	TimerRoundingUp      = "up"
+	TimerRoundingNearest = "nearest"
+	TimerRoundingDown    = "down"
+)
+
+// maxTimeEntryHours is the most hours a single time entry can hold
+var maxTimeEntryHours = decimal.NewFromInt(24)
+
+// TimerSettings controls how the time measured by timers becomes time entries
+type TimerSettings struct {
+	// RoundingMinutes is the increment the time of each work date is rounded to; 0 disables rounding
+	RoundingMinutes int
+	// RoundingMode is up, nearest or down
+	RoundingMode string
+	// MaxDuration is how long a timer may run before it is stopped automatically
+	MaxDuration time.Duration
+	// Location decides where one work date ends and the next begins
+	Location *time.Location
+}
+
+// DefaultTimerSettings returns the settings used when nothing is configured
+func DefaultTimerSettings() TimerSettings {
+	return TimerSettings{
+		RoundingMinutes: 15,
+		RoundingMode:    TimerRoundingNearest,
+		MaxDuration:     12 * time.Hour,
+		Location:        time.Local,
+	}
+}
+
+// ParseTimerSettings builds timer settings from their configured values, e.g. "15", "up", "12h"
+// and "Asia/Tokyo"
+func ParseTimerSettings(roundingMinutes, roundingMode, maxDuration, timezone string) (TimerSettings, error) {
+	settings := DefaultTimerSettings()
+
+	minutes, err := strconv.Atoi(roundingMinutes)
+	if err != nil || minutes < 0 || minutes > 60 {
+		return settings, fmt.Errorf("rounding minutes must be between 0 and 60: %q", roundingMinutes)
+	}
+	settings.RoundingMinutes = minutes
+
+	switch roundingMode {
+	case TimerRoundingUp, TimerRoundingNearest, TimerRoundingDown:
+		settings.RoundingMode = roundingMode
+	default:
+		return settings, fmt.Errorf("rounding mode must be one of up, nearest, down: %q", roundingMode)
+	}
+
+	duration, err := time.ParseDuration(maxDuration)
+	if err != nil || duration <= 0 {
+		return settings, fmt.Errorf("max duration must be a positive duration: %q", maxDuration)
+	}
+	settings.MaxDuration = duration
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return settings, fmt.Errorf("unknown timezone %q: %w", timezone, err)
+	}
+	settings.Location = location
+
+	return settings, nil
+}
+
+// roundHours rounds the time measured on one work date to the configured increment
+func (s TimerSettings) roundHours(duration time.Duration) decimal.Decimal {
+	if s.RoundingMinutes > 0 {
+		increment := time.Duration(s.RoundingMinutes) * time.Minute
+		switch s.RoundingMode {
+		case TimerRoundingUp:
+			if remainder := duration % increment; remainder != 0 {
+				duration += increment - remainder
+			}
+		case TimerRoundingDown:
+			duration = duration.Truncate(increment)
+		default:
+			duration = duration.Round(increment)
+		}
+	}
+
+	hours := money.RoundHours(decimal.NewFromInt(int64(duration)).Div(decimal.NewFromInt(int64(time.Hour))))
+	return decimal.Min(hours, maxTimeEntryHours)
+}
+
+// workDateSpan is the part of a timer's run that falls on one work date
+type workDateSpan struct {
+	workDate string
+	start    time.Time
+	end      time.Time
+}
+
+// duration is the time measured on the work date
+func (s workDateSpan) duration() time.Duration {
+	return s.end.Sub(s.start)
+}
+
+// splitByWorkDate splits the time between start and stop at every midnight in the location
+func splitByWorkDate(start, stop time.Time, location *time.Location) []workDateSpan {
+	start = start.In(location)
+	stop = stop.In(location)
+
+	var spans []workDateSpan
+	for start.Before(stop) {
+		end := time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, location)
+		if stop.Before(end) {
+			end = stop
+		}
+		spans = append(spans, workDateSpan{workDate: start.Format("2006-01-02"), start: start, end: end})
+		start = end
+	}
+	return spans
+}
+
+// TimerService handles business logic for timers. A stopped timer is recorded as time entries
+// in the same way as entries entered by hand.
+type TimerService struct {
+	db            *gorm.DB
+	timerRepo     *repository.TimerRepository
+	budgetService *BudgetService
+	settings      TimerSettings
+}
+
+// NewTimerService creates a new TimerService that records timers with the given settings and
+// checks their time entries against the given daily limit
+func NewTimerService(db *gorm.DB, settings TimerSettings, dailyLimit DailyHoursLimit) *TimerService {
+	return &TimerService{
+		db:            db,
+		timerRepo:     repository.NewTimerRepository(db),
+		budgetService: NewBudgetService(db, dailyLimit),
+		settings:      settings,
+	}
+}
+
+// StartTimer starts a timer for a member on a task. A member can run only one timer at a time;
+// a running timer that has passed the maximum duration is stopped first.
+func (s *TimerService) StartTimer(userID uuid.UUID, req *dto.StartTimerRequest) (*dto.TimerResponse, error) {
+	// Verify task exists
+	var task models.Task
+	if err := s.db.First(&task, "id = ?", req.TaskID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Task")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	// Verify member exists
+	var member models.Member
+	if err := s.db.First(&member, "id = ?", req.MemberID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Member")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	now := time.Now()
+	running, err := s.timerRepo.GetRunningByMember(req.MemberID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	if running != nil {
+		cutoff := running.StartedAt.Add(s.settings.MaxDuration)
+		if now.Before(cutoff) {
+			return nil, apperrors.ErrConflict("Member already has a running timer")
+		}
+		if _, _, err := s.finishTimer(running, cutoff, true); err != nil {
+			return nil, err
+		}
+	}
+
+	timer := &models.Timer{
+		TaskID:     req.TaskID,
+		MemberID:   req.MemberID,
+		UserID:     userID,
+		StartedAt:  now,
+		Comment:    req.Comment,
+		IsBillable: req.IsBillable,
+	}
+	if err := s.timerRepo.Create(timer); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+	timer.Task = task
+
+	return s.toTimerResponse(timer, nil, now), nil
+}
+
+// StopTimer stops the running timer of a member and records the measured time as time entries,
+// one per work date. A timer that has passed the maximum duration is stopped at that point.
+func (s *TimerService) StopTimer(req *dto.StopTimerRequest) (*dto.TimerResponse, error) {
+	timer, err := s.timerRepo.GetRunningByMember(req.MemberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Running timer")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	if req.Comment != nil {
+		timer.Comment = req.Comment
+	}
+
+	now := time.Now()
+	stoppedAt, autoStopped := now, false
+	if cutoff := timer.StartedAt.Add(s.settings.MaxDuration); now.After(cutoff) {
+		stoppedAt, autoStopped = cutoff, true
+	}
+
+	entries, skipped, err := s.finishTimer(timer, stoppedAt, autoStopped)
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.toTimerResponse(timer, entries, now)
+	response.SkippedEntries = skipped
+	return response, nil
+}
+
+// ListRunningTimers retrieves the running timers, optionally narrowed to one member
+func (s *TimerService) ListRunningTimers(memberID *uuid.UUID) (*dto.TimerListResponse, error) {
+	timers, err := s.timerRepo.ListRunning(memberID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	now := time.Now()
+	responses := make([]dto.TimerResponse, len(timers))
+	for i := range timers {
+		responses[i] = *s.toTimerResponse(&timers[i], nil, now)
+	}
+
+	return &dto.TimerListResponse{Timers: responses}, nil
+}
+
+// AutoStopExpiredTimers stops every timer that has run longer than the maximum duration at the
+// point the maximum was reached, and returns how many were stopped
+func (s *TimerService) AutoStopExpiredTimers(now time.Time) (int, error) {
+	timers, err := s.timerRepo.ListStartedBefore(now.Add(-s.settings.MaxDuration))
+	if err != nil {
+		return 0, apperrors.ErrDatabaseError(err)
+	}
+
+	stopped := 0
+	var errs []error
+	for i := range timers {
+		timer := &timers[i]
+		_, skipped, err := s.finishTimer(timer, timer.StartedAt.Add(s.settings.MaxDuration), true)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("timer %s: %w", timer.ID, err))
+			continue
+		}
+		for _, entry := range skipped {
+			log.Printf("timer %s: skipped %s hours on %s: %s", timer.ID, entry.Hours, entry.WorkDate, entry.Message)
+		}
+		stopped++
+	}
+
+	return stopped, errors.Join(errs...)
+}
+
+// finishTimer creates the time entries of a timer and marks it stopped in one transaction, so a
+// timer is never left running with some of its entries recorded. Work dates whose time rounds
+// to zero get no entry. Each entry keeps its measured start and end time, so it is checked for
+// overlaps like an entry entered by hand, but keeps its rounded hours. A work date whose entry
+// is rejected, e.g. because its period is closed, is skipped and returned so the timer can still
+// be stopped; only database errors keep the timer running. The alerts are checked once the
+// transaction is committed.
+func (s *TimerService) finishTimer(timer *models.Timer, stoppedAt time.Time, autoStopped bool) ([]dto.TimeEntryResponse, []dto.TimerSkippedEntryResponse, error) {
+	var entries []dto.TimeEntryResponse
+	var skipped []dto.TimerSkippedEntryResponse
+	timezone := s.settings.Location.String()
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		budgetService := s.budgetService.withDB(tx)
+		for _, span := range splitByWorkDate(timer.StartedAt, stoppedAt, s.settings.Location) {
+			hours := s.settings.roundHours(span.duration())
+			if hours.IsZero() {
+				continue
+			}
+
+			// A rejected entry is rolled back to its own savepoint, leaving the others in place
+			entry, _, err := budgetService.createTimeEntry(timer.UserID, &dto.CreateTimeEntryRequest{
+				TaskID:     timer.TaskID,
+				MemberID:   timer.MemberID,
+				WorkDate:   span.workDate,
+				Hours:      hours,
+				StartedAt:  &span.start,
+				EndedAt:    &span.end,
+				Timezone:   &timezone,
+				Comment:    timer.Comment,
+				IsBillable: timer.IsBillable,
+			}, true)
+			if err != nil {
+				var appErr *apperrors.AppError
+				if !errors.As(err, &appErr) || isDatabaseError(err) {
+					return err
+				}
+				skipped = append(skipped, dto.TimerSkippedEntryResponse{
+					WorkDate: span.workDate,
+					Hours:    hours,
+					Code:     appErr.Code,
+					Message:  appErr.Message,
+				})
+				continue
+			}
+			entries = append(entries, *entry)
+		}
+
+		timer.StoppedAt = &stoppedAt
+		timer.AutoStopped = autoStopped
+		if err := repository.NewTimerRepository(tx).Update(timer); err != nil {
+			return apperrors.ErrDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		timer.StoppedAt = nil
+		timer.AutoStopped = false
+		return nil, nil, err
+	}
+
+	// Alerts are checked once for all the entries of the timer
+	if len(entries) > 0 {
+		s.budgetService.reevaluateAlerts(timer.Task.ProjectID)
+	}
+
+	return entries, skipped, nil
+}
+
+// toTimerResponse converts a timer to a response, measuring a running timer up to now
+func (s *TimerService) toTimerResponse(timer *models.Timer, entries []dto.TimeEntryResponse, now time.Time) *dto.TimerResponse {
+	end := now
+	if timer.StoppedAt != nil {
+		end = *timer.StoppedAt
+	}
+	elapsed := decimal.NewFromInt(int64(end.Sub(timer.StartedAt))).Div(decimal.NewFromInt(int64(time.Hour)))
+
+	return &dto.TimerResponse{
+		ID:           timer.ID,
+		TaskID:       timer.TaskID,
+		TaskName:     timer.Task.Name,
+		MemberID:     timer.MemberID,
+		UserID:       timer.UserID,
+		StartedAt:    timer.StartedAt,
+		StoppedAt:    timer.StoppedAt,
+		AutoStopped:  timer.AutoStopped,
+		ElapsedHours: money.RoundHours(elapsed),
+		Comment:      timer.Comment,
+		IsBillable:   timer.IsBillable,
+		TimeEntries:  entries,
+	}
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+)
+
+// TimesheetService handles business logic for the weekly timesheets of members, which are
+// submitted for approval and lock their time entries once submitted
+type TimesheetService struct {
+	db            *gorm.DB
+	timesheetRepo *repository.TimesheetRepository
+	timeEntryRepo *repository.TimeEntryRepository
+	budgetService *BudgetService
+}
+
+// NewTimesheetService creates a new TimesheetService
+func NewTimesheetService(db *gorm.DB, dailyLimit DailyHoursLimit) *TimesheetService {
+	return &TimesheetService{
+		db:            db,
+		timesheetRepo: repository.NewTimesheetRepository(db),
+		timeEntryRepo: repository.NewTimeEntryRepository(db),
+		budgetService: NewBudgetService(db, dailyLimit),
+	}
+}
+
+// GetTimesheet retrieves the timesheet of a member for an ISO week given as YYYY-Www,
+// together with its time entries
+func (s *TimesheetService) GetTimesheet(memberID uuid.UUID, week string) (*dto.TimesheetResponse, error) {
+	timesheet, err := s.getOrDraftTimesheet(memberID, week)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.timeEntryRepo.GetByPeriod(repository.DateRange{From: &timesheet.WeekStart, To: &timesheet.WeekEnd}, &memberID)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	totalHours := decimal.Zero
+	responses := make([]dto.TimeEntryResponse, len(entries))
+	for i := range entries {
+		totalHours = totalHours.Add(entries[i].Hours)
+		responses[i] = *s.budgetService.toTimeEntryResponse(&entries[i])
+	}
+	totalHours = money.RoundHours(totalHours)
+
+	response := toTimesheetResponse(timesheet)
+	response.TotalHours = &totalHours
+	response.TimeEntries = responses
+	return response, nil
+}
+
+// ListTimesheets retrieves the submitted timesheets, optionally narrowed to a member,
+// a status and an ISO week
+func (s *TimesheetService) ListTimesheets(memberID *uuid.UUID, status, week string) (*dto.TimesheetListResponse, error) {
+	switch status {
+	case "", models.TimesheetStatusSubmitted, models.TimesheetStatusApproved, models.TimesheetStatusRejected:
+	default:
+		return nil, apperrors.ErrValidationFailed("status must be one of submitted, approved, rejected")
+	}
+
+	params := repository.TimesheetListParams{MemberID: memberID, Status: status}
+	if week != "" {
+		weekStart, err := parseISOWeek(week)
+		if err != nil {
+			return nil, err
+		}
+		params.WeekStart = &weekStart
+	}
+
+	timesheets, err := s.timesheetRepo.List(params)
+	if err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	responses := make([]dto.TimesheetResponse, len(timesheets))
+	for i := range timesheets {
+		responses[i] = *toTimesheetResponse(&timesheets[i])
+	}
+
+	return &dto.TimesheetListResponse{Timesheets: responses}, nil
+}
+
+// SubmitTimesheet submits the timesheet of a member for approval. A draft or rejected
+// timesheet can be submitted; its time entries are locked from then on.
+func (s *TimesheetService) SubmitTimesheet(memberID uuid.UUID, week string, userID uuid.UUID) (*dto.TimesheetResponse, error) {
+	timesheet, err := s.getOrDraftTimesheet(memberID, week)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet.IsLocked() {
+		return nil, apperrors.ErrConflict("Timesheet has already been submitted")
+	}
+
+	now := time.Now()
+	timesheet.Status = models.TimesheetStatusSubmitted
+	timesheet.SubmittedBy = &userID
+	timesheet.SubmittedAt = &now
+	// A resubmitted timesheet awaits a new review
+	timesheet.ReviewedBy = nil
+	timesheet.ReviewedAt = nil
+	timesheet.ReviewComment = nil
+
+	if err := s.timesheetRepo.Save(timesheet); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return toTimesheetResponse(timesheet), nil
+}
+
+// ApproveTimesheet approves a submitted timesheet. Its time entries stay locked.
+func (s *TimesheetService) ApproveTimesheet(memberID uuid.UUID, week string, reviewerID uuid.UUID, req *dto.ReviewTimesheetRequest) (*dto.TimesheetResponse, error) {
+	return s.reviewTimesheet(memberID, week, models.TimesheetStatusApproved, reviewerID, req)
+}
+
+// RejectTimesheet sends a submitted timesheet back to the member with a comment, unlocking its
+// time entries so that they can be corrected and submitted again
+func (s *TimesheetService) RejectTimesheet(memberID uuid.UUID, week string, reviewerID uuid.UUID, req *dto.ReviewTimesheetRequest) (*dto.TimesheetResponse, error) {
+	if req == nil || req.Comment == nil || *req.Comment == "" {
+		return nil, apperrors.ErrValidationFailed("comment is required to reject a timesheet")
+	}
+	return s.reviewTimesheet(memberID, week, models.TimesheetStatusRejected, reviewerID, req)
+}
+
+// reviewTimesheet records the decision on a submitted timesheet
+func (s *TimesheetService) reviewTimesheet(memberID uuid.UUID, week, status string, reviewerID uuid.UUID, req *dto.ReviewTimesheetRequest) (*dto.TimesheetResponse, error) {
+	timesheet, err := s.getOrDraftTimesheet(memberID, week)
+	if err != nil {
+		return nil, err
+	}
+	if timesheet.Status != models.TimesheetStatusSubmitted {
+		return nil, apperrors.ErrConflict("Only a submitted timesheet can be reviewed")
+	}
+
+	now := time.Now()
+	timesheet.Status = status
+	timesheet.ReviewedBy = &reviewerID
+	timesheet.ReviewedAt = &now
+	if req != nil {
+		timesheet.ReviewComment = req.Comment
+	}
+
+	if err := s.timesheetRepo.Save(timesheet); err != nil {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return toTimesheetResponse(timesheet), nil
+}
+
+// getOrDraftTimesheet retrieves the timesheet of a member for an ISO week, or a new draft
+// when the week has never been submitted
+func (s *TimesheetService) getOrDraftTimesheet(memberID uuid.UUID, week string) (*models.Timesheet, error) {
+	weekStart, err := parseISOWeek(week)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify member exists
+	var member models.Member
+	if err := s.db.First(&member, "id = ?", memberID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.ErrNotFound("Member")
+		}
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	timesheet, err := s.timesheetRepo.GetByMemberAndWeek(memberID, weekStart)
+	if err == nil {
+		return timesheet, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.ErrDatabaseError(err)
+	}
+
+	return &models.Timesheet{
+		MemberID:  memberID,
+		WeekStart: weekStart,
+		WeekEnd:   weekStart.AddDate(0, 0, 6),
+		Status:    models.TimesheetStatusDraft,
+		Member:    member,
+	}, nil
+}
+
+// ensureTimesheetsOpen checks that none of the dates falls in a submitted or approved timesheet of the member
+func ensureTimesheetsOpen(db *gorm.DB, memberID uuid.UUID, dates ...time.Time) error {
+	weekStarts := make([]time.Time, len(dates))
+	for i, date := range dates {
+		weekStarts[i] = periodStart(date, HistoryGranularityWeek)
+	}
+
+	locked, err := repository.NewTimesheetRepository(db).FindLocked(memberID, weekStarts)
+	if err != nil {
+		return apperrors.ErrDatabaseError(err)
+	}
+	if len(locked) > 0 {
+		return apperrors.ErrTimesheetLocked(isoWeekLabel(locked[0].WeekStart))
+	}
+	return nil
+}
+
+// parseISOWeek parses an ISO week given as YYYY-Www and returns its Monday
+func parseISOWeek(week string) (time.Time, error) {
+	var year, number int
+	if _, err := fmt.Sscanf(week, "%4d-W%2d", &year, &number); err != nil {
+		return time.Time{}, apperrors.ErrValidationFailed("week must be an ISO week in YYYY-Www format")
+	}
+
+	// Week 1 is the week containing January 4th
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	weekStart := periodStart(jan4, HistoryGranularityWeek).AddDate(0, 0, (number-1)*7)
+
+	// Rejects week numbers the year does not have, such as W00 or W53 of a 52-week year
+	if isoWeekLabel(weekStart) != week {
+		return time.Time{}, apperrors.ErrValidationFailed("week must be an ISO week in YYYY-Www format")
+	}
+	return weekStart, nil
+}
+
+// isoWeekLabel formats the ISO week of a date as YYYY-Www
+func isoWeekLabel(date time.Time) string {
+	year, week := date.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// toTimesheetResponse converts a timesheet to a response
+func toTimesheetResponse(timesheet *models.Timesheet) *dto.TimesheetResponse {
+	response := &dto.TimesheetResponse{
+		MemberID:      timesheet.MemberID,
+		MemberName:    timesheet.Member.Name,
+		Week:          isoWeekLabel(timesheet.WeekStart),
+		WeekStart:     timesheet.WeekStart.Format("2006-01-02"),
+		WeekEnd:       timesheet.WeekEnd.Format("2006-01-02"),
+		Status:        timesheet.Status,
+		SubmittedBy:   timesheet.SubmittedBy,
+		SubmittedAt:   timesheet.SubmittedAt,
+		ReviewedBy:    timesheet.ReviewedBy,
+		ReviewedAt:    timesheet.ReviewedAt,
+		ReviewComment: timesheet.ReviewComment,
+	}
+	if timesheet.ID != uuid.Nil {
+		id := timesheet.ID
+		response.ID = &id
+	}
+	return response
+}
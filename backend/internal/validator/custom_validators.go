@@ -1,7 +1,10 @@
 package validator
 
 import (
+	"reflect"
+
 	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 )
 
 var validate *validator.Validate
@@ -9,6 +12,17 @@ var validate *validator.Validate
 func init() {
 	validate = validator.New()
 	// Register custom validators here if needed
+
+	// Validate decimal amounts with numeric tags such as min and max
+	validate.RegisterCustomTypeFunc(decimalValue, decimal.Decimal{})
+}
+
+// decimalValue converts a decimal field into a float for numeric validation tags
+func decimalValue(field reflect.Value) interface{} {
+	if d, ok := field.Interface().(decimal.Decimal); ok {
+		return d.InexactFloat64()
+	}
+	return nil
 }
 
 // Validate validates a struct
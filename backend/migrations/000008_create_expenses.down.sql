@@ -0,0 +1,2 @@
+-- Drop expenses table
+DROP TABLE IF EXISTS expenses CASCADE;
@@ -0,0 +1,31 @@
+-- Create expenses table
+CREATE TABLE expenses (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    category VARCHAR(30) NOT NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    vendor VARCHAR(200),
+    incurred_date DATE NOT NULL DEFAULT CURRENT_DATE,
+    note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT expenses_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT expenses_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT,
+    CONSTRAINT expenses_category_check CHECK (category IN ('subcontractor', 'license', 'travel', 'hardware', 'other')),
+    CONSTRAINT expenses_amount_check CHECK (amount >= 0)
+);
+
+-- Indexes
+CREATE INDEX expenses_project_id_idx ON expenses(project_id);
+CREATE INDEX expenses_user_id_idx ON expenses(user_id);
+CREATE INDEX expenses_category_idx ON expenses(category);
+CREATE INDEX expenses_incurred_date_idx ON expenses(incurred_date);
+
+-- Comments
+COMMENT ON TABLE expenses IS '経費（人件費以外のコスト）';
+COMMENT ON COLUMN expenses.category IS 'カテゴリ: subcontractor, license, travel, hardware, other';
+COMMENT ON COLUMN expenses.amount IS '金額';
+COMMENT ON COLUMN expenses.vendor IS '支払先';
+COMMENT ON COLUMN expenses.incurred_date IS '発生日';
@@ -0,0 +1,6 @@
+-- Drop currency columns
+DROP INDEX IF EXISTS expenses_currency_idx;
+DROP INDEX IF EXISTS time_entries_currency_idx;
+ALTER TABLE expenses DROP COLUMN IF EXISTS currency;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS currency;
+ALTER TABLE members DROP COLUMN IF EXISTS currency;
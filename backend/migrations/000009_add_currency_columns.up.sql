@@ -0,0 +1,13 @@
+-- Add currency columns to members, time_entries and expenses
+ALTER TABLE members ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'JPY';
+ALTER TABLE time_entries ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'JPY';
+ALTER TABLE expenses ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'JPY';
+
+-- Indexes
+CREATE INDEX time_entries_currency_idx ON time_entries(currency);
+CREATE INDEX expenses_currency_idx ON expenses(currency);
+
+-- Comments
+COMMENT ON COLUMN members.currency IS '時間単価の通貨（ISO 4217）';
+COMMENT ON COLUMN time_entries.currency IS '記録時点の時間単価の通貨（スナップショット）';
+COMMENT ON COLUMN expenses.currency IS '経費の通貨（ISO 4217）';
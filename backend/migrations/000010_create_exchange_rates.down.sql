@@ -0,0 +1,2 @@
+-- Drop exchange_rates table
+DROP TABLE IF EXISTS exchange_rates CASCADE;
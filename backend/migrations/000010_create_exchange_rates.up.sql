@@ -0,0 +1,24 @@
+-- Create exchange_rates table
+CREATE TABLE exchange_rates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    rate_date DATE NOT NULL,
+    from_currency VARCHAR(3) NOT NULL,
+    to_currency VARCHAR(3) NOT NULL,
+    rate DECIMAL(18,8) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT exchange_rates_rate_check CHECK (rate > 0),
+    CONSTRAINT exchange_rates_pair_check CHECK (from_currency <> to_currency)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX exchange_rates_date_pair_idx ON exchange_rates(rate_date, from_currency, to_currency);
+CREATE INDEX exchange_rates_pair_idx ON exchange_rates(from_currency, to_currency, rate_date);
+
+-- Comments
+COMMENT ON TABLE exchange_rates IS '為替レート';
+COMMENT ON COLUMN exchange_rates.rate_date IS '適用日';
+COMMENT ON COLUMN exchange_rates.from_currency IS '換算元通貨';
+COMMENT ON COLUMN exchange_rates.to_currency IS '換算先通貨';
+COMMENT ON COLUMN exchange_rates.rate IS '換算元1単位あたりの換算先通貨額';
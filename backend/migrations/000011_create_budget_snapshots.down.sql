@@ -0,0 +1,2 @@
+-- Drop budget_snapshots table
+DROP TABLE IF EXISTS budget_snapshots CASCADE;
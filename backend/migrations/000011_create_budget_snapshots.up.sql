@@ -0,0 +1,31 @@
+-- Create budget_snapshots table
+CREATE TABLE budget_snapshots (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    snapshot_date DATE NOT NULL,
+    source VARCHAR(20) NOT NULL DEFAULT 'daily',
+    revenue DECIMAL(15,2) DEFAULT 0.00,
+    total_cost DECIMAL(15,2) DEFAULT 0.00,
+    profit DECIMAL(15,2) DEFAULT 0.00,
+    profit_rate DECIMAL(5,2) DEFAULT 0.00,
+    currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT budget_snapshots_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT budget_snapshots_source_check CHECK (source IN ('daily', 'revenue_change'))
+);
+
+-- Indexes
+CREATE INDEX budget_snapshots_project_date_idx ON budget_snapshots(project_id, snapshot_date);
+CREATE UNIQUE INDEX budget_snapshots_project_date_daily_idx ON budget_snapshots(project_id, snapshot_date) WHERE source = 'daily';
+
+-- Comments
+COMMENT ON TABLE budget_snapshots IS 'プロジェクト予算・収支のスナップショット';
+COMMENT ON COLUMN budget_snapshots.snapshot_date IS '記録日';
+COMMENT ON COLUMN budget_snapshots.source IS '記録契機（daily: 日次, revenue_change: 売上変更）';
+COMMENT ON COLUMN budget_snapshots.revenue IS '売上金額';
+COMMENT ON COLUMN budget_snapshots.total_cost IS '総コスト';
+COMMENT ON COLUMN budget_snapshots.profit IS '利益';
+COMMENT ON COLUMN budget_snapshots.profit_rate IS '利益率（％）';
+COMMENT ON COLUMN budget_snapshots.currency IS '通貨（ISO 4217）';
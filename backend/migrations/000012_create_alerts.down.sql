@@ -0,0 +1,3 @@
+-- Drop alerts tables
+DROP TABLE IF EXISTS alerts CASCADE;
+DROP TABLE IF EXISTS alert_rules CASCADE;
@@ -0,0 +1,53 @@
+-- Create alert_rules table
+CREATE TABLE alert_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    metric VARCHAR(30) NOT NULL,
+    threshold DECIMAL(15,2) NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT alert_rules_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT alert_rules_metric_check CHECK (metric IN ('cost_ratio', 'profit_rate', 'weekly_burn_rate'))
+);
+
+-- Create alerts table
+CREATE TABLE alerts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    rule_id UUID,
+    metric VARCHAR(30) NOT NULL,
+    threshold DECIMAL(15,2) NOT NULL,
+    value DECIMAL(15,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
+    message TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    triggered_at TIMESTAMP NOT NULL,
+    acknowledged_at TIMESTAMP,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT alerts_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT alerts_rule_id_fkey FOREIGN KEY (rule_id) REFERENCES alert_rules(id) ON DELETE SET NULL,
+    CONSTRAINT alerts_status_check CHECK (status IN ('open', 'acknowledged', 'resolved'))
+);
+
+-- Indexes
+CREATE INDEX alert_rules_project_id_idx ON alert_rules(project_id);
+CREATE INDEX alerts_project_id_idx ON alerts(project_id);
+CREATE INDEX alerts_rule_id_idx ON alerts(rule_id);
+CREATE INDEX alerts_status_idx ON alerts(status);
+
+-- Comments
+COMMENT ON TABLE alert_rules IS '予算アラートルール';
+COMMENT ON COLUMN alert_rules.metric IS '指標（cost_ratio: 予算消化率, profit_rate: 利益率, weekly_burn_rate: 週次バーンレート）';
+COMMENT ON COLUMN alert_rules.threshold IS 'しきい値（％または金額）';
+COMMENT ON COLUMN alert_rules.is_enabled IS '有効フラグ';
+COMMENT ON TABLE alerts IS '発生した予算アラート';
+COMMENT ON COLUMN alerts.value IS '発生時の指標値';
+COMMENT ON COLUMN alerts.status IS 'ステータス（open, acknowledged, resolved）';
+COMMENT ON COLUMN alerts.triggered_at IS '発生日時';
+COMMENT ON COLUMN alerts.acknowledged_at IS '確認日時';
+COMMENT ON COLUMN alerts.resolved_at IS '解決日時';
@@ -0,0 +1,4 @@
+-- Drop revenue breakdown columns and revenue_items table
+ALTER TABLE budgets DROP COLUMN IF EXISTS planned_revenue;
+ALTER TABLE budgets DROP COLUMN IF EXISTS recognized_revenue;
+DROP TABLE IF EXISTS revenue_items CASCADE;
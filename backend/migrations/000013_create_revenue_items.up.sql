@@ -0,0 +1,40 @@
+-- Create revenue_items table
+CREATE TABLE revenue_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    task_id UUID,
+    name VARCHAR(255) NOT NULL,
+    planned_date DATE NOT NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
+    status VARCHAR(20) NOT NULL DEFAULT 'planned',
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT revenue_items_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT revenue_items_task_id_fkey FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL,
+    CONSTRAINT revenue_items_amount_check CHECK (amount > 0),
+    CONSTRAINT revenue_items_status_check CHECK (status IN ('planned', 'invoiced', 'received'))
+);
+
+-- Indexes
+CREATE INDEX revenue_items_project_id_idx ON revenue_items(project_id);
+CREATE INDEX revenue_items_task_id_idx ON revenue_items(task_id);
+CREATE INDEX revenue_items_planned_date_idx ON revenue_items(planned_date);
+CREATE INDEX revenue_items_status_idx ON revenue_items(status);
+
+-- Add revenue breakdown columns to budgets
+ALTER TABLE budgets ADD COLUMN recognized_revenue DECIMAL(15,2) DEFAULT 0.00;
+ALTER TABLE budgets ADD COLUMN planned_revenue DECIMAL(15,2) DEFAULT 0.00;
+
+-- Comments
+COMMENT ON TABLE revenue_items IS 'マイルストーン単位の売上計画';
+COMMENT ON COLUMN revenue_items.task_id IS '紐づくタスク（任意）';
+COMMENT ON COLUMN revenue_items.name IS 'マイルストーン名';
+COMMENT ON COLUMN revenue_items.planned_date IS '計上予定日';
+COMMENT ON COLUMN revenue_items.amount IS '金額';
+COMMENT ON COLUMN revenue_items.currency IS '通貨（ISO 4217）';
+COMMENT ON COLUMN revenue_items.status IS 'ステータス（planned: 予定, invoiced: 請求済, received: 入金済）';
+COMMENT ON COLUMN budgets.recognized_revenue IS '計上済み売上（請求済・入金済の合計）';
+COMMENT ON COLUMN budgets.planned_revenue IS '予定売上（未請求の合計）';
@@ -0,0 +1,6 @@
+-- Drop invoice tables
+DROP INDEX IF EXISTS time_entries_invoice_id_idx;
+ALTER TABLE time_entries DROP CONSTRAINT IF EXISTS time_entries_invoice_id_fkey;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS invoice_id;
+DROP TABLE IF EXISTS invoice_lines CASCADE;
+DROP TABLE IF EXISTS invoices CASCADE;
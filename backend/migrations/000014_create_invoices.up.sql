@@ -0,0 +1,66 @@
+-- Create invoices table
+CREATE TABLE invoices (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    sequence_number INTEGER,
+    invoice_number VARCHAR(30),
+    status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    group_by VARCHAR(20) NOT NULL DEFAULT 'member',
+    currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
+    total_hours DECIMAL(10,2) NOT NULL DEFAULT 0,
+    total_amount DECIMAL(15,2) NOT NULL DEFAULT 0,
+    note TEXT,
+    issued_at TIMESTAMP,
+    paid_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT invoices_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT invoices_sequence_number_key UNIQUE (sequence_number),
+    CONSTRAINT invoices_invoice_number_key UNIQUE (invoice_number),
+    CONSTRAINT invoices_status_check CHECK (status IN ('draft', 'issued', 'paid')),
+    CONSTRAINT invoices_group_by_check CHECK (group_by IN ('member', 'task')),
+    CONSTRAINT invoices_period_check CHECK (period_start <= period_end)
+);
+
+-- Create invoice_lines table
+CREATE TABLE invoice_lines (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    invoice_id UUID NOT NULL,
+    member_id UUID,
+    task_id UUID,
+    description VARCHAR(255) NOT NULL,
+    hours DECIMAL(10,2) NOT NULL,
+    unit_price DECIMAL(15,2) NOT NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT invoice_lines_invoice_id_fkey FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE CASCADE
+);
+
+-- Link time entries to the invoice that bills them
+ALTER TABLE time_entries ADD COLUMN invoice_id UUID;
+ALTER TABLE time_entries ADD CONSTRAINT time_entries_invoice_id_fkey FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE SET NULL;
+
+-- Indexes
+CREATE INDEX invoices_project_id_idx ON invoices(project_id);
+CREATE INDEX invoices_status_idx ON invoices(status);
+CREATE INDEX invoice_lines_invoice_id_idx ON invoice_lines(invoice_id);
+CREATE INDEX time_entries_invoice_id_idx ON time_entries(invoice_id);
+
+-- Comments
+COMMENT ON TABLE invoices IS '請求書';
+COMMENT ON COLUMN invoices.sequence_number IS '請求書の連番（発行時に採番）';
+COMMENT ON COLUMN invoices.invoice_number IS '請求書番号（発行時に採番）';
+COMMENT ON COLUMN invoices.status IS 'ステータス（draft: 下書き, issued: 発行済, paid: 入金済）';
+COMMENT ON COLUMN invoices.period_start IS '請求対象期間の開始日';
+COMMENT ON COLUMN invoices.period_end IS '請求対象期間の終了日';
+COMMENT ON COLUMN invoices.group_by IS '明細の集計単位（member: メンバー, task: タスク）';
+COMMENT ON COLUMN invoices.currency IS '通貨（ISO 4217）';
+COMMENT ON TABLE invoice_lines IS '請求書明細';
+COMMENT ON COLUMN invoice_lines.unit_price IS '単価（金額 ÷ 工数）';
+COMMENT ON COLUMN time_entries.invoice_id IS '請求先の請求書';
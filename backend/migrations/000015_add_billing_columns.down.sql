@@ -0,0 +1,7 @@
+-- Drop billing columns
+DROP INDEX IF EXISTS time_entries_is_billable_idx;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS bill_rate_snapshot;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS is_billable;
+ALTER TABLE projects DROP COLUMN IF EXISTS default_billable;
+ALTER TABLE project_members DROP COLUMN IF EXISTS bill_rate;
+ALTER TABLE members DROP COLUMN IF EXISTS bill_rate;
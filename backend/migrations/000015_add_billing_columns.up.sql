@@ -0,0 +1,24 @@
+-- Add bill rate columns to members and project_members
+ALTER TABLE members ADD COLUMN bill_rate DECIMAL(10,2);
+ALTER TABLE project_members ADD COLUMN bill_rate DECIMAL(10,2);
+
+-- Add the default billable flag to projects
+ALTER TABLE projects ADD COLUMN default_billable BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Add billable flag and bill rate snapshot to time_entries
+ALTER TABLE time_entries ADD COLUMN is_billable BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE time_entries ADD COLUMN bill_rate_snapshot DECIMAL(10,2);
+
+-- Existing time entries were billed at their cost rate
+UPDATE time_entries SET bill_rate_snapshot = hourly_rate_snapshot;
+
+-- Indexes
+CREATE INDEX time_entries_is_billable_idx ON time_entries(is_billable);
+
+-- Comments
+COMMENT ON COLUMN members.bill_rate IS '請求単価（未設定の場合は原価単価）';
+COMMENT ON COLUMN project_members.bill_rate IS 'プロジェクト別の請求単価（未設定の場合はメンバーの請求単価）';
+COMMENT ON COLUMN projects.default_billable IS '工数の請求可否の既定値';
+COMMENT ON COLUMN time_entries.is_billable IS '請求対象かどうか';
+COMMENT ON COLUMN time_entries.bill_rate_snapshot IS '記録時点の請求単価';
+COMMENT ON COLUMN time_entries.hourly_rate_snapshot IS '記録時点の原価単価';
@@ -0,0 +1,2 @@
+-- Drop member_rates table
+DROP TABLE IF EXISTS member_rates CASCADE;
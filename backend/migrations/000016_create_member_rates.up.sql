@@ -0,0 +1,32 @@
+-- Create member_rates table
+CREATE TABLE member_rates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    member_id UUID NOT NULL,
+    hourly_rate DECIMAL(10,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
+    effective_from DATE NOT NULL,
+    effective_to DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT member_rates_member_id_fkey FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE,
+    CONSTRAINT member_rates_hourly_rate_check CHECK (hourly_rate >= 0),
+    CONSTRAINT member_rates_period_check CHECK (effective_to IS NULL OR effective_to >= effective_from)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX member_rates_member_id_effective_from_idx ON member_rates(member_id, effective_from);
+CREATE INDEX member_rates_effective_to_idx ON member_rates(effective_to);
+
+-- Start the history of existing members with their current rate
+INSERT INTO member_rates (member_id, hourly_rate, currency, effective_from)
+SELECT id, COALESCE(hourly_rate, 0), currency, created_at::date
+FROM members
+WHERE deleted_at IS NULL;
+
+-- Comments
+COMMENT ON TABLE member_rates IS 'メンバーの原価単価履歴';
+COMMENT ON COLUMN member_rates.hourly_rate IS '時間単価';
+COMMENT ON COLUMN member_rates.currency IS '通貨（ISO 4217）';
+COMMENT ON COLUMN member_rates.effective_from IS '適用開始日';
+COMMENT ON COLUMN member_rates.effective_to IS '適用終了日（NULLの場合は現行単価）';
@@ -0,0 +1,4 @@
+-- Drop rate resolution columns and department_rates table
+ALTER TABLE time_entries DROP CONSTRAINT IF EXISTS time_entries_rate_source_check;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS rate_source;
+DROP TABLE IF EXISTS department_rates CASCADE;
@@ -0,0 +1,34 @@
+-- Create department_rates table
+CREATE TABLE department_rates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    department VARCHAR(100) NOT NULL,
+    hourly_rate DECIMAL(10,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT department_rates_hourly_rate_check CHECK (hourly_rate >= 0)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX department_rates_department_idx ON department_rates(department);
+
+-- Record where the cost rate of each time entry came from
+ALTER TABLE time_entries ADD COLUMN rate_source VARCHAR(20) NOT NULL DEFAULT 'member';
+ALTER TABLE time_entries ADD CONSTRAINT time_entries_rate_source_check CHECK (rate_source IN ('entry', 'project', 'member', 'department'));
+
+-- Assignments only keep a rate when it is overridden for the project.
+-- Rates copied from the member at assignment would hide later rate changes.
+UPDATE project_members
+SET hourly_rate_snapshot = NULL
+FROM members
+WHERE members.id = project_members.member_id
+  AND project_members.hourly_rate_snapshot = members.hourly_rate;
+
+-- Comments
+COMMENT ON TABLE department_rates IS '部署ごとの既定の原価単価';
+COMMENT ON COLUMN department_rates.department IS '部署名';
+COMMENT ON COLUMN department_rates.hourly_rate IS '時間単価';
+COMMENT ON COLUMN department_rates.currency IS '通貨（ISO 4217）';
+COMMENT ON COLUMN time_entries.rate_source IS '原価単価の決定元（entry: 工数個別, project: プロジェクト別, member: メンバー, department: 部署既定）';
+COMMENT ON COLUMN project_members.hourly_rate_snapshot IS 'プロジェクト別の原価単価（未設定の場合はメンバーの単価）';
@@ -0,0 +1,2 @@
+-- Drop holidays table
+DROP TABLE IF EXISTS holidays CASCADE;
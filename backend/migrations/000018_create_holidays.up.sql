@@ -0,0 +1,16 @@
+-- Create holidays table
+CREATE TABLE holidays (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    date DATE NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes
+CREATE UNIQUE INDEX holidays_date_idx ON holidays(date);
+
+-- Comments
+COMMENT ON TABLE holidays IS '休日カレンダー（土日以外の休業日）';
+COMMENT ON COLUMN holidays.date IS '休日';
+COMMENT ON COLUMN holidays.name IS '休日名';
@@ -0,0 +1,2 @@
+-- Drop department_overheads table
+DROP TABLE IF EXISTS department_overheads CASCADE;
@@ -0,0 +1,20 @@
+-- Create department_overheads table
+CREATE TABLE department_overheads (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    department VARCHAR(100) NOT NULL,
+    multiplier DECIMAL(5,3) NOT NULL,
+    effective_from DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT department_overheads_multiplier_check CHECK (multiplier > 0)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX department_overheads_department_effective_from_idx ON department_overheads(department, effective_from);
+
+-- Comments
+COMMENT ON TABLE department_overheads IS '部署ごとの間接費係数（適用開始日から次の係数まで有効）';
+COMMENT ON COLUMN department_overheads.department IS '部署名';
+COMMENT ON COLUMN department_overheads.multiplier IS '人件費に乗じる間接費係数（オフィス・福利厚生等を含む）';
+COMMENT ON COLUMN department_overheads.effective_from IS '適用開始日';
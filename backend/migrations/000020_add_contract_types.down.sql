@@ -0,0 +1,9 @@
+-- Drop contract type columns
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS projects_retainer_rollover_months_check;
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS projects_retainer_hours_check;
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS projects_retainer_fee_check;
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS projects_contract_type_check;
+ALTER TABLE projects DROP COLUMN IF EXISTS retainer_rollover_months;
+ALTER TABLE projects DROP COLUMN IF EXISTS retainer_hours;
+ALTER TABLE projects DROP COLUMN IF EXISTS retainer_fee;
+ALTER TABLE projects DROP COLUMN IF EXISTS contract_type;
@@ -0,0 +1,17 @@
+-- Add the contract type and retainer terms to projects
+ALTER TABLE projects ADD COLUMN contract_type VARCHAR(20) NOT NULL DEFAULT 'fixed_price';
+ALTER TABLE projects ADD COLUMN retainer_fee DECIMAL(15,2);
+ALTER TABLE projects ADD COLUMN retainer_hours DECIMAL(7,2);
+ALTER TABLE projects ADD COLUMN retainer_rollover_months INTEGER NOT NULL DEFAULT 0;
+
+-- Constraints
+ALTER TABLE projects ADD CONSTRAINT projects_contract_type_check CHECK (contract_type IN ('fixed_price', 'time_and_materials', 'retainer'));
+ALTER TABLE projects ADD CONSTRAINT projects_retainer_fee_check CHECK (retainer_fee IS NULL OR retainer_fee >= 0);
+ALTER TABLE projects ADD CONSTRAINT projects_retainer_hours_check CHECK (retainer_hours IS NULL OR retainer_hours >= 0);
+ALTER TABLE projects ADD CONSTRAINT projects_retainer_rollover_months_check CHECK (retainer_rollover_months >= 0);
+
+-- Comments
+COMMENT ON COLUMN projects.contract_type IS '契約形態（fixed_price: 請負, time_and_materials: 準委任（時間精算）, retainer: 月額顧問）';
+COMMENT ON COLUMN projects.retainer_fee IS '月額顧問料（予算通貨）';
+COMMENT ON COLUMN projects.retainer_hours IS '月額顧問料に含まれる月間工数';
+COMMENT ON COLUMN projects.retainer_rollover_months IS '未使用工数を繰り越せる月数（0 の場合は繰り越さない）';
@@ -0,0 +1,3 @@
+-- Drop budget_line_links and budget_lines tables
+DROP TABLE IF EXISTS budget_line_links CASCADE;
+DROP TABLE IF EXISTS budget_lines CASCADE;
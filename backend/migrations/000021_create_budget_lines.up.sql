@@ -0,0 +1,45 @@
+-- Create budget_lines table
+CREATE TABLE budget_lines (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    category VARCHAR(100) NOT NULL,
+    planned_amount DECIMAL(15,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT budget_lines_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT budget_lines_planned_amount_check CHECK (planned_amount >= 0)
+);
+
+-- Create budget_line_links table
+CREATE TABLE budget_line_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    budget_line_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    task_id UUID,
+    expense_category VARCHAR(30),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT budget_line_links_budget_line_id_fkey FOREIGN KEY (budget_line_id) REFERENCES budget_lines(id) ON DELETE CASCADE,
+    CONSTRAINT budget_line_links_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT budget_line_links_task_id_fkey FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT budget_line_links_target_check CHECK ((task_id IS NULL) <> (expense_category IS NULL)),
+    CONSTRAINT budget_line_links_expense_category_check CHECK (expense_category IS NULL OR expense_category IN ('subcontractor', 'license', 'travel', 'hardware', 'other'))
+);
+
+-- Indexes
+CREATE INDEX budget_lines_project_id_idx ON budget_lines(project_id);
+CREATE INDEX budget_lines_category_idx ON budget_lines(category);
+CREATE INDEX budget_line_links_budget_line_id_idx ON budget_line_links(budget_line_id);
+CREATE UNIQUE INDEX budget_line_links_task_idx ON budget_line_links(project_id, task_id) WHERE task_id IS NOT NULL;
+CREATE UNIQUE INDEX budget_line_links_expense_category_idx ON budget_line_links(project_id, expense_category) WHERE expense_category IS NOT NULL;
+
+-- Comments
+COMMENT ON TABLE budget_lines IS 'プロジェクト予算の明細（費目・タスクグループ単位）';
+COMMENT ON COLUMN budget_lines.name IS '明細名';
+COMMENT ON COLUMN budget_lines.category IS '費目';
+COMMENT ON COLUMN budget_lines.planned_amount IS '計画金額（予算通貨）';
+COMMENT ON TABLE budget_line_links IS '予算明細に実績を集計するタスク・経費区分（各プロジェクトで 1 明細のみ）';
+COMMENT ON COLUMN budget_line_links.task_id IS '工数を集計するタスク';
+COMMENT ON COLUMN budget_line_links.expense_category IS '経費を集計する経費区分';
@@ -0,0 +1,8 @@
+-- Restore the budget snapshot sources
+DELETE FROM budget_snapshots WHERE source = 'change_order';
+ALTER TABLE budget_snapshots DROP CONSTRAINT budget_snapshots_source_check;
+ALTER TABLE budget_snapshots ADD CONSTRAINT budget_snapshots_source_check CHECK (source IN ('daily', 'revenue_change'));
+COMMENT ON COLUMN budget_snapshots.source IS '記録契機（daily: 日次, revenue_change: 売上変更）';
+
+-- Drop change_orders table
+DROP TABLE IF EXISTS change_orders CASCADE;
@@ -0,0 +1,49 @@
+-- Create change_orders table
+CREATE TABLE change_orders (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    reason TEXT NOT NULL,
+    budget_amount DECIMAL(15,2),
+    revenue DECIMAL(15,2),
+    end_date DATE,
+    cost_impact DECIMAL(15,2) NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'submitted',
+    requested_by UUID NOT NULL,
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP,
+    review_comment TEXT,
+    previous_budget_amount DECIMAL(15,2),
+    previous_revenue DECIMAL(15,2),
+    previous_end_date DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT change_orders_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    CONSTRAINT change_orders_status_check CHECK (status IN ('submitted', 'approved', 'rejected')),
+    CONSTRAINT change_orders_change_check CHECK (budget_amount IS NOT NULL OR revenue IS NOT NULL OR end_date IS NOT NULL),
+    CONSTRAINT change_orders_budget_amount_check CHECK (budget_amount IS NULL OR budget_amount >= 0),
+    CONSTRAINT change_orders_revenue_check CHECK (revenue IS NULL OR revenue >= 0)
+);
+
+-- Indexes
+CREATE INDEX change_orders_project_id_idx ON change_orders(project_id);
+CREATE INDEX change_orders_status_idx ON change_orders(status);
+
+-- Budget snapshots are also recorded when a change order is approved
+ALTER TABLE budget_snapshots DROP CONSTRAINT budget_snapshots_source_check;
+ALTER TABLE budget_snapshots ADD CONSTRAINT budget_snapshots_source_check CHECK (source IN ('daily', 'revenue_change', 'change_order'));
+
+-- Comments
+COMMENT ON TABLE change_orders IS '予算・売上・終了日の変更申請';
+COMMENT ON COLUMN change_orders.title IS '件名';
+COMMENT ON COLUMN change_orders.reason IS '変更理由';
+COMMENT ON COLUMN change_orders.budget_amount IS '変更後の予算額';
+COMMENT ON COLUMN change_orders.revenue IS '変更後の売上（予算通貨）';
+COMMENT ON COLUMN change_orders.end_date IS '変更後の終了日';
+COMMENT ON COLUMN change_orders.cost_impact IS '原価への影響額（予算通貨）';
+COMMENT ON COLUMN change_orders.status IS 'ステータス（submitted: 申請中, approved: 承認済, rejected: 却下）';
+COMMENT ON COLUMN change_orders.previous_budget_amount IS '承認時点の変更前の予算額';
+COMMENT ON COLUMN change_orders.previous_revenue IS '承認時点の変更前の売上';
+COMMENT ON COLUMN change_orders.previous_end_date IS '承認時点の変更前の終了日';
+COMMENT ON COLUMN budget_snapshots.source IS '記録契機（daily: 日次, revenue_change: 売上変更, change_order: 変更申請の承認）';
@@ -0,0 +1,2 @@
+-- Drop accounting_periods table
+DROP TABLE IF EXISTS accounting_periods CASCADE;
@@ -0,0 +1,25 @@
+-- Create accounting_periods table
+CREATE TABLE accounting_periods (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    period_start DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    closed_by UUID,
+    closed_at TIMESTAMP,
+    reopened_by UUID,
+    reopened_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT accounting_periods_status_check CHECK (status IN ('open', 'closed')),
+    CONSTRAINT accounting_periods_period_start_check CHECK (EXTRACT(DAY FROM period_start) = 1)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX accounting_periods_period_start_idx ON accounting_periods(period_start);
+
+-- Comments
+COMMENT ON TABLE accounting_periods IS '会計期間（月次）。締め済みの月の工数・経費・売上は変更不可';
+COMMENT ON COLUMN accounting_periods.period_start IS '期間の初日（月初）';
+COMMENT ON COLUMN accounting_periods.status IS 'ステータス（open: 未締め, closed: 締め済み）';
+COMMENT ON COLUMN accounting_periods.closed_by IS '最後に締めたユーザー';
+COMMENT ON COLUMN accounting_periods.reopened_by IS '最後に締めを解除したユーザー';
@@ -0,0 +1,2 @@
+-- Drop timers table
+DROP TABLE IF EXISTS timers CASCADE;
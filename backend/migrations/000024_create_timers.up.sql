@@ -0,0 +1,32 @@
+-- Create timers table
+CREATE TABLE timers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL,
+    member_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    stopped_at TIMESTAMP,
+    auto_stopped BOOLEAN NOT NULL DEFAULT FALSE,
+    comment TEXT,
+    is_billable BOOLEAN,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT timers_task_id_fkey FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT timers_member_id_fkey FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE,
+    CONSTRAINT timers_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT timers_stopped_at_check CHECK (stopped_at IS NULL OR stopped_at >= started_at)
+);
+
+-- Indexes
+CREATE INDEX timers_task_id_idx ON timers(task_id);
+CREATE INDEX timers_member_id_idx ON timers(member_id);
+-- A member has at most one running timer
+CREATE UNIQUE INDEX timers_running_member_id_idx ON timers(member_id) WHERE stopped_at IS NULL;
+
+-- Comments
+COMMENT ON TABLE timers IS '作業タイマー。停止時に稼働日ごとの工数として登録される';
+COMMENT ON COLUMN timers.started_at IS '開始日時';
+COMMENT ON COLUMN timers.stopped_at IS '停止日時（NULLは計測中）';
+COMMENT ON COLUMN timers.auto_stopped IS '上限時間を超えたため自動停止されたか';
+COMMENT ON COLUMN timers.is_billable IS '請求対象か（NULLはプロジェクトの既定に従う）';
@@ -0,0 +1,6 @@
+-- Drop the hours basis of budgets
+ALTER TABLE budgets DROP CONSTRAINT IF EXISTS budgets_hours_basis_check;
+ALTER TABLE budgets DROP COLUMN IF EXISTS hours_basis;
+
+-- Drop timesheets table
+DROP TABLE IF EXISTS timesheets CASCADE;
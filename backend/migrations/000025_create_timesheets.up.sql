@@ -0,0 +1,36 @@
+-- Create timesheets table
+CREATE TABLE timesheets (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    member_id UUID NOT NULL,
+    week_start DATE NOT NULL,
+    week_end DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    submitted_by UUID,
+    submitted_at TIMESTAMP,
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP,
+    review_comment TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT timesheets_member_id_fkey FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE,
+    CONSTRAINT timesheets_status_check CHECK (status IN ('draft', 'submitted', 'approved', 'rejected')),
+    CONSTRAINT timesheets_week_start_check CHECK (EXTRACT(ISODOW FROM week_start) = 1),
+    CONSTRAINT timesheets_week_end_check CHECK (week_end = week_start + 6)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX timesheets_member_id_week_start_idx ON timesheets(member_id, week_start);
+CREATE INDEX timesheets_status_idx ON timesheets(status);
+
+-- Add the basis of the hours counted in budget summaries
+ALTER TABLE budgets ADD COLUMN hours_basis VARCHAR(20) NOT NULL DEFAULT 'all';
+ALTER TABLE budgets ADD CONSTRAINT budgets_hours_basis_check CHECK (hours_basis IN ('all', 'approved'));
+
+-- Comments
+COMMENT ON TABLE timesheets IS 'メンバー・ISO週ごとの工数表。提出後はその週の工数を変更不可';
+COMMENT ON COLUMN timesheets.week_start IS '週の初日（月曜日）';
+COMMENT ON COLUMN timesheets.week_end IS '週の最終日（日曜日）';
+COMMENT ON COLUMN timesheets.status IS 'ステータス（draft: 下書き, submitted: 提出済, approved: 承認済, rejected: 差戻し）';
+COMMENT ON COLUMN timesheets.review_comment IS '承認者のコメント';
+COMMENT ON COLUMN budgets.hours_basis IS '予算サマリーで集計する工数（all: すべて, approved: 承認済の工数表のみ）';
@@ -0,0 +1,8 @@
+-- Drop the start and end times of time entries
+DROP INDEX IF EXISTS time_entries_member_started_at_idx;
+ALTER TABLE time_entries DROP CONSTRAINT IF EXISTS time_entries_time_range_check;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS local_end_time;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS local_start_time;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS timezone;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS ended_at;
+ALTER TABLE time_entries DROP COLUMN IF EXISTS started_at;
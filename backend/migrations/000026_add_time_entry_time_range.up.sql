@@ -0,0 +1,21 @@
+-- Add the start and end times of time entries
+ALTER TABLE time_entries ADD COLUMN started_at TIMESTAMP;
+ALTER TABLE time_entries ADD COLUMN ended_at TIMESTAMP;
+ALTER TABLE time_entries ADD COLUMN timezone VARCHAR(64);
+ALTER TABLE time_entries ADD COLUMN local_start_time VARCHAR(5);
+ALTER TABLE time_entries ADD COLUMN local_end_time VARCHAR(5);
+ALTER TABLE time_entries ADD CONSTRAINT time_entries_time_range_check CHECK (
+    (started_at IS NULL AND ended_at IS NULL AND timezone IS NULL AND local_start_time IS NULL AND local_end_time IS NULL)
+    OR (started_at IS NOT NULL AND ended_at > started_at AND ended_at <= started_at + INTERVAL '24 hours'
+        AND timezone IS NOT NULL AND local_start_time IS NOT NULL AND local_end_time IS NOT NULL)
+);
+
+-- Indexes
+CREATE INDEX time_entries_member_started_at_idx ON time_entries(member_id, started_at) WHERE started_at IS NOT NULL;
+
+-- Comments
+COMMENT ON COLUMN time_entries.started_at IS '作業の開始日時（UTC、NULLは時刻の記録なし）';
+COMMENT ON COLUMN time_entries.ended_at IS '作業の終了日時（UTC）';
+COMMENT ON COLUMN time_entries.timezone IS '開始・終了日時を記録したタイムゾーン（例: Asia/Tokyo）';
+COMMENT ON COLUMN time_entries.local_start_time IS 'タイムゾーンでの開始時刻（HH:MM）';
+COMMENT ON COLUMN time_entries.local_end_time IS 'タイムゾーンでの終了時刻（HH:MM、開始時刻以前は日付をまたぐ）';
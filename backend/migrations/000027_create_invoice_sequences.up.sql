@@ -0,0 +1,16 @@
+-- Create invoice_sequences table
+CREATE TABLE invoice_sequences (
+    id INTEGER PRIMARY KEY,
+    last_number INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT invoice_sequences_single_row_check CHECK (id = 1),
+    CONSTRAINT invoice_sequences_last_number_check CHECK (last_number >= 0)
+);
+
+-- Continue from the numbers already issued
+INSERT INTO invoice_sequences (id, last_number)
+SELECT 1, COALESCE(MAX(sequence_number), 0) FROM invoices;
+
+-- Comments
+COMMENT ON TABLE invoice_sequences IS '請求書の連番（1行のみ、発行時に行ロックして採番）';
+COMMENT ON COLUMN invoice_sequences.last_number IS '最後に採番した連番';
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
@@ -48,6 +49,11 @@ func setupBudgetTestDBSchema(t *testing.T, db *gorm.DB) {
 			budget_amount REAL,
 			start_date DATE,
 			end_date DATE,
+			default_billable BOOLEAN NOT NULL DEFAULT 1,
+			contract_type TEXT NOT NULL DEFAULT 'fixed_price',
+			retainer_fee REAL,
+			retainer_hours REAL,
+			retainer_rollover_months INTEGER NOT NULL DEFAULT 0,
 			created_at DATETIME,
 			updated_at DATETIME,
 			deleted_at DATETIME
@@ -80,6 +86,8 @@ func setupBudgetTestDBSchema(t *testing.T, db *gorm.DB) {
 			email TEXT NOT NULL,
 			role TEXT,
 			hourly_rate REAL DEFAULT 0,
+			bill_rate REAL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
 			department TEXT,
 			created_at DATETIME,
 			updated_at DATETIME,
@@ -96,7 +104,17 @@ func setupBudgetTestDBSchema(t *testing.T, db *gorm.DB) {
 			work_date DATE NOT NULL,
 			hours REAL NOT NULL,
 			hourly_rate_snapshot REAL,
+			rate_source TEXT NOT NULL DEFAULT 'member',
+			bill_rate_snapshot REAL,
+			is_billable BOOLEAN NOT NULL DEFAULT 1,
+			currency TEXT NOT NULL DEFAULT 'JPY',
 			comment TEXT,
+			invoice_id TEXT,
+			started_at DATETIME,
+			ended_at DATETIME,
+			timezone TEXT,
+			local_start_time TEXT,
+			local_end_time TEXT,
 			created_at DATETIME,
 			updated_at DATETIME
 		)
@@ -107,10 +125,13 @@ func setupBudgetTestDBSchema(t *testing.T, db *gorm.DB) {
 			id TEXT PRIMARY KEY,
 			project_id TEXT NOT NULL UNIQUE,
 			revenue REAL DEFAULT 0,
+			recognized_revenue REAL DEFAULT 0,
+			planned_revenue REAL DEFAULT 0,
 			total_cost REAL DEFAULT 0,
 			profit REAL DEFAULT 0,
 			profit_rate REAL DEFAULT 0,
 			currency TEXT NOT NULL DEFAULT 'JPY',
+			hours_basis TEXT NOT NULL DEFAULT 'all',
 			created_at DATETIME,
 			updated_at DATETIME
 		)
@@ -125,10 +146,293 @@ func setupBudgetTestDBSchema(t *testing.T, db *gorm.DB) {
 			left_at DATETIME,
 			allocation_rate REAL DEFAULT 1.0,
 			hourly_rate_snapshot REAL,
+			bill_rate REAL,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS expenses (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			category TEXT NOT NULL,
+			amount REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			vendor TEXT,
+			incurred_date DATE NOT NULL,
+			note TEXT,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS exchange_rates (
+			id TEXT PRIMARY KEY,
+			rate_date DATE NOT NULL,
+			from_currency TEXT NOT NULL,
+			to_currency TEXT NOT NULL,
+			rate REAL NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			UNIQUE (rate_date, from_currency, to_currency)
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS budget_snapshots (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			snapshot_date DATE NOT NULL,
+			source TEXT NOT NULL DEFAULT 'daily',
+			revenue REAL DEFAULT 0,
+			total_cost REAL DEFAULT 0,
+			profit REAL DEFAULT 0,
+			profit_rate REAL DEFAULT 0,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS alert_rules (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			metric TEXT NOT NULL,
+			threshold REAL NOT NULL,
+			is_enabled BOOLEAN NOT NULL DEFAULT 1,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS alerts (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			rule_id TEXT,
+			metric TEXT NOT NULL,
+			threshold REAL NOT NULL,
+			value REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			message TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'open',
+			triggered_at DATETIME NOT NULL,
+			acknowledged_at DATETIME,
+			resolved_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS revenue_items (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			task_id TEXT,
+			name TEXT NOT NULL,
+			planned_date DATE NOT NULL,
+			amount REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			status TEXT NOT NULL DEFAULT 'planned',
+			description TEXT,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS invoices (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			sequence_number INTEGER UNIQUE,
+			invoice_number TEXT UNIQUE,
+			status TEXT NOT NULL DEFAULT 'draft',
+			period_start DATE NOT NULL,
+			period_end DATE NOT NULL,
+			group_by TEXT NOT NULL DEFAULT 'member',
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			total_hours REAL NOT NULL DEFAULT 0,
+			total_amount REAL NOT NULL DEFAULT 0,
+			note TEXT,
+			issued_at DATETIME,
+			paid_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS invoice_sequences (
+			id INTEGER PRIMARY KEY,
+			last_number INTEGER NOT NULL DEFAULT 0
+		)
+	`).Error)
+	require.NoError(t, db.Exec(`INSERT INTO invoice_sequences (id, last_number) VALUES (1, 0)`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS invoice_lines (
+			id TEXT PRIMARY KEY,
+			invoice_id TEXT NOT NULL,
+			member_id TEXT,
+			task_id TEXT,
+			description TEXT NOT NULL,
+			hours REAL NOT NULL,
+			unit_price REAL NOT NULL,
+			amount REAL NOT NULL,
+			sort_order INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS member_rates (
+			id TEXT PRIMARY KEY,
+			member_id TEXT NOT NULL,
+			hourly_rate REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			effective_from DATE NOT NULL,
+			effective_to DATE,
+			created_at DATETIME,
+			updated_at DATETIME,
+			UNIQUE (member_id, effective_from)
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS department_rates (
+			id TEXT PRIMARY KEY,
+			department TEXT NOT NULL UNIQUE,
+			hourly_rate REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
 			created_at DATETIME,
 			updated_at DATETIME
 		)
 	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS holidays (
+			id TEXT PRIMARY KEY,
+			date DATE NOT NULL UNIQUE,
+			name TEXT NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS department_overheads (
+			id TEXT PRIMARY KEY,
+			department TEXT NOT NULL,
+			multiplier REAL NOT NULL,
+			effective_from DATE NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			UNIQUE (department, effective_from)
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS budget_lines (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			category TEXT NOT NULL,
+			planned_amount REAL NOT NULL DEFAULT 0,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS budget_line_links (
+			id TEXT PRIMARY KEY,
+			budget_line_id TEXT NOT NULL,
+			project_id TEXT NOT NULL,
+			task_id TEXT,
+			expense_category TEXT,
+			created_at DATETIME,
+			UNIQUE (project_id, task_id),
+			UNIQUE (project_id, expense_category)
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS change_orders (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			title TEXT NOT NULL,
+			reason TEXT NOT NULL,
+			budget_amount REAL,
+			revenue REAL,
+			end_date DATE,
+			cost_impact REAL NOT NULL DEFAULT 0,
+			status TEXT NOT NULL DEFAULT 'submitted',
+			requested_by TEXT NOT NULL,
+			reviewed_by TEXT,
+			reviewed_at DATETIME,
+			review_comment TEXT,
+			previous_budget_amount REAL,
+			previous_revenue REAL,
+			previous_end_date DATE,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS accounting_periods (
+			id TEXT PRIMARY KEY,
+			period_start DATE NOT NULL UNIQUE,
+			status TEXT NOT NULL DEFAULT 'open',
+			closed_by TEXT,
+			closed_at DATETIME,
+			reopened_by TEXT,
+			reopened_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS timers (
+			id TEXT PRIMARY KEY,
+			task_id TEXT NOT NULL,
+			member_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			started_at DATETIME NOT NULL,
+			stopped_at DATETIME,
+			auto_stopped INTEGER NOT NULL DEFAULT 0,
+			comment TEXT,
+			is_billable INTEGER,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+	require.NoError(t, db.Exec(`
+		CREATE UNIQUE INDEX IF NOT EXISTS timers_running_member_id_idx ON timers(member_id) WHERE stopped_at IS NULL
+	`).Error)
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS timesheets (
+			id TEXT PRIMARY KEY,
+			member_id TEXT NOT NULL,
+			week_start DATE NOT NULL,
+			week_end DATE NOT NULL,
+			status TEXT NOT NULL DEFAULT 'draft',
+			submitted_by TEXT,
+			submitted_at DATETIME,
+			reviewed_by TEXT,
+			reviewed_at DATETIME,
+			review_comment TEXT,
+			created_at DATETIME,
+			updated_at DATETIME,
+			UNIQUE (member_id, week_start)
+		)
+	`).Error)
 }
 
 func setupBudgetTestServer(t *testing.T) (*echo.Echo, *gorm.DB, *models.User, uuid.UUID) {
@@ -166,7 +470,7 @@ func setupBudgetTestServer(t *testing.T) (*echo.Echo, *gorm.DB, *models.User, uu
 		ID:         uuid.New(),
 		Name:       "テストメンバー",
 		Email:      "member@example.com",
-		HourlyRate: 5000,
+		HourlyRate: decimal.NewFromInt(5000),
 	}
 	require.NoError(t, db.Create(member).Error)
 
@@ -184,7 +488,7 @@ func setupBudgetTestServer(t *testing.T) (*echo.Echo, *gorm.DB, *models.User, uu
 	e.Validator = &testValidator{}
 
 	// Initialize service and handler
-	budgetService := service.NewBudgetService(db)
+	budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
 	budgetHandler := handler.NewBudgetHandler(budgetService)
 
 	// Register routes with user context middleware
@@ -198,6 +502,8 @@ func setupBudgetTestServer(t *testing.T) (*echo.Echo, *gorm.DB, *models.User, uu
 
 	// Budget routes
 	api.GET("/projects/:id/budget", budgetHandler.GetBudget)
+	api.GET("/projects/:id/budget/comparison", budgetHandler.GetBudgetComparison)
+	api.GET("/projects/:id/budget/history", budgetHandler.GetBudgetHistory)
 	api.PUT("/projects/:id/budget/revenue", budgetHandler.UpdateRevenue)
 
 	// Time entry routes
@@ -239,6 +545,26 @@ func TestBudgetAPI_GetBudget(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
+	t.Run("正常系: 期間を指定して予算を取得できる", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget?from=2024-01-01&to=2024-01-31", projectID), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("異常系: 無効な期間指定でエラー", func(t *testing.T) {
+		for _, query := range []string{"from=invalid", "to=2024-13-01", "from=2024-02-01&to=2024-01-01"} {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget?%s", projectID, query), nil)
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+		}
+	})
+
 	t.Run("異常系: 存在しないプロジェクトIDでエラー", func(t *testing.T) {
 		nonExistentID := uuid.New()
 		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget", nonExistentID), nil)
@@ -250,6 +576,96 @@ func TestBudgetAPI_GetBudget(t *testing.T) {
 	})
 }
 
+func TestBudgetAPI_GetBudgetComparison(t *testing.T) {
+	e, _, _, projectID := setupBudgetTestServer(t)
+
+	t.Run("正常系: 予算比較を取得できる", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget/comparison?as_of=2024-01-31", projectID), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response dto.Response
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+	})
+
+	t.Run("異常系: 無効な基準日でエラー", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget/comparison?as_of=invalid", projectID), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("異常系: 存在しないプロジェクトIDでエラー", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget/comparison", uuid.New()), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestBudgetAPI_GetBudgetHistory(t *testing.T) {
+	e, _, _, projectID := setupBudgetTestServer(t)
+
+	t.Run("正常系: 売上変更の履歴を取得できる", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{"revenue": 1000000})
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/projects/%s/budget/revenue", projectID), bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		e.ServeHTTP(httptest.NewRecorder(), req)
+
+		req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget/history?granularity=month", projectID), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Success bool                      `json:"success"`
+			Data    dto.BudgetHistoryResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		assert.Equal(t, "month", response.Data.Granularity)
+		require.Len(t, response.Data.Points, 1)
+		assert.True(t, decimal.NewFromInt(1000000).Equal(response.Data.Points[0].Revenue))
+	})
+
+	t.Run("異常系: 無効な集計単位でエラー", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget/history?granularity=year", projectID), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("異常系: 無効な期間でエラー", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget/history?from=invalid", projectID), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("異常系: 存在しないプロジェクトIDでエラー", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/projects/%s/budget/history", uuid.New()), nil)
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
 func TestBudgetAPI_UpdateRevenue(t *testing.T) {
 	e, _, _, projectID := setupBudgetTestServer(t)
 
@@ -318,10 +734,18 @@ func TestBudgetAPI_TimeEntries(t *testing.T) {
 	taskID := uuid.New()
 	memberID := uuid.New()
 
+	project := &models.Project{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Name:   "時間記録用プロジェクト",
+		Status: "in_progress",
+	}
+	require.NoError(t, db.Create(project).Error)
+
 	task := &models.Task{
 		ID:        taskID,
-		ProjectID: uuid.New(),
-		Name:     "時間記録用タスク",
+		ProjectID: project.ID,
+		Name:      "時間記録用タスク",
 		Status:    "in_progress",
 	}
 	require.NoError(t, db.Create(task).Error)
@@ -330,7 +754,7 @@ func TestBudgetAPI_TimeEntries(t *testing.T) {
 		ID:         memberID,
 		Name:       "時間記録用メンバー",
 		Email:      "timeentry@example.com",
-		HourlyRate: 6000,
+		HourlyRate: decimal.NewFromInt(6000),
 	}
 	require.NoError(t, db.Create(member).Error)
 
@@ -376,6 +800,27 @@ func TestBudgetAPI_TimeEntries(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 
+	t.Run("異常系: プロジェクトが存在しないタスクでエラー", func(t *testing.T) {
+		orphan := &models.Task{ID: uuid.New(), ProjectID: uuid.New(), Name: "孤立タスク", Status: "in_progress"}
+		require.NoError(t, db.Create(orphan).Error)
+
+		reqBody := map[string]interface{}{
+			"task_id":   orphan.ID.String(),
+			"member_id": memberID.String(),
+			"work_date": "2024-01-15",
+			"hours":     8,
+		}
+		body, _ := json.Marshal(reqBody)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/time-entries", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
 	t.Run("異常系: 存在しないメンバーIDでエラー", func(t *testing.T) {
 		reqBody := map[string]interface{}{
 			"task_id":   taskID.String(),
@@ -425,7 +870,7 @@ func TestBudgetAPI_ListTimeEntries(t *testing.T) {
 		ID:         memberID,
 		Name:       "リスト用メンバー",
 		Email:      "list@example.com",
-		HourlyRate: 5000,
+		HourlyRate: decimal.NewFromInt(5000),
 	}
 	db.Create(member)
 
@@ -439,14 +884,14 @@ func TestBudgetAPI_ListTimeEntries(t *testing.T) {
 
 	// 時間エントリを複数作成
 	for i := 0; i < 5; i++ {
-		rate := 5000.0
+		rate := decimal.NewFromInt(5000)
 		entry := &models.TimeEntry{
 			ID:                 uuid.New(),
 			TaskID:             taskID,
 			MemberID:           memberID,
 			UserID:             userID,
 			WorkDate:           parseDate(t, fmt.Sprintf("2024-01-%02d", 10+i)),
-			Hours:              float64(i + 1),
+			Hours:              decimal.NewFromInt(int64(i + 1)),
 			HourlyRateSnapshot: &rate,
 		}
 		db.Create(entry)
@@ -511,12 +956,12 @@ func TestBudgetAPI_GetTimeEntry(t *testing.T) {
 	taskID := uuid.New()
 	memberID := uuid.New()
 	userID := uuid.New()
-	rate := 5000.0
+	rate := decimal.NewFromInt(5000)
 
 	task := &models.Task{ID: taskID, Name: "Get用タスク", Status: "in_progress", ProjectID: uuid.New()}
 	db.Create(task)
 
-	member := &models.Member{ID: memberID, Name: "Get用メンバー", Email: "get@example.com", HourlyRate: 5000}
+	member := &models.Member{ID: memberID, Name: "Get用メンバー", Email: "get@example.com", HourlyRate: decimal.NewFromInt(5000)}
 	db.Create(member)
 
 	user := &models.User{ID: userID, Email: "getuser@example.com", PasswordHash: "hash", Name: "Get User"}
@@ -528,7 +973,7 @@ func TestBudgetAPI_GetTimeEntry(t *testing.T) {
 		MemberID:           memberID,
 		UserID:             userID,
 		WorkDate:           parseDate(t, "2024-01-15"),
-		Hours:              8,
+		Hours:              decimal.NewFromInt(8),
 		HourlyRateSnapshot: &rate,
 	}
 	require.NoError(t, db.Create(entry).Error)
@@ -574,12 +1019,12 @@ func TestBudgetAPI_UpdateTimeEntry(t *testing.T) {
 	taskID := uuid.New()
 	memberID := uuid.New()
 	userID := uuid.New()
-	rate := 5000.0
+	rate := decimal.NewFromInt(5000)
 
 	task := &models.Task{ID: taskID, Name: "Update用タスク", Status: "in_progress", ProjectID: uuid.New()}
 	db.Create(task)
 
-	member := &models.Member{ID: memberID, Name: "Update用メンバー", Email: "update@example.com", HourlyRate: 5000}
+	member := &models.Member{ID: memberID, Name: "Update用メンバー", Email: "update@example.com", HourlyRate: decimal.NewFromInt(5000)}
 	db.Create(member)
 
 	user := &models.User{ID: userID, Email: "updateuser@example.com", PasswordHash: "hash", Name: "Update User"}
@@ -591,7 +1036,7 @@ func TestBudgetAPI_UpdateTimeEntry(t *testing.T) {
 		MemberID:           memberID,
 		UserID:             userID,
 		WorkDate:           parseDate(t, "2024-01-15"),
-		Hours:              8,
+		Hours:              decimal.NewFromInt(8),
 		HourlyRateSnapshot: &rate,
 	}
 	require.NoError(t, db.Create(entry).Error)
@@ -675,12 +1120,12 @@ func TestBudgetAPI_DeleteTimeEntry(t *testing.T) {
 	taskID := uuid.New()
 	memberID := uuid.New()
 	userID := uuid.New()
-	rate := 5000.0
+	rate := decimal.NewFromInt(5000)
 
 	task := &models.Task{ID: taskID, Name: "Delete用タスク", Status: "in_progress", ProjectID: uuid.New()}
 	db.Create(task)
 
-	member := &models.Member{ID: memberID, Name: "Delete用メンバー", Email: "delete@example.com", HourlyRate: 5000}
+	member := &models.Member{ID: memberID, Name: "Delete用メンバー", Email: "delete@example.com", HourlyRate: decimal.NewFromInt(5000)}
 	db.Create(member)
 
 	user := &models.User{ID: userID, Email: "deleteuser@example.com", PasswordHash: "hash", Name: "Delete User"}
@@ -694,7 +1139,7 @@ func TestBudgetAPI_DeleteTimeEntry(t *testing.T) {
 			MemberID:           memberID,
 			UserID:             userID,
 			WorkDate:           parseDate(t, "2024-01-15"),
-			Hours:              8,
+			Hours:              decimal.NewFromInt(8),
 			HourlyRateSnapshot: &rate,
 		}
 		require.NoError(t, db.Create(entry).Error)
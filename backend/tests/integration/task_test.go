@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
@@ -115,8 +116,8 @@ func TestTaskAPI_GetTask(t *testing.T) {
 		ID:           uuid.New(),
 		ProjectID:    project.ID,
 		Name:         "テストタスク",
-		PlannedHours: 10.0,
-		ActualHours:  5.0,
+		PlannedHours: decimal.NewFromInt(10),
+		ActualHours:  decimal.NewFromInt(5),
 		Status:       "in_progress",
 	}
 	require.NoError(t, db.Create(task).Error)
@@ -154,7 +155,7 @@ func TestTaskAPI_ListTasks(t *testing.T) {
 			ID:           uuid.New(),
 			ProjectID:    project.ID,
 			Name:         "タスク" + string(rune('A'+i)),
-			PlannedHours: float64(i * 2),
+			PlannedHours: decimal.NewFromInt(int64(i * 2)),
 			Status:       "todo",
 		}
 		require.NoError(t, db.Create(task).Error)
@@ -192,8 +193,8 @@ func TestTaskAPI_UpdateTask(t *testing.T) {
 		ID:           uuid.New(),
 		ProjectID:    project.ID,
 		Name:         "更新テスト用タスク",
-		PlannedHours: 10.0,
-		ActualHours:  0.0,
+		PlannedHours: decimal.NewFromInt(10),
+		ActualHours:  decimal.NewFromInt(0),
 		Status:       "todo",
 	}
 	require.NoError(t, db.Create(task).Error)
@@ -256,10 +257,10 @@ func TestTaskAPI_GetProjectSummary(t *testing.T) {
 
 	// Create multiple tasks
 	tasks := []*models.Task{
-		{ID: uuid.New(), ProjectID: project.ID, Name: "完了タスク1", PlannedHours: 10.0, ActualHours: 8.0, Status: "completed"},
-		{ID: uuid.New(), ProjectID: project.ID, Name: "完了タスク2", PlannedHours: 20.0, ActualHours: 25.0, Status: "completed"},
-		{ID: uuid.New(), ProjectID: project.ID, Name: "進行中タスク", PlannedHours: 15.0, ActualHours: 10.0, Status: "in_progress"},
-		{ID: uuid.New(), ProjectID: project.ID, Name: "未着手タスク", PlannedHours: 5.0, ActualHours: 0.0, Status: "todo"},
+		{ID: uuid.New(), ProjectID: project.ID, Name: "完了タスク1", PlannedHours: decimal.NewFromInt(10), ActualHours: decimal.NewFromInt(8), Status: "completed"},
+		{ID: uuid.New(), ProjectID: project.ID, Name: "完了タスク2", PlannedHours: decimal.NewFromInt(20), ActualHours: decimal.NewFromInt(25), Status: "completed"},
+		{ID: uuid.New(), ProjectID: project.ID, Name: "進行中タスク", PlannedHours: decimal.NewFromInt(15), ActualHours: decimal.NewFromInt(10), Status: "in_progress"},
+		{ID: uuid.New(), ProjectID: project.ID, Name: "未着手タスク", PlannedHours: decimal.NewFromInt(5), ActualHours: decimal.NewFromInt(0), Status: "todo"},
 	}
 
 	for _, task := range tasks {
@@ -0,0 +1,65 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/money"
+)
+
+func TestRound(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, money.Configure(money.RoundHalfUp, nil)) })
+
+	tests := []struct {
+		name     string
+		mode     money.RoundingMode
+		amount   string
+		currency string
+		want     string
+	}{
+		{"正常: JPYは整数に四捨五入", money.RoundHalfUp, "1234.5", "JPY", "1235"},
+		{"正常: USDは小数2桁に四捨五入", money.RoundHalfUp, "10.125", "USD", "10.13"},
+		{"正常: KWDは小数3桁", money.RoundHalfUp, "1.23456", "KWD", "1.235"},
+		{"正常: 未登録通貨は小数2桁", money.RoundHalfUp, "1.005", "XXX", "1.01"},
+		{"正常: 銀行丸め", money.RoundHalfEven, "1234.5", "JPY", "1234"},
+		{"正常: 切り捨て", money.RoundDown, "1234.9", "JPY", "1234"},
+		{"正常: 切り上げ", money.RoundUp, "1234.1", "JPY", "1235"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, money.Configure(tt.mode, nil))
+			got := money.Round(decimal.RequireFromString(tt.amount), tt.currency)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, money.Configure(money.RoundHalfUp, nil)) })
+
+	overrides, err := money.ParseMinorUnits("jpy:2, USD:0")
+	require.NoError(t, err)
+	require.NoError(t, money.Configure(money.RoundHalfUp, overrides))
+
+	assert.Equal(t, int32(2), money.MinorUnits("JPY"))
+	assert.Equal(t, int32(0), money.MinorUnits("USD"))
+
+	assert.Error(t, money.Configure("nearest", nil))
+	_, err = money.ParseMinorUnits("JPY")
+	assert.Error(t, err)
+}
+
+func TestNoFloatDrift(t *testing.T) {
+	// 0.1h × 3,333円 を 10,000 件積み上げても誤差が出ない
+	total := decimal.Zero
+	hours := decimal.RequireFromString("0.1")
+	rate := decimal.NewFromInt(3333)
+	for i := 0; i < 10000; i++ {
+		total = total.Add(hours.Mul(rate))
+	}
+	assert.Equal(t, "3333000", money.Round(total, "JPY").String())
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// assertPeriodClosed は締め済み期間のエラーであることを確認する
+func assertPeriodClosed(t *testing.T, err error) {
+	t.Helper()
+	require.Error(t, err)
+	appErr, ok := err.(*apperrors.AppError)
+	require.True(t, ok)
+	assert.Equal(t, "PERIOD_CLOSED", appErr.Code)
+}
+
+func TestAccountingPeriodService_CloseAndReopen(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	svc := service.NewAccountingPeriodService(db)
+	adminID := uuid.New()
+
+	t.Run("正常: 締めと締め解除", func(t *testing.T) {
+		closed, err := svc.CloseAccountingPeriod("2024-01", adminID)
+		require.NoError(t, err)
+		assert.Equal(t, models.AccountingPeriodStatusClosed, closed.Status)
+		assert.Equal(t, "2024-01-01", closed.PeriodStart)
+		assert.Equal(t, "2024-01-31", closed.PeriodEnd)
+		assert.Equal(t, adminID, *closed.ClosedBy)
+
+		reopened, err := svc.ReopenAccountingPeriod("2024-01", adminID)
+		require.NoError(t, err)
+		assert.Equal(t, models.AccountingPeriodStatusOpen, reopened.Status)
+		assert.Equal(t, closed.ID, reopened.ID)
+
+		// 再度締められる
+		_, err = svc.CloseAccountingPeriod("2024-01", adminID)
+		require.NoError(t, err)
+
+		periods, err := svc.ListAccountingPeriods()
+		require.NoError(t, err)
+		require.Len(t, periods.Periods, 1)
+		assert.Equal(t, "2024-01", periods.Periods[0].Period)
+	})
+
+	t.Run("異常: 締め済みの期間を再度締める", func(t *testing.T) {
+		_, err := svc.CloseAccountingPeriod("2024-01", adminID)
+		require.Error(t, err)
+	})
+
+	t.Run("異常: 締めていない期間の締め解除", func(t *testing.T) {
+		_, err := svc.ReopenAccountingPeriod("2024-02", adminID)
+		require.Error(t, err)
+	})
+
+	t.Run("異常: 不正な月の形式", func(t *testing.T) {
+		_, err := svc.CloseAccountingPeriod("2024-01-01", adminID)
+		require.Error(t, err)
+	})
+}
+
+func TestAccountingPeriodService_LocksClosedPeriod(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+	member := createTestMember(t, db)
+	budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	expenseService := service.NewExpenseService(db, service.DefaultDailyHoursLimit())
+	revenueItemService := service.NewRevenueItemService(db, service.DefaultDailyHoursLimit())
+	periodService := service.NewAccountingPeriodService(db)
+
+	januaryEntry, err := budgetService.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+		TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-10", Hours: decimal.NewFromInt(8),
+	})
+	require.NoError(t, err)
+	februaryEntry, err := budgetService.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+		TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-02-01", Hours: decimal.NewFromInt(4),
+	})
+	require.NoError(t, err)
+	expense := createTestExpense(t, db, project.ID, "travel", 30000)
+	revenueItem, err := revenueItemService.CreateRevenueItem(project.ID, &dto.CreateRevenueItemRequest{
+		Name: "1月納品", PlannedDate: "2024-01-31", Amount: decimal.NewFromInt(500000),
+	})
+	require.NoError(t, err)
+
+	_, err = periodService.CloseAccountingPeriod("2024-01", uuid.New())
+	require.NoError(t, err)
+
+	t.Run("異常: 締め済み期間の工数は作成・更新・削除できない", func(t *testing.T) {
+		_, err := budgetService.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-31", Hours: decimal.NewFromInt(1),
+		})
+		assertPeriodClosed(t, err)
+
+		hours := decimal.NewFromInt(10)
+		_, err = budgetService.UpdateTimeEntry(januaryEntry.ID, &dto.UpdateTimeEntryRequest{Hours: &hours})
+		assertPeriodClosed(t, err)
+
+		assertPeriodClosed(t, budgetService.DeleteTimeEntry(januaryEntry.ID))
+
+		// 未締めの期間から締め済み期間への移動もできない
+		workDate := "2024-01-15"
+		_, err = budgetService.UpdateTimeEntry(februaryEntry.ID, &dto.UpdateTimeEntryRequest{WorkDate: &workDate})
+		assertPeriodClosed(t, err)
+	})
+
+	t.Run("異常: 締め済み期間の経費・売上は作成・更新・削除できない", func(t *testing.T) {
+		_, err := expenseService.CreateExpense(project.ID, uuid.New(), &dto.CreateExpenseRequest{
+			Category: "travel", Amount: decimal.NewFromInt(1000), IncurredDate: "2024-01-20",
+		})
+		assertPeriodClosed(t, err)
+
+		amount := decimal.NewFromInt(40000)
+		_, err = expenseService.UpdateExpense(project.ID, expense.ID, &dto.UpdateExpenseRequest{Amount: &amount})
+		assertPeriodClosed(t, err)
+		assertPeriodClosed(t, expenseService.DeleteExpense(project.ID, expense.ID))
+
+		_, err = revenueItemService.CreateRevenueItem(project.ID, &dto.CreateRevenueItemRequest{
+			Name: "追加納品", PlannedDate: "2024-01-15", Amount: decimal.NewFromInt(100000),
+		})
+		assertPeriodClosed(t, err)
+		assertPeriodClosed(t, revenueItemService.DeleteRevenueItem(project.ID, revenueItem.ID))
+	})
+
+	t.Run("正常: 未締めの期間は変更できる", func(t *testing.T) {
+		hours := decimal.NewFromInt(6)
+		updated, err := budgetService.UpdateTimeEntry(februaryEntry.ID, &dto.UpdateTimeEntryRequest{Hours: &hours})
+		require.NoError(t, err)
+		assertDecimal(t, 6.0, updated.Hours)
+	})
+
+	t.Run("正常: 締め解除後は変更できる", func(t *testing.T) {
+		_, err := periodService.ReopenAccountingPeriod("2024-01", uuid.New())
+		require.NoError(t, err)
+
+		require.NoError(t, budgetService.DeleteTimeEntry(januaryEntry.ID))
+		require.NoError(t, expenseService.DeleteExpense(project.ID, expense.ID))
+	})
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// createAlertTestProject は予算額を設定したテスト用プロジェクトを作成
+func createAlertTestProject(t *testing.T, db *gorm.DB, budgetAmount int64) *models.Project {
+	amount := decimal.NewFromInt(budgetAmount)
+	project := &models.Project{
+		ID:           uuid.New(),
+		UserID:       uuid.New(),
+		Name:         "アラート用プロジェクト",
+		Status:       "in_progress",
+		BudgetAmount: &amount,
+	}
+	require.NoError(t, db.Create(project).Error)
+	return project
+}
+
+func TestAlertService_CreateAlertRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *dto.CreateAlertRuleRequest
+		existing bool
+		wantErr  bool
+	}{
+		{
+			name:     "正常: コスト比率のルールを作成できる",
+			req:      &dto.CreateAlertRuleRequest{Metric: models.AlertMetricCostRatio, Threshold: decimal.NewFromInt(80)},
+			existing: true,
+		},
+		{
+			name:     "正常: 利益率のしきい値は負の値も指定できる",
+			req:      &dto.CreateAlertRuleRequest{Metric: models.AlertMetricProfitRate, Threshold: decimal.NewFromInt(-10)},
+			existing: true,
+		},
+		{
+			name:     "異常: バーンレートのしきい値が負の値",
+			req:      &dto.CreateAlertRuleRequest{Metric: models.AlertMetricWeeklyBurnRate, Threshold: decimal.NewFromInt(-1)},
+			existing: true,
+			wantErr:  true,
+		},
+		{
+			name:     "異常: 存在しないプロジェクト",
+			req:      &dto.CreateAlertRuleRequest{Metric: models.AlertMetricCostRatio, Threshold: decimal.NewFromInt(80)},
+			existing: false,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupBudgetTestDB(t)
+			projectID := uuid.New()
+			if tt.existing {
+				projectID = createTestProject(t, db).ID
+			}
+
+			svc := service.NewAlertService(db)
+			result, err := svc.CreateAlertRule(projectID, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.req.Metric, result.Metric)
+			assertDecimal(t, tt.req.Threshold.InexactFloat64(), result.Threshold)
+			assert.True(t, result.IsEnabled)
+		})
+	}
+}
+
+func TestAlertService_UpdateAndDeleteAlertRule(t *testing.T) {
+	t.Run("正常: ルールを無効化できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		svc := service.NewAlertService(db)
+
+		rule, err := svc.CreateAlertRule(project.ID, &dto.CreateAlertRuleRequest{
+			Metric:    models.AlertMetricCostRatio,
+			Threshold: decimal.NewFromInt(80),
+		})
+		require.NoError(t, err)
+
+		disabled := false
+		updated, err := svc.UpdateAlertRule(project.ID, rule.ID, &dto.UpdateAlertRuleRequest{IsEnabled: &disabled})
+		require.NoError(t, err)
+		assert.False(t, updated.IsEnabled)
+
+		rules, err := svc.ListAlertRules(project.ID)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.False(t, rules[0].IsEnabled)
+	})
+
+	t.Run("異常: 別プロジェクトのルールは更新・削除できない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		other := createTestProject(t, db)
+		svc := service.NewAlertService(db)
+
+		rule, err := svc.CreateAlertRule(project.ID, &dto.CreateAlertRuleRequest{
+			Metric:    models.AlertMetricCostRatio,
+			Threshold: decimal.NewFromInt(80),
+		})
+		require.NoError(t, err)
+
+		threshold := decimal.NewFromInt(90)
+		_, err = svc.UpdateAlertRule(other.ID, rule.ID, &dto.UpdateAlertRuleRequest{Threshold: &threshold})
+		assert.Error(t, err)
+		assert.Error(t, svc.DeleteAlertRule(other.ID, rule.ID))
+	})
+
+	t.Run("正常: ルールを削除しても発生済みのアラートは残る", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createAlertTestProject(t, db, 100000)
+		svc := service.NewAlertService(db)
+
+		rule, err := svc.CreateAlertRule(project.ID, &dto.CreateAlertRuleRequest{
+			Metric:    models.AlertMetricCostRatio,
+			Threshold: decimal.NewFromInt(50),
+		})
+		require.NoError(t, err)
+
+		costRatio := decimal.NewFromInt(60)
+		triggered, err := svc.EvaluateAlerts(project.ID, service.AlertMetrics{Currency: "JPY", CostRatio: &costRatio})
+		require.NoError(t, err)
+		require.Len(t, triggered, 1)
+
+		require.NoError(t, svc.DeleteAlertRule(project.ID, rule.ID))
+
+		alerts, err := svc.ListAlerts(repository.AlertListParams{ProjectID: project.ID})
+		require.NoError(t, err)
+		require.Len(t, alerts.Alerts, 1)
+		assert.Nil(t, alerts.Alerts[0].RuleID)
+		assert.Equal(t, models.AlertMetricCostRatio, alerts.Alerts[0].Metric)
+		// 評価されなくなるアラートは解決済みにする
+		assert.Equal(t, models.AlertStatusResolved, alerts.Alerts[0].Status)
+		assert.NotNil(t, alerts.Alerts[0].ResolvedAt)
+	})
+
+	t.Run("正常: ルールを無効化すると発生中のアラートを解決する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createAlertTestProject(t, db, 100000)
+		svc := service.NewAlertService(db)
+
+		rule, err := svc.CreateAlertRule(project.ID, &dto.CreateAlertRuleRequest{
+			Metric:    models.AlertMetricCostRatio,
+			Threshold: decimal.NewFromInt(50),
+		})
+		require.NoError(t, err)
+
+		costRatio := decimal.NewFromInt(60)
+		triggered, err := svc.EvaluateAlerts(project.ID, service.AlertMetrics{Currency: "JPY", CostRatio: &costRatio})
+		require.NoError(t, err)
+		require.Len(t, triggered, 1)
+
+		disabled := false
+		_, err = svc.UpdateAlertRule(project.ID, rule.ID, &dto.UpdateAlertRuleRequest{IsEnabled: &disabled})
+		require.NoError(t, err)
+
+		alerts, err := svc.ListAlerts(repository.AlertListParams{ProjectID: project.ID})
+		require.NoError(t, err)
+		require.Len(t, alerts.Alerts, 1)
+		assert.Equal(t, models.AlertStatusResolved, alerts.Alerts[0].Status)
+		assert.Equal(t, &rule.ID, alerts.Alerts[0].RuleID)
+	})
+}
+
+func TestAlertService_EvaluateAlerts(t *testing.T) {
+	t.Run("正常: 工数の登録でコスト比率のアラートが発生し、削除で解消される", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createAlertTestProject(t, db, 100000)
+		task := createTestTask(t, db, project.ID)
+		member := createTestMember(t, db)
+
+		alertService := service.NewAlertService(db)
+		_, err := alertService.CreateAlertRule(project.ID, &dto.CreateAlertRuleRequest{
+			Metric:    models.AlertMetricCostRatio,
+			Threshold: decimal.NewFromInt(50),
+		})
+		require.NoError(t, err)
+
+		budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		createEntry := func() *dto.TimeEntryResponse {
+			// 8時間 × 5,000円 = 40,000円
+			entry, err := budgetService.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+				TaskID:   task.ID,
+				MemberID: member.ID,
+				WorkDate: "2024-01-15",
+				Hours:    decimal.NewFromInt(8),
+			})
+			require.NoError(t, err)
+			return entry
+		}
+		listAlerts := func() []dto.AlertResponse {
+			result, err := alertService.ListAlerts(repository.AlertListParams{ProjectID: project.ID})
+			require.NoError(t, err)
+			return result.Alerts
+		}
+
+		// 40% ではしきい値未満
+		createEntry()
+		assert.Empty(t, listAlerts())
+
+		// 80% でアラートが発生
+		second := createEntry()
+		alerts := listAlerts()
+		require.Len(t, alerts, 1)
+		assert.Equal(t, models.AlertStatusOpen, alerts[0].Status)
+		assertDecimal(t, 80.0, alerts[0].Value)
+		assertDecimal(t, 50.0, alerts[0].Threshold)
+		assert.NotEmpty(t, alerts[0].Message)
+
+		// 条件を満たし続けている間は重複して発生しない
+		third := createEntry()
+		assert.Len(t, listAlerts(), 1)
+
+		// しきい値を下回ると自動で解消される
+		require.NoError(t, budgetService.DeleteTimeEntry(third.ID))
+		require.NoError(t, budgetService.DeleteTimeEntry(second.ID))
+		alerts = listAlerts()
+		require.Len(t, alerts, 1)
+		assert.Equal(t, models.AlertStatusResolved, alerts[0].Status)
+		assert.NotNil(t, alerts[0].ResolvedAt)
+	})
+
+	t.Run("正常: 売上の更新で利益率のアラートが発生する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		createTestExpense(t, db, project.ID, "license", 90000)
+
+		alertService := service.NewAlertService(db)
+		_, err := alertService.CreateAlertRule(project.ID, &dto.CreateAlertRuleRequest{
+			Metric:    models.AlertMetricProfitRate,
+			Threshold: decimal.NewFromInt(20),
+		})
+		require.NoError(t, err)
+
+		budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+
+		// 売上未設定の間は利益率を評価しない
+		triggered, err := budgetService.EvaluateAlerts(project.ID)
+		require.NoError(t, err)
+		assert.Empty(t, triggered)
+
+		// 売上 100,000円、コスト 90,000円 → 利益率 10%
+		_, err = budgetService.UpdateRevenue(project.ID, &dto.UpdateRevenueRequest{Revenue: decimal.NewFromInt(100000)})
+		require.NoError(t, err)
+
+		result, err := alertService.ListAlerts(repository.AlertListParams{ProjectID: project.ID, Status: models.AlertStatusOpen})
+		require.NoError(t, err)
+		require.Len(t, result.Alerts, 1)
+		assert.Equal(t, models.AlertMetricProfitRate, result.Alerts[0].Metric)
+		assertDecimal(t, 10.0, result.Alerts[0].Value)
+	})
+
+	t.Run("正常: 無効なルールは評価しない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		svc := service.NewAlertService(db)
+
+		disabled := false
+		_, err := svc.CreateAlertRule(project.ID, &dto.CreateAlertRuleRequest{
+			Metric:    models.AlertMetricWeeklyBurnRate,
+			Threshold: decimal.NewFromInt(1000),
+			IsEnabled: &disabled,
+		})
+		require.NoError(t, err)
+
+		triggered, err := svc.EvaluateAlerts(project.ID, service.AlertMetrics{
+			Currency:       "JPY",
+			WeeklyBurnRate: decimal.NewFromInt(5000),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, triggered)
+	})
+}
+
+func TestAlertService_AcknowledgeAndResolveAlert(t *testing.T) {
+	setup := func(t *testing.T) (*service.AlertService, uuid.UUID, uuid.UUID) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		svc := service.NewAlertService(db)
+
+		_, err := svc.CreateAlertRule(project.ID, &dto.CreateAlertRuleRequest{
+			Metric:    models.AlertMetricWeeklyBurnRate,
+			Threshold: decimal.NewFromInt(1000),
+		})
+		require.NoError(t, err)
+
+		triggered, err := svc.EvaluateAlerts(project.ID, service.AlertMetrics{
+			Currency:       "JPY",
+			WeeklyBurnRate: decimal.NewFromInt(5000),
+		})
+		require.NoError(t, err)
+		require.Len(t, triggered, 1)
+		return svc, project.ID, triggered[0].ID
+	}
+
+	t.Run("正常: 確認済みにしてから解決できる", func(t *testing.T) {
+		svc, projectID, alertID := setup(t)
+
+		acknowledged, err := svc.AcknowledgeAlert(projectID, alertID)
+		require.NoError(t, err)
+		assert.Equal(t, models.AlertStatusAcknowledged, acknowledged.Status)
+		assert.NotNil(t, acknowledged.AcknowledgedAt)
+
+		resolved, err := svc.ResolveAlert(projectID, alertID)
+		require.NoError(t, err)
+		assert.Equal(t, models.AlertStatusResolved, resolved.Status)
+		assert.NotNil(t, resolved.ResolvedAt)
+	})
+
+	t.Run("異常: 解決済みのアラートは確認・解決できない", func(t *testing.T) {
+		svc, projectID, alertID := setup(t)
+
+		_, err := svc.ResolveAlert(projectID, alertID)
+		require.NoError(t, err)
+
+		_, err = svc.AcknowledgeAlert(projectID, alertID)
+		require.Error(t, err)
+		appErr, ok := err.(*apperrors.AppError)
+		require.True(t, ok)
+		assert.Equal(t, "CONFLICT", appErr.Code)
+
+		_, err = svc.ResolveAlert(projectID, alertID)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常: 別プロジェクトのアラートは見つからない", func(t *testing.T) {
+		svc, _, alertID := setup(t)
+
+		_, err := svc.AcknowledgeAlert(uuid.New(), alertID)
+		assert.Error(t, err)
+	})
+}
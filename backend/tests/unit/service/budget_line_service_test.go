@@ -0,0 +1,155 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+func TestBudgetLineService(t *testing.T) {
+	t.Run("正常: 予算明細を作成・更新・削除できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		design := createTestTask(t, db, project.ID)
+		development := createTestTask(t, db, project.ID)
+		svc := service.NewBudgetLineService(db)
+
+		line, err := svc.CreateBudgetLine(project.ID, &dto.CreateBudgetLineRequest{
+			Name:              "設計",
+			Category:          "設計",
+			PlannedAmount:     decimal.NewFromInt(500000),
+			TaskIDs:           []uuid.UUID{design.ID, design.ID},
+			ExpenseCategories: []string{"license"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{design.ID}, line.TaskIDs)
+		assert.Equal(t, []string{"license"}, line.ExpenseCategories)
+
+		// 指定したリンクだけが置き換わり、経費区分はそのまま
+		taskIDs := []uuid.UUID{development.ID}
+		planned := decimal.NewFromInt(800000)
+		updated, err := svc.UpdateBudgetLine(project.ID, line.ID, &dto.UpdateBudgetLineRequest{
+			PlannedAmount: &planned,
+			TaskIDs:       &taskIDs,
+		})
+		require.NoError(t, err)
+		assertDecimal(t, 800000.0, updated.PlannedAmount)
+		assert.Equal(t, []uuid.UUID{development.ID}, updated.TaskIDs)
+		assert.Equal(t, []string{"license"}, updated.ExpenseCategories)
+
+		list, err := svc.ListBudgetLines(project.ID)
+		require.NoError(t, err)
+		require.Len(t, list.Lines, 1)
+
+		require.NoError(t, svc.DeleteBudgetLine(project.ID, line.ID))
+		_, err = svc.GetBudgetLine(project.ID, line.ID)
+		require.Error(t, err)
+	})
+
+	t.Run("異常: タスクと経費区分は1つの明細にしか紐づけられない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+		svc := service.NewBudgetLineService(db)
+
+		_, err := svc.CreateBudgetLine(project.ID, &dto.CreateBudgetLineRequest{
+			Name: "設計", Category: "設計", PlannedAmount: decimal.NewFromInt(100000),
+			TaskIDs: []uuid.UUID{task.ID}, ExpenseCategories: []string{"travel"},
+		})
+		require.NoError(t, err)
+
+		_, err = svc.CreateBudgetLine(project.ID, &dto.CreateBudgetLineRequest{
+			Name: "開発", Category: "開発", PlannedAmount: decimal.NewFromInt(100000),
+			TaskIDs: []uuid.UUID{task.ID},
+		})
+		require.Error(t, err)
+		_, err = svc.CreateBudgetLine(project.ID, &dto.CreateBudgetLineRequest{
+			Name: "出張", Category: "経費", PlannedAmount: decimal.NewFromInt(100000),
+			ExpenseCategories: []string{"travel"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("異常: 他プロジェクトのタスクは紐づけられない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		other := createTestProject(t, db)
+		task := createTestTask(t, db, other.ID)
+		svc := service.NewBudgetLineService(db)
+
+		_, err := svc.CreateBudgetLine(project.ID, &dto.CreateBudgetLineRequest{
+			Name: "設計", Category: "設計", PlannedAmount: decimal.NewFromInt(100000),
+			TaskIDs: []uuid.UUID{task.ID},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestBudgetService_GetBudgetSummary_BudgetLines(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	member := createTestMember(t, db)
+	design := createTestTask(t, db, project.ID)
+	development := createTestTask(t, db, project.ID)
+	unlinked := createTestTask(t, db, project.ID)
+
+	lineSvc := service.NewBudgetLineService(db)
+	_, err := lineSvc.CreateBudgetLine(project.ID, &dto.CreateBudgetLineRequest{
+		Name: "設計", Category: "設計", PlannedAmount: decimal.NewFromInt(40000),
+		TaskIDs: []uuid.UUID{design.ID},
+	})
+	require.NoError(t, err)
+	_, err = lineSvc.CreateBudgetLine(project.ID, &dto.CreateBudgetLineRequest{
+		Name: "開発", Category: "開発", PlannedAmount: decimal.NewFromInt(100000),
+		TaskIDs: []uuid.UUID{development.ID}, ExpenseCategories: []string{"license"},
+	})
+	require.NoError(t, err)
+
+	svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	for _, req := range []*dto.CreateTimeEntryRequest{
+		{TaskID: design.ID, MemberID: member.ID, WorkDate: "2024-01-10", Hours: decimal.NewFromInt(10)},
+		{TaskID: development.ID, MemberID: member.ID, WorkDate: "2024-01-11", Hours: decimal.NewFromInt(8)},
+		{TaskID: unlinked.ID, MemberID: member.ID, WorkDate: "2024-01-12", Hours: decimal.NewFromInt(2)},
+	} {
+		_, err := svc.CreateTimeEntry(uuid.New(), req)
+		require.NoError(t, err)
+	}
+	createTestExpense(t, db, project.ID, "license", 20000)
+	createTestExpense(t, db, project.ID, "travel", 5000)
+
+	summary, err := svc.GetBudgetSummary(project.ID, repository.DateRange{})
+	require.NoError(t, err)
+
+	lines := summary.BudgetLines.Lines
+	require.Len(t, lines, 2)
+	byName := map[string]dto.BudgetLineVarianceResponse{}
+	for _, line := range lines {
+		byName[line.Name] = line
+	}
+
+	// 設計: 10時間 × 5,000円 = 50,000円で予算超過
+	designLine := byName["設計"]
+	assertDecimal(t, 50000.0, designLine.ActualCost)
+	assertDecimal(t, -10000.0, designLine.Variance)
+	assert.Equal(t, -25.0, designLine.VarianceRate)
+	assert.True(t, designLine.IsOverBudget)
+
+	// 開発: 8時間 × 5,000円 + ライセンス 20,000円 = 60,000円で予算内
+	developmentLine := byName["開発"]
+	assertDecimal(t, 40000.0, developmentLine.LaborCost)
+	assertDecimal(t, 20000.0, developmentLine.ExpenseCost)
+	assertDecimal(t, 40000.0, developmentLine.Variance)
+	assert.False(t, developmentLine.IsOverBudget)
+
+	assertDecimal(t, 140000.0, summary.BudgetLines.PlannedAmount)
+	assertDecimal(t, 110000.0, summary.BudgetLines.ActualCost)
+	// 紐づけのないタスク 10,000円と出張費 5,000円
+	assertDecimal(t, 15000.0, summary.BudgetLines.UnallocatedCost)
+}
@@ -2,8 +2,10 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
@@ -11,7 +13,9 @@ import (
 	"gorm.io/gorm/logger"
 
 	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
 	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
 	"github.com/your-org/project-budget-tracker/backend/internal/service"
 )
 
@@ -46,6 +50,11 @@ func setupBudgetTestDB(t *testing.T) *gorm.DB {
 			budget_amount REAL,
 			start_date DATE,
 			end_date DATE,
+			default_billable BOOLEAN NOT NULL DEFAULT 1,
+			contract_type TEXT NOT NULL DEFAULT 'fixed_price',
+			retainer_fee REAL,
+			retainer_hours REAL,
+			retainer_rollover_months INTEGER NOT NULL DEFAULT 0,
 			created_at DATETIME,
 			updated_at DATETIME,
 			deleted_at DATETIME
@@ -78,6 +87,8 @@ func setupBudgetTestDB(t *testing.T) *gorm.DB {
 			email TEXT NOT NULL,
 			role TEXT,
 			hourly_rate REAL DEFAULT 0,
+			bill_rate REAL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
 			department TEXT,
 			created_at DATETIME,
 			updated_at DATETIME,
@@ -94,7 +105,17 @@ func setupBudgetTestDB(t *testing.T) *gorm.DB {
 			work_date DATE NOT NULL,
 			hours REAL NOT NULL,
 			hourly_rate_snapshot REAL,
+			rate_source TEXT NOT NULL DEFAULT 'member',
+			bill_rate_snapshot REAL,
+			is_billable BOOLEAN NOT NULL DEFAULT 1,
+			currency TEXT NOT NULL DEFAULT 'JPY',
 			comment TEXT,
+			invoice_id TEXT,
+			started_at DATETIME,
+			ended_at DATETIME,
+			timezone TEXT,
+			local_start_time TEXT,
+			local_end_time TEXT,
 			created_at DATETIME,
 			updated_at DATETIME
 		)
@@ -105,6 +126,69 @@ func setupBudgetTestDB(t *testing.T) *gorm.DB {
 			id TEXT PRIMARY KEY,
 			project_id TEXT NOT NULL UNIQUE,
 			revenue REAL DEFAULT 0,
+			recognized_revenue REAL DEFAULT 0,
+			planned_revenue REAL DEFAULT 0,
+			total_cost REAL DEFAULT 0,
+			profit REAL DEFAULT 0,
+			profit_rate REAL DEFAULT 0,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			hours_basis TEXT NOT NULL DEFAULT 'all',
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS project_members (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			member_id TEXT NOT NULL,
+			joined_at DATETIME,
+			left_at DATETIME,
+			allocation_rate REAL DEFAULT 1.0,
+			hourly_rate_snapshot REAL,
+			bill_rate REAL,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS expenses (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			category TEXT NOT NULL,
+			amount REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			vendor TEXT,
+			incurred_date DATE NOT NULL,
+			note TEXT,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS exchange_rates (
+			id TEXT PRIMARY KEY,
+			rate_date DATE NOT NULL,
+			from_currency TEXT NOT NULL,
+			to_currency TEXT NOT NULL,
+			rate REAL NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			UNIQUE (rate_date, from_currency, to_currency)
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS budget_snapshots (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			snapshot_date DATE NOT NULL,
+			source TEXT NOT NULL DEFAULT 'daily',
+			revenue REAL DEFAULT 0,
 			total_cost REAL DEFAULT 0,
 			profit REAL DEFAULT 0,
 			profit_rate REAL DEFAULT 0,
@@ -114,6 +198,243 @@ func setupBudgetTestDB(t *testing.T) *gorm.DB {
 		)
 	`).Error)
 
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS alert_rules (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			metric TEXT NOT NULL,
+			threshold REAL NOT NULL,
+			is_enabled BOOLEAN NOT NULL DEFAULT 1,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS alerts (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			rule_id TEXT,
+			metric TEXT NOT NULL,
+			threshold REAL NOT NULL,
+			value REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			message TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'open',
+			triggered_at DATETIME NOT NULL,
+			acknowledged_at DATETIME,
+			resolved_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS revenue_items (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			task_id TEXT,
+			name TEXT NOT NULL,
+			planned_date DATE NOT NULL,
+			amount REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			status TEXT NOT NULL DEFAULT 'planned',
+			description TEXT,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS invoices (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			sequence_number INTEGER UNIQUE,
+			invoice_number TEXT UNIQUE,
+			status TEXT NOT NULL DEFAULT 'draft',
+			period_start DATE NOT NULL,
+			period_end DATE NOT NULL,
+			group_by TEXT NOT NULL DEFAULT 'member',
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			total_hours REAL NOT NULL DEFAULT 0,
+			total_amount REAL NOT NULL DEFAULT 0,
+			note TEXT,
+			issued_at DATETIME,
+			paid_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS invoice_sequences (
+			id INTEGER PRIMARY KEY,
+			last_number INTEGER NOT NULL DEFAULT 0
+		)
+	`).Error)
+	require.NoError(t, db.Exec(`INSERT INTO invoice_sequences (id, last_number) VALUES (1, 0)`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS invoice_lines (
+			id TEXT PRIMARY KEY,
+			invoice_id TEXT NOT NULL,
+			member_id TEXT,
+			task_id TEXT,
+			description TEXT NOT NULL,
+			hours REAL NOT NULL,
+			unit_price REAL NOT NULL,
+			amount REAL NOT NULL,
+			sort_order INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS member_rates (
+			id TEXT PRIMARY KEY,
+			member_id TEXT NOT NULL,
+			hourly_rate REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			effective_from DATE NOT NULL,
+			effective_to DATE,
+			created_at DATETIME,
+			updated_at DATETIME,
+			UNIQUE (member_id, effective_from)
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS department_rates (
+			id TEXT PRIMARY KEY,
+			department TEXT NOT NULL UNIQUE,
+			hourly_rate REAL NOT NULL,
+			currency TEXT NOT NULL DEFAULT 'JPY',
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS holidays (
+			id TEXT PRIMARY KEY,
+			date DATE NOT NULL UNIQUE,
+			name TEXT NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS department_overheads (
+			id TEXT PRIMARY KEY,
+			department TEXT NOT NULL,
+			multiplier REAL NOT NULL,
+			effective_from DATE NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			UNIQUE (department, effective_from)
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS budget_lines (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			category TEXT NOT NULL,
+			planned_amount REAL NOT NULL DEFAULT 0,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS budget_line_links (
+			id TEXT PRIMARY KEY,
+			budget_line_id TEXT NOT NULL,
+			project_id TEXT NOT NULL,
+			task_id TEXT,
+			expense_category TEXT,
+			created_at DATETIME,
+			UNIQUE (project_id, task_id),
+			UNIQUE (project_id, expense_category)
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS change_orders (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			title TEXT NOT NULL,
+			reason TEXT NOT NULL,
+			budget_amount REAL,
+			revenue REAL,
+			end_date DATE,
+			cost_impact REAL NOT NULL DEFAULT 0,
+			status TEXT NOT NULL DEFAULT 'submitted',
+			requested_by TEXT NOT NULL,
+			reviewed_by TEXT,
+			reviewed_at DATETIME,
+			review_comment TEXT,
+			previous_budget_amount REAL,
+			previous_revenue REAL,
+			previous_end_date DATE,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS accounting_periods (
+			id TEXT PRIMARY KEY,
+			period_start DATE NOT NULL UNIQUE,
+			status TEXT NOT NULL DEFAULT 'open',
+			closed_by TEXT,
+			closed_at DATETIME,
+			reopened_by TEXT,
+			reopened_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS timers (
+			id TEXT PRIMARY KEY,
+			task_id TEXT NOT NULL,
+			member_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			started_at DATETIME NOT NULL,
+			stopped_at DATETIME,
+			auto_stopped INTEGER NOT NULL DEFAULT 0,
+			comment TEXT,
+			is_billable INTEGER,
+			created_at DATETIME,
+			updated_at DATETIME
+		)
+	`).Error)
+	require.NoError(t, db.Exec(`
+		CREATE UNIQUE INDEX IF NOT EXISTS timers_running_member_id_idx ON timers(member_id) WHERE stopped_at IS NULL
+	`).Error)
+	require.NoError(t, db.Exec(`
+		CREATE TABLE IF NOT EXISTS timesheets (
+			id TEXT PRIMARY KEY,
+			member_id TEXT NOT NULL,
+			week_start DATE NOT NULL,
+			week_end DATE NOT NULL,
+			status TEXT NOT NULL DEFAULT 'draft',
+			submitted_by TEXT,
+			submitted_at DATETIME,
+			reviewed_by TEXT,
+			reviewed_at DATETIME,
+			review_comment TEXT,
+			created_at DATETIME,
+			updated_at DATETIME,
+			UNIQUE (member_id, week_start)
+		)
+	`).Error)
+
 	return db
 }
 
@@ -154,7 +475,7 @@ func createTestMember(t *testing.T, db *gorm.DB) *models.Member {
 		ID:         memberID,
 		Name:       "テストメンバー",
 		Email:      "member@example.com",
-		HourlyRate: 5000,
+		HourlyRate: decimal.NewFromInt(5000),
 	}
 	require.NoError(t, db.Create(member).Error)
 	return member
@@ -173,6 +494,12 @@ func createTestTask(t *testing.T, db *gorm.DB, projectID uuid.UUID) *models.Task
 	return task
 }
 
+// assertDecimal は金額・工数が期待値と一致することを検証
+func assertDecimal(t *testing.T, expected float64, actual decimal.Decimal) {
+	t.Helper()
+	assert.Truef(t, decimal.NewFromFloat(expected).Equal(actual), "expected %v, got %s", expected, actual)
+}
+
 func TestBudgetService_GetBudget(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -210,7 +537,7 @@ func TestBudgetService_GetBudget(t *testing.T) {
 			db := setupBudgetTestDB(t)
 			projectID := tt.setupData(t, db)
 
-			svc := service.NewBudgetService(db)
+			svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
 			result, err := svc.GetBudget(projectID)
 
 			if tt.wantErr {
@@ -260,9 +587,9 @@ func TestBudgetService_UpdateRevenue(t *testing.T) {
 			db := setupBudgetTestDB(t)
 			projectID := tt.setupData(t, db)
 
-			svc := service.NewBudgetService(db)
+			svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
 			req := &dto.UpdateRevenueRequest{
-				Revenue:  tt.revenue,
+				Revenue:  decimal.NewFromFloat(tt.revenue),
 				Currency: tt.currency,
 			}
 
@@ -274,7 +601,7 @@ func TestBudgetService_UpdateRevenue(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
-				assert.Equal(t, tt.revenue, result.Revenue)
+				assertDecimal(t, tt.revenue, result.Revenue)
 			}
 		})
 	}
@@ -293,7 +620,7 @@ func TestBudgetService_CreateTimeEntry(t *testing.T) {
 				TaskID:   uuid.New(),
 				MemberID: uuid.New(),
 				WorkDate: "2024-01-15",
-				Hours:    8,
+				Hours:    decimal.NewFromInt(8),
 			},
 			setupData: func(t *testing.T, db *gorm.DB) (uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) {
 				return uuid.New(), uuid.New(), uuid.New(), uuid.New()
@@ -306,7 +633,7 @@ func TestBudgetService_CreateTimeEntry(t *testing.T) {
 				TaskID:   uuid.New(),
 				MemberID: uuid.New(),
 				WorkDate: "2024-01-15",
-				Hours:    8,
+				Hours:    decimal.NewFromInt(8),
 			},
 			setupData: func(t *testing.T, db *gorm.DB) (uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) {
 				return uuid.New(), uuid.New(), uuid.New(), uuid.New()
@@ -319,7 +646,7 @@ func TestBudgetService_CreateTimeEntry(t *testing.T) {
 				TaskID:   uuid.New(),
 				MemberID: uuid.New(),
 				WorkDate: "invalid-date",
-				Hours:    8,
+				Hours:    decimal.NewFromInt(8),
 			},
 			setupData: func(t *testing.T, db *gorm.DB) (uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) {
 				return uuid.New(), uuid.New(), uuid.New(), uuid.New()
@@ -333,7 +660,7 @@ func TestBudgetService_CreateTimeEntry(t *testing.T) {
 			db := setupBudgetTestDB(t)
 			userID, _, _, _ := tt.setupData(t, db)
 
-			svc := service.NewBudgetService(db)
+			svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
 
 			result, err := svc.CreateTimeEntry(userID, tt.req)
 
@@ -343,7 +670,7 @@ func TestBudgetService_CreateTimeEntry(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
-				assert.Equal(t, tt.req.Hours, result.Hours)
+				assert.True(t, tt.req.Hours.Equal(result.Hours))
 			}
 		})
 	}
@@ -371,7 +698,7 @@ func TestBudgetService_GetTimeEntry(t *testing.T) {
 			db := setupBudgetTestDB(t)
 			entryID := tt.setupData(t, db)
 
-			svc := service.NewBudgetService(db)
+			svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
 			result, err := svc.GetTimeEntry(entryID)
 
 			if tt.wantErr {
@@ -418,7 +745,7 @@ func TestBudgetService_ListTimeEntries(t *testing.T) {
 			db := setupBudgetTestDB(t)
 			tt.setupData(t, db)
 
-			svc := service.NewBudgetService(db)
+			svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
 			params := struct {
 				Page    int
 				PerPage int
@@ -448,7 +775,7 @@ func TestBudgetService_UpdateTimeEntry(t *testing.T) {
 			name:    "異常: 存在しないエントリIDでエラー",
 			entryID: uuid.New(),
 			req: &dto.UpdateTimeEntryRequest{
-				Hours: func() *float64 { h := 4.0; return &h }(),
+				Hours: func() *decimal.Decimal { h := decimal.NewFromInt(4); return &h }(),
 			},
 			setupData: func(t *testing.T, db *gorm.DB) uuid.UUID {
 				return uuid.New()
@@ -462,7 +789,7 @@ func TestBudgetService_UpdateTimeEntry(t *testing.T) {
 			db := setupBudgetTestDB(t)
 			entryID := tt.setupData(t, db)
 
-			svc := service.NewBudgetService(db)
+			svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
 			result, err := svc.UpdateTimeEntry(entryID, tt.req)
 
 			if tt.wantErr {
@@ -496,7 +823,7 @@ func TestBudgetService_DeleteTimeEntry(t *testing.T) {
 			db := setupBudgetTestDB(t)
 			entryID := tt.setupData(t, db)
 
-			svc := service.NewBudgetService(db)
+			svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
 			err := svc.DeleteTimeEntry(entryID)
 
 			if tt.wantErr {
@@ -508,6 +835,1198 @@ func TestBudgetService_DeleteTimeEntry(t *testing.T) {
 	}
 }
 
+func TestBudgetService_DailyHoursLimit(t *testing.T) {
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	hours := func(h float64) *decimal.Decimal {
+		d := decimal.NewFromFloat(h)
+		return &d
+	}
+	limitOf := func(t *testing.T, maxHours, overAllocation string) service.DailyHoursLimit {
+		limit, err := service.ParseDailyHoursLimit(maxHours, overAllocation)
+		require.NoError(t, err)
+		return limit
+	}
+	// violationsOf は上限超過エラーの詳細を取り出す
+	violationsOf := func(t *testing.T, err error) []dto.DailyHoursViolation {
+		assertAppErrorCode(t, "DAILY_HOURS_EXCEEDED", err)
+		violations, ok := err.(*apperrors.AppError).Details.([]dto.DailyHoursViolation)
+		require.True(t, ok)
+		return violations
+	}
+
+	t.Run("異常: 1日の上限時間を超える工数は拒否する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		task := createTestTask(t, db, createTestProject(t, db).ID)
+		member := createTestMember(t, db)
+		svc := service.NewBudgetService(db, limitOf(t, "10", service.OverAllocationWarn))
+
+		_, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-04", Hours: decimal.NewFromInt(6),
+		})
+		require.NoError(t, err)
+
+		_, err = svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-04", Hours: decimal.NewFromInt(5),
+		})
+		violations := violationsOf(t, err)
+		require.Len(t, violations, 1)
+		assert.Equal(t, member.ID, violations[0].MemberID)
+		assert.Equal(t, "2024-03-04", violations[0].WorkDate)
+		assert.Equal(t, service.DailyLimitMaxHours, violations[0].Limit)
+		assertDecimal(t, 10.0, violations[0].LimitHours)
+		assertDecimal(t, 6.0, violations[0].RecordedHours)
+		assertDecimal(t, 5.0, violations[0].RequestedHours)
+		assertDecimal(t, 11.0, violations[0].TotalHours)
+		assertDecimal(t, 1.0, violations[0].ExcessHours)
+
+		// 別の日は影響を受けない
+		_, err = svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-05", Hours: decimal.NewFromInt(5),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("正常: 更新では変更するエントリ自身を除いて上限と比べる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		task := createTestTask(t, db, createTestProject(t, db).ID)
+		member := createTestMember(t, db)
+		svc := service.NewBudgetService(db, limitOf(t, "10", service.OverAllocationWarn))
+
+		first, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-04", Hours: decimal.NewFromInt(6),
+		})
+		require.NoError(t, err)
+		second, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-05", Hours: decimal.NewFromInt(8),
+		})
+		require.NoError(t, err)
+
+		_, err = svc.UpdateTimeEntry(first.ID, &dto.UpdateTimeEntryRequest{Hours: hours(10)})
+		require.NoError(t, err)
+
+		// 8時間の記録がある日への移動は上限を超える
+		workDate := "2024-03-05"
+		_, err = svc.UpdateTimeEntry(first.ID, &dto.UpdateTimeEntryRequest{WorkDate: &workDate})
+		violations := violationsOf(t, err)
+		assertDecimal(t, 8.0, violations[0].RecordedHours)
+		assertDecimal(t, 18.0, violations[0].TotalHours)
+
+		_, err = svc.UpdateTimeEntry(second.ID, &dto.UpdateTimeEntryRequest{Hours: hours(10.5)})
+		violationsOf(t, err)
+
+		// 工数を減らす変更は上限を超えていても受け付ける
+		strict := service.NewBudgetService(db, limitOf(t, "4", service.OverAllocationWarn))
+		_, err = strict.UpdateTimeEntry(second.ID, &dto.UpdateTimeEntryRequest{Hours: hours(7)})
+		require.NoError(t, err)
+	})
+
+	t.Run("正常: 稼働率を超える工数は既定では警告として返す", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		first := createTestProject(t, db)
+		second := &models.Project{ID: uuid.New(), UserID: uuid.New(), Name: "別プロジェクト", Status: "in_progress"}
+		require.NoError(t, db.Create(second).Error)
+		task := createTestTask(t, db, first.ID)
+		member := createTestMember(t, db)
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+
+		// 稼働率 50% + 25% = 1日 6時間
+		require.NoError(t, db.Create(&models.ProjectMember{
+			ProjectID: first.ID, MemberID: member.ID, JoinedAt: date("2024-01-01"), AllocationRate: 0.5,
+		}).Error)
+		require.NoError(t, db.Create(&models.ProjectMember{
+			ProjectID: second.ID, MemberID: member.ID, JoinedAt: date("2024-01-01"), AllocationRate: 0.25,
+		}).Error)
+
+		entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-04", Hours: decimal.NewFromInt(5),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, entry.Warnings)
+
+		entry, err = svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-04", Hours: decimal.NewFromInt(3),
+		})
+		require.NoError(t, err)
+		require.Len(t, entry.Warnings, 1)
+		assert.Equal(t, service.DailyLimitAllocation, entry.Warnings[0].Limit)
+		assertDecimal(t, 6.0, entry.Warnings[0].LimitHours)
+		assertDecimal(t, 2.0, entry.Warnings[0].ExcessHours)
+
+		// 参画前の日は稼働率がないため警告しない
+		entry, err = svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2023-12-20", Hours: decimal.NewFromInt(10),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, entry.Warnings)
+	})
+
+	t.Run("異常: 稼働率を超える工数は設定により拒否する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+		member := createTestMember(t, db)
+		svc := service.NewBudgetService(db, limitOf(t, "24", service.OverAllocationReject))
+		require.NoError(t, db.Create(&models.ProjectMember{
+			ProjectID: project.ID, MemberID: member.ID, JoinedAt: date("2024-01-01"), AllocationRate: 0.5,
+		}).Error)
+
+		_, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-04", Hours: decimal.NewFromInt(5),
+		})
+		violations := violationsOf(t, err)
+		assert.Equal(t, service.DailyLimitAllocation, violations[0].Limit)
+		assertDecimal(t, 4.0, violations[0].LimitHours)
+
+		var count int64
+		require.NoError(t, db.Model(&models.TimeEntry{}).Count(&count).Error)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("異常: 不正な設定値", func(t *testing.T) {
+		for _, values := range [][2]string{{"0", "warn"}, {"25", "warn"}, {"abc", "warn"}, {"8", "ignore"}} {
+			_, err := service.ParseDailyHoursLimit(values[0], values[1])
+			assert.Error(t, err, values)
+		}
+	})
+}
+
+func TestBudgetService_TimeEntryTimeRange(t *testing.T) {
+	at := func(s string) *time.Time {
+		ts, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return &ts
+	}
+	tokyo := "Asia/Tokyo"
+
+	setup := func(t *testing.T) (*gorm.DB, *models.Task, *models.Member, *service.BudgetService) {
+		db := setupBudgetTestDB(t)
+		task := createTestTask(t, db, createTestProject(t, db).ID)
+		member := createTestMember(t, db)
+		return db, task, member, service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	}
+
+	t.Run("正常: 開始・終了日時から工数と作業日を求める", func(t *testing.T) {
+		db, task, member, svc := setup(t)
+
+		entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID,
+			StartedAt: at("2024-03-04T09:00:00+09:00"), EndedAt: at("2024-03-04T12:30:00+09:00"), Timezone: &tokyo,
+		})
+		require.NoError(t, err)
+		assertDecimal(t, 3.5, entry.Hours)
+		assert.Equal(t, "2024-03-04", entry.WorkDate)
+		assert.Equal(t, "2024-03-04T09:00:00+09:00", entry.StartedAt.Format(time.RFC3339))
+		assert.Equal(t, "2024-03-04T12:30:00+09:00", entry.EndedAt.Format(time.RFC3339))
+		assert.Equal(t, tokyo, *entry.Timezone)
+
+		var updatedTask models.Task
+		require.NoError(t, db.First(&updatedTask, "id = ?", task.ID).Error)
+		assertDecimal(t, 3.5, updatedTask.ActualHours)
+	})
+
+	t.Run("正常: 日付をまたぐ夜間作業は開始日の工数とする", func(t *testing.T) {
+		_, task, member, svc := setup(t)
+
+		// UTCで指定しても作業日はタイムゾーンでの開始日になる
+		entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-04", Hours: decimal.NewFromInt(4),
+			StartedAt: at("2024-03-04T13:00:00Z"), EndedAt: at("2024-03-04T17:00:00Z"), Timezone: &tokyo,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03-04", entry.WorkDate)
+		assert.Equal(t, "2024-03-04T22:00:00+09:00", entry.StartedAt.Format(time.RFC3339))
+		assert.Equal(t, "2024-03-05T02:00:00+09:00", entry.EndedAt.Format(time.RFC3339))
+	})
+
+	t.Run("異常: 不正な開始・終了日時", func(t *testing.T) {
+		_, task, member, svc := setup(t)
+		unknown := "Mars/Olympus"
+
+		tests := []struct {
+			name string
+			req  *dto.CreateTimeEntryRequest
+		}{
+			{
+				name: "終了が開始以前",
+				req:  &dto.CreateTimeEntryRequest{StartedAt: at("2024-03-04T10:00:00+09:00"), EndedAt: at("2024-03-04T10:00:00+09:00"), Timezone: &tokyo},
+			},
+			{
+				name: "24時間を超える",
+				req:  &dto.CreateTimeEntryRequest{StartedAt: at("2024-03-04T09:00:00+09:00"), EndedAt: at("2024-03-05T09:30:00+09:00"), Timezone: &tokyo},
+			},
+			{
+				name: "不明なタイムゾーン",
+				req:  &dto.CreateTimeEntryRequest{StartedAt: at("2024-03-04T09:00:00+09:00"), EndedAt: at("2024-03-04T10:00:00+09:00"), Timezone: &unknown},
+			},
+			{
+				name: "終了日時がない",
+				req:  &dto.CreateTimeEntryRequest{StartedAt: at("2024-03-04T09:00:00+09:00"), Timezone: &tokyo},
+			},
+			{
+				name: "工数が開始・終了日時と合わない",
+				req: &dto.CreateTimeEntryRequest{Hours: decimal.NewFromInt(2),
+					StartedAt: at("2024-03-04T09:00:00+09:00"), EndedAt: at("2024-03-04T10:00:00+09:00"), Timezone: &tokyo},
+			},
+			{
+				name: "作業日が開始日と合わない",
+				req: &dto.CreateTimeEntryRequest{WorkDate: "2024-03-03",
+					StartedAt: at("2024-03-04T09:00:00+09:00"), EndedAt: at("2024-03-04T10:00:00+09:00"), Timezone: &tokyo},
+			},
+		}
+
+		for _, tt := range tests {
+			tt.req.TaskID, tt.req.MemberID = task.ID, member.ID
+			_, err := svc.CreateTimeEntry(uuid.New(), tt.req)
+			assertAppErrorCode(t, "VALIDATION_FAILED", err)
+		}
+	})
+
+	t.Run("異常: 同じメンバーの時間帯が重なる工数は拒否する", func(t *testing.T) {
+		db, task, member, svc := setup(t)
+		other := &models.Member{ID: uuid.New(), Name: "別メンバー", Email: "other@example.com", HourlyRate: decimal.NewFromInt(5000)}
+		require.NoError(t, db.Create(other).Error)
+
+		night, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID,
+			StartedAt: at("2024-03-04T22:00:00+09:00"), EndedAt: at("2024-03-05T02:00:00+09:00"), Timezone: &tokyo,
+		})
+		require.NoError(t, err)
+
+		_, err = svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID,
+			StartedAt: at("2024-03-05T01:00:00+09:00"), EndedAt: at("2024-03-05T03:00:00+09:00"), Timezone: &tokyo,
+		})
+		assertAppErrorCode(t, "TIME_ENTRY_OVERLAP", err)
+		overlaps, ok := err.(*apperrors.AppError).Details.([]dto.TimeEntryOverlap)
+		require.True(t, ok)
+		require.Len(t, overlaps, 1)
+		assert.Equal(t, night.ID, overlaps[0].ID)
+		assert.Equal(t, "2024-03-04T22:00:00+09:00", overlaps[0].StartedAt.Format(time.RFC3339))
+
+		// 終了時刻ちょうどからの工数と別メンバーの工数は重ならない
+		_, err = svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID,
+			StartedAt: at("2024-03-05T02:00:00+09:00"), EndedAt: at("2024-03-05T03:00:00+09:00"), Timezone: &tokyo,
+		})
+		require.NoError(t, err)
+		_, err = svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: other.ID,
+			StartedAt: at("2024-03-05T01:00:00+09:00"), EndedAt: at("2024-03-05T03:00:00+09:00"), Timezone: &tokyo,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("正常: 更新では開始・終了日時に合わせて工数を変える", func(t *testing.T) {
+		db, task, member, svc := setup(t)
+
+		morning, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID,
+			StartedAt: at("2024-03-04T09:00:00+09:00"), EndedAt: at("2024-03-04T12:00:00+09:00"), Timezone: &tokyo,
+		})
+		require.NoError(t, err)
+		_, err = svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID,
+			StartedAt: at("2024-03-04T13:00:00+09:00"), EndedAt: at("2024-03-04T15:00:00+09:00"), Timezone: &tokyo,
+		})
+		require.NoError(t, err)
+
+		updated, err := svc.UpdateTimeEntry(morning.ID, &dto.UpdateTimeEntryRequest{EndedAt: at("2024-03-04T12:45:00+09:00")})
+		require.NoError(t, err)
+		assertDecimal(t, 3.75, updated.Hours)
+
+		var updatedTask models.Task
+		require.NoError(t, db.First(&updatedTask, "id = ?", task.ID).Error)
+		assertDecimal(t, 5.75, updatedTask.ActualHours)
+
+		// 時間帯のある工数は工数だけを変えられない
+		_, err = svc.UpdateTimeEntry(morning.ID, &dto.UpdateTimeEntryRequest{Hours: func() *decimal.Decimal { h := decimal.NewFromInt(5); return &h }()})
+		assertAppErrorCode(t, "VALIDATION_FAILED", err)
+
+		_, err = svc.UpdateTimeEntry(morning.ID, &dto.UpdateTimeEntryRequest{EndedAt: at("2024-03-04T13:30:00+09:00")})
+		assertAppErrorCode(t, "TIME_ENTRY_OVERLAP", err)
+	})
+
+	t.Run("正常: 時間帯で工数を絞り込む", func(t *testing.T) {
+		_, task, member, svc := setup(t)
+		ranges := map[string][2]string{
+			"morning":   {"2024-03-04T09:00:00+09:00", "2024-03-04T12:30:00+09:00"},
+			"afternoon": {"2024-03-04T13:00:00+09:00", "2024-03-04T15:00:00+09:00"},
+			"night":     {"2024-03-04T22:00:00+09:00", "2024-03-05T02:00:00+09:00"},
+		}
+		ids := make(map[uuid.UUID]string)
+		for name, r := range ranges {
+			entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+				TaskID: task.ID, MemberID: member.ID, StartedAt: at(r[0]), EndedAt: at(r[1]), Timezone: &tokyo,
+			})
+			require.NoError(t, err)
+			ids[entry.ID] = name
+		}
+		// 時刻のない工数は時間帯の絞り込みに含まれない
+		_, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-03-06", Hours: decimal.NewFromInt(1),
+		})
+		require.NoError(t, err)
+
+		tests := []struct {
+			from, to string
+			want     []string
+		}{
+			{from: "12:00", to: "14:00", want: []string{"afternoon", "morning"}},
+			{from: "12:30", to: "13:00", want: nil},
+			{from: "23:00", to: "01:00", want: []string{"night"}},
+			{from: "01:00", to: "03:00", want: []string{"night"}},
+			{from: "00:00", to: "09:30", want: []string{"morning", "night"}},
+			{from: "20:00", to: "08:00", want: []string{"night"}},
+		}
+		for _, tt := range tests {
+			result, err := svc.ListTimeEntries(repository.TimeEntryListParams{
+				TimeOfDay: &repository.TimeOfDayRange{From: tt.from, To: tt.to}, Page: 1, PerPage: 20,
+			})
+			require.NoError(t, err)
+			var got []string
+			for _, entry := range result.TimeEntries {
+				got = append(got, ids[entry.ID])
+			}
+			assert.ElementsMatch(t, tt.want, got, "%s-%s", tt.from, tt.to)
+		}
+	})
+}
+
+func TestBudgetService_GetBudgetSummary_TaskCosts(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	member := createTestMember(t, db)
+
+	design := &models.Task{ID: uuid.New(), ProjectID: project.ID, Name: "設計", PlannedHours: decimal.NewFromInt(10), Status: "completed"}
+	develop := &models.Task{ID: uuid.New(), ProjectID: project.ID, Name: "開発", PlannedHours: decimal.NewFromInt(20), Status: "in_progress"}
+	review := &models.Task{ID: uuid.New(), ProjectID: project.ID, Name: "レビュー", PlannedHours: decimal.NewFromInt(5), Status: "todo"}
+	for _, task := range []*models.Task{design, develop, review} {
+		require.NoError(t, db.Create(task).Error)
+	}
+
+	rate := decimal.NewFromInt(5000)
+	entries := []struct {
+		taskID uuid.UUID
+		date   string
+		hours  float64
+	}{
+		{design.ID, "2024-01-10", 8},
+		{design.ID, "2024-01-11", 6},
+		{develop.ID, "2024-02-05", 8},
+	}
+	for _, e := range entries {
+		workDate, err := time.Parse("2006-01-02", e.date)
+		require.NoError(t, err)
+		require.NoError(t, db.Create(&models.TimeEntry{
+			ID:                 uuid.New(),
+			TaskID:             e.taskID,
+			MemberID:           member.ID,
+			UserID:             uuid.New(),
+			WorkDate:           workDate,
+			Hours:              decimal.NewFromFloat(e.hours),
+			HourlyRateSnapshot: &rate,
+		}).Error)
+	}
+
+	svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+
+	t.Run("正常: タスク別のコストと差異を取得できる", func(t *testing.T) {
+		summary, err := svc.GetBudgetSummary(project.ID, repository.DateRange{})
+		require.NoError(t, err)
+		require.Len(t, summary.TaskCosts, 3)
+
+		// コストの大きい順
+		assert.Equal(t, design.ID, summary.TaskCosts[0].TaskID)
+		assertDecimal(t, 14.0, summary.TaskCosts[0].Hours)
+		assertDecimal(t, 70000.0, summary.TaskCosts[0].Cost)
+		assertDecimal(t, 10.0, summary.TaskCosts[0].PlannedHours)
+		assertDecimal(t, 4.0, summary.TaskCosts[0].VarianceHours)
+		assertDecimal(t, 50000.0, summary.TaskCosts[0].PlannedCost)
+		assertDecimal(t, 20000.0, summary.TaskCosts[0].CostVariance)
+
+		// 実績のないタスクはプロジェクト平均単価で予定コストを算出
+		assert.Equal(t, review.ID, summary.TaskCosts[2].TaskID)
+		assertDecimal(t, 0.0, summary.TaskCosts[2].Cost)
+		assertDecimal(t, 25000.0, summary.TaskCosts[2].PlannedCost)
+		assertDecimal(t, -25000.0, summary.TaskCosts[2].CostVariance)
+	})
+
+	t.Run("正常: 期間で絞り込める", func(t *testing.T) {
+		from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+		summary, err := svc.GetBudgetSummary(project.ID, repository.DateRange{From: &from, To: &to})
+		require.NoError(t, err)
+
+		assertDecimal(t, 40000.0, summary.CostBreakdown.LaborCost)
+		assertDecimal(t, 8.0, summary.CostBreakdown.TotalHours)
+		require.Len(t, summary.MemberCosts, 1)
+		assertDecimal(t, 8.0, summary.MemberCosts[0].Hours)
+		assert.Equal(t, develop.ID, summary.TaskCosts[0].TaskID)
+		assertDecimal(t, 40000.0, summary.TaskCosts[0].Cost)
+		require.NotNil(t, summary.From)
+		assert.Equal(t, "2024-02-01", *summary.From)
+
+		// 予算全体は期間に関わらずプロジェクト累計
+		assertDecimal(t, 110000.0, summary.Budget.TotalCost)
+	})
+}
+
+func TestBudgetService_GetBudgetSummary_LoadedCost(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return d
+	}
+
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+	require.NoError(t, db.Create(&models.Budget{ProjectID: project.ID, Revenue: decimal.NewFromInt(200000), Currency: "JPY"}).Error)
+
+	development := "開発部"
+	developer := createTestMember(t, db)
+	require.NoError(t, db.Model(developer).Update("department", development).Error)
+	contractor := &models.Member{ID: uuid.New(), Name: "部署なし", Email: "contractor@example.com", HourlyRate: decimal.NewFromInt(5000)}
+	require.NoError(t, db.Create(contractor).Error)
+
+	// 開発部は 1 月から 1.5 倍、2 月から 2.0 倍。他部署の係数は適用しない
+	for _, o := range []struct {
+		department string
+		multiplier float64
+		from       string
+	}{
+		{development, 1.5, "2024-01-01"},
+		{development, 2.0, "2024-02-01"},
+		{"営業部", 3.0, "2024-01-01"},
+	} {
+		require.NoError(t, db.Create(&models.DepartmentOverhead{
+			Department:    o.department,
+			Multiplier:    decimal.NewFromFloat(o.multiplier),
+			EffectiveFrom: date(o.from),
+		}).Error)
+	}
+
+	rate := decimal.NewFromInt(5000)
+	for _, e := range []struct {
+		memberID uuid.UUID
+		date     string
+		hours    int64
+	}{
+		{developer.ID, "2024-01-15", 8},
+		{developer.ID, "2024-02-10", 4},
+		{contractor.ID, "2024-02-10", 2},
+	} {
+		require.NoError(t, db.Create(&models.TimeEntry{
+			ID:                 uuid.New(),
+			TaskID:             task.ID,
+			MemberID:           e.memberID,
+			UserID:             uuid.New(),
+			WorkDate:           date(e.date),
+			Hours:              decimal.NewFromInt(e.hours),
+			HourlyRateSnapshot: &rate,
+			IsBillable:         true,
+		}).Error)
+	}
+	createTestExpense(t, db, project.ID, "license", 10000)
+
+	svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+
+	t.Run("正常: 直接原価と間接費込み原価を並べて利益を算出する", func(t *testing.T) {
+		summary, err := svc.GetBudgetSummary(project.ID, repository.DateRange{})
+		require.NoError(t, err)
+
+		// 直接原価: 人件費 70,000 + 経費 10,000
+		assertDecimal(t, 80000.0, summary.LoadedCost.DirectCost)
+		// 間接費込み人件費: 40,000 × 1.5 + 20,000 × 2.0 + 10,000 × 1
+		assertDecimal(t, 110000.0, summary.LoadedCost.LoadedLaborCost)
+		assertDecimal(t, 40000.0, summary.LoadedCost.OverheadCost)
+		assertDecimal(t, 120000.0, summary.LoadedCost.LoadedCost)
+		assertDecimal(t, 120000.0, summary.LoadedCost.DirectProfit)
+		assertDecimal(t, 60.0, summary.LoadedCost.DirectProfitRate)
+		assertDecimal(t, 80000.0, summary.LoadedCost.LoadedProfit)
+		assertDecimal(t, 40.0, summary.LoadedCost.LoadedProfitRate)
+
+		for _, mc := range summary.MemberCosts {
+			if mc.MemberID == developer.ID {
+				assertDecimal(t, 60000.0, mc.Cost)
+				assertDecimal(t, 100000.0, mc.LoadedCost)
+			} else {
+				assertDecimal(t, 10000.0, mc.LoadedCost)
+			}
+		}
+	})
+
+	t.Run("正常: 期間内の原価のみを間接費込みで集計する", func(t *testing.T) {
+		from := date("2024-02-01")
+		to := date("2024-02-29")
+		summary, err := svc.GetBudgetSummary(project.ID, repository.DateRange{From: &from, To: &to})
+		require.NoError(t, err)
+
+		assertDecimal(t, 30000.0, summary.LoadedCost.DirectCost)
+		assertDecimal(t, 50000.0, summary.LoadedCost.LoadedCost)
+
+		// 収益はプロジェクト全体のため、利益も全期間の原価で算出する
+		assertDecimal(t, 120000.0, summary.LoadedCost.DirectProfit)
+		assertDecimal(t, 80000.0, summary.LoadedCost.LoadedProfit)
+	})
+}
+
+func TestBudgetService_Billing(t *testing.T) {
+	t.Run("正常: 請求単価はプロジェクト別・メンバー・原価単価の順に決まる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+
+		billRate := decimal.NewFromInt(8000)
+		overrideRate := decimal.NewFromInt(10000)
+		assigned := &models.Member{ID: uuid.New(), Name: "アサイン済み", Email: "a@example.com", HourlyRate: decimal.NewFromInt(5000), BillRate: &billRate}
+		unassigned := &models.Member{ID: uuid.New(), Name: "未アサイン", Email: "b@example.com", HourlyRate: decimal.NewFromInt(5000), BillRate: &billRate}
+		costOnly := createTestMember(t, db)
+		require.NoError(t, db.Create(assigned).Error)
+		require.NoError(t, db.Create(unassigned).Error)
+		require.NoError(t, db.Create(&models.ProjectMember{
+			ProjectID: project.ID,
+			MemberID:  assigned.ID,
+			JoinedAt:  time.Now(),
+			BillRate:  &overrideRate,
+		}).Error)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		expected := map[uuid.UUID]float64{
+			assigned.ID:   10000,
+			unassigned.ID: 8000,
+			costOnly.ID:   5000,
+		}
+		for memberID, rate := range expected {
+			entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+				TaskID:   task.ID,
+				MemberID: memberID,
+				WorkDate: "2024-01-15",
+				Hours:    decimal.NewFromInt(2),
+			})
+			require.NoError(t, err)
+			require.NotNil(t, entry.BillRateSnapshot)
+			assertDecimal(t, rate, *entry.BillRateSnapshot)
+			assertDecimal(t, 5000.0, *entry.HourlyRateSnapshot)
+			assertDecimal(t, rate*2, entry.BillValue)
+			assert.True(t, entry.IsBillable)
+		}
+	})
+
+	t.Run("正常: 請求可否はプロジェクトの既定値に従い、個別に指定できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		require.NoError(t, db.Model(project).Update("default_billable", false).Error)
+		task := createTestTask(t, db, project.ID)
+		member := createTestMember(t, db)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID:   task.ID,
+			MemberID: member.ID,
+			WorkDate: "2024-01-15",
+			Hours:    decimal.NewFromInt(2),
+		})
+		require.NoError(t, err)
+		assert.False(t, entry.IsBillable)
+
+		billable := true
+		updated, err := svc.UpdateTimeEntry(entry.ID, &dto.UpdateTimeEntryRequest{IsBillable: &billable})
+		require.NoError(t, err)
+		assert.True(t, updated.IsBillable)
+	})
+
+	t.Run("正常: サマリーに請求対象の金額と実現率を含む", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+		billRate := decimal.NewFromInt(10000)
+		member := &models.Member{ID: uuid.New(), Name: "メンバー", Email: "a@example.com", HourlyRate: decimal.NewFromInt(5000), BillRate: &billRate}
+		require.NoError(t, db.Create(member).Error)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		nonBillable := false
+		for _, req := range []*dto.CreateTimeEntryRequest{
+			{TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-15", Hours: decimal.NewFromInt(6)},
+			{TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-16", Hours: decimal.NewFromInt(2), IsBillable: &nonBillable},
+		} {
+			_, err := svc.CreateTimeEntry(uuid.New(), req)
+			require.NoError(t, err)
+		}
+
+		summary, err := svc.GetBudgetSummary(project.ID, repository.DateRange{})
+		require.NoError(t, err)
+
+		// 原価は請求可否に関わらず全工数が対象
+		assertDecimal(t, 40000.0, summary.CostBreakdown.LaborCost)
+		assertDecimal(t, 6.0, summary.Billing.BillableHours)
+		assertDecimal(t, 2.0, summary.Billing.NonBillableHours)
+		assertDecimal(t, 60000.0, summary.Billing.BillableValue)
+		assertDecimal(t, 20000.0, summary.Billing.NonBillableValue)
+		// 60,000円 ÷ (60,000円 + 20,000円)
+		assert.Equal(t, 75.0, summary.Billing.RealizationRate)
+	})
+}
+
+func TestBudgetService_ContractTypes(t *testing.T) {
+	// createContractProject は契約形態を指定したプロジェクトと請求単価 10,000円のメンバーを作成
+	createContractProject := func(t *testing.T, db *gorm.DB, project *models.Project) (*models.Task, *models.Member) {
+		project.ID = uuid.New()
+		project.UserID = uuid.New()
+		project.Name = "契約テスト"
+		project.Status = "in_progress"
+		require.NoError(t, db.Create(project).Error)
+
+		billRate := decimal.NewFromInt(10000)
+		member := &models.Member{ID: uuid.New(), Name: "メンバー", Email: "a@example.com", HourlyRate: decimal.NewFromInt(5000), BillRate: &billRate}
+		require.NoError(t, db.Create(member).Error)
+		return createTestTask(t, db, project.ID), member
+	}
+	date := func(s string) *time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return &d
+	}
+
+	t.Run("正常: 準委任は請求対象工数×請求単価を売上とする", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := &models.Project{ContractType: models.ContractTypeTimeAndMaterials}
+		task, member := createContractProject(t, db, project)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		nonBillable := false
+		for _, req := range []*dto.CreateTimeEntryRequest{
+			{TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-15", Hours: decimal.NewFromInt(6)},
+			{TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-16", Hours: decimal.NewFromInt(2), IsBillable: &nonBillable},
+		} {
+			_, err := svc.CreateTimeEntry(uuid.New(), req)
+			require.NoError(t, err)
+		}
+
+		budget, err := svc.GetBudget(project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.ContractTypeTimeAndMaterials, budget.ContractType)
+		assertDecimal(t, 60000.0, budget.Revenue)
+		assertDecimal(t, 60000.0, budget.RecognizedRevenue)
+		assertDecimal(t, 0.0, budget.PlannedRevenue)
+		// 原価は請求対象外を含む 8時間 × 5,000円
+		assertDecimal(t, 40000.0, budget.TotalCost)
+		assertDecimal(t, 20000.0, budget.Profit)
+		assert.False(t, budget.IsDeficit)
+
+		// 売上は工数から決まるため直接は更新できない
+		_, err = svc.UpdateRevenue(project.ID, &dto.UpdateRevenueRequest{Revenue: decimal.NewFromInt(100000)})
+		require.Error(t, err)
+	})
+
+	t.Run("正常: 月額顧問は月額×経過月数と持ち越しを超えた工数を売上とする", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		fee := decimal.NewFromInt(300000)
+		hours := decimal.NewFromInt(20)
+		project := &models.Project{
+			ContractType:           models.ContractTypeRetainer,
+			RetainerFee:            &fee,
+			RetainerHours:          &hours,
+			RetainerRolloverMonths: 1,
+			StartDate:              date("2024-01-01"),
+			EndDate:                date("2024-04-30"),
+		}
+		task, member := createContractProject(t, db, project)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		for workDate, h := range map[string]int64{
+			"2024-01-10": 10,
+			"2024-02-10": 20,
+			"2024-02-11": 15,
+			"2024-03-10": 5,
+			"2024-04-10": 20,
+			"2024-04-11": 20,
+		} {
+			_, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+				TaskID: task.ID, MemberID: member.ID, WorkDate: workDate, Hours: decimal.NewFromInt(h),
+			})
+			require.NoError(t, err)
+		}
+
+		budget, err := svc.GetBudget(project.ID)
+		require.NoError(t, err)
+		require.NotNil(t, budget.Retainer)
+		retainer := budget.Retainer
+		assert.Equal(t, 4, retainer.ContractMonths)
+		assert.Equal(t, 4, retainer.ElapsedMonths)
+		require.Len(t, retainer.Months, 4)
+
+		// 1月: 20時間中10時間を使用し、10時間を翌月に持ち越し
+		assertDecimal(t, 10.0, retainer.Months[0].UnusedHours)
+		// 2月: 持ち越し10時間 + 20時間に対して35時間を使用し、5時間が超過
+		assertDecimal(t, 30.0, retainer.Months[1].AvailableHours)
+		assertDecimal(t, 5.0, retainer.Months[1].OverageHours)
+		assertDecimal(t, 50000.0, retainer.Months[1].OverageRevenue)
+		// 3月: 5時間のみ使用し、15時間を持ち越し
+		assertDecimal(t, 15.0, retainer.Months[2].UnusedHours)
+		// 4月: 持ち越し15時間 + 20時間に対して40時間を使用し、5時間が超過
+		assertDecimal(t, 35.0, retainer.Months[3].AvailableHours)
+		assertDecimal(t, 5.0, retainer.Months[3].OverageHours)
+
+		assertDecimal(t, 90.0, retainer.UsedHours)
+		assertDecimal(t, 10.0, retainer.OverageHours)
+		assertDecimal(t, 1200000.0, retainer.FeeRevenue)
+		assertDecimal(t, 100000.0, retainer.OverageRevenue)
+		assertDecimal(t, 1300000.0, budget.Revenue)
+		assertDecimal(t, 1300000.0, budget.RecognizedRevenue)
+		assertDecimal(t, 0.0, budget.PlannedRevenue)
+		// 90時間 × 5,000円
+		assertDecimal(t, 450000.0, budget.TotalCost)
+		assertDecimal(t, 850000.0, budget.Profit)
+	})
+
+	t.Run("正常: 持ち越しなしの月額顧問は未使用工数が失効する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		fee := decimal.NewFromInt(300000)
+		hours := decimal.NewFromInt(20)
+		project := &models.Project{
+			ContractType:  models.ContractTypeRetainer,
+			RetainerFee:   &fee,
+			RetainerHours: &hours,
+			StartDate:     date("2024-01-01"),
+			EndDate:       date("2024-02-29"),
+		}
+		task, member := createContractProject(t, db, project)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		for workDate, h := range map[string]int64{"2024-01-10": 10, "2024-02-10": 15, "2024-02-11": 15} {
+			_, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+				TaskID: task.ID, MemberID: member.ID, WorkDate: workDate, Hours: decimal.NewFromInt(h),
+			})
+			require.NoError(t, err)
+		}
+
+		budget, err := svc.GetBudget(project.ID)
+		require.NoError(t, err)
+		assertDecimal(t, 20.0, budget.Retainer.Months[1].AvailableHours)
+		assertDecimal(t, 10.0, budget.Retainer.OverageHours)
+		assertDecimal(t, 700000.0, budget.Revenue)
+	})
+
+	t.Run("正常: 月額顧問の残りの月は計画売上になる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		fee := decimal.NewFromInt(300000)
+		hours := decimal.NewFromInt(20)
+		thisMonth := time.Date(time.Now().Year(), time.Now().Month(), 1, 0, 0, 0, 0, time.UTC)
+		startDate := thisMonth.AddDate(0, -1, 0)
+		endDate := thisMonth.AddDate(0, 2, -1)
+		project := &models.Project{
+			ContractType:  models.ContractTypeRetainer,
+			RetainerFee:   &fee,
+			RetainerHours: &hours,
+			StartDate:     &startDate,
+			EndDate:       &endDate,
+		}
+		createContractProject(t, db, project)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		budget, err := svc.GetBudget(project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, budget.Retainer.ContractMonths)
+		assert.Equal(t, 2, budget.Retainer.ElapsedMonths)
+		assertDecimal(t, 600000.0, budget.RecognizedRevenue)
+		assertDecimal(t, 300000.0, budget.PlannedRevenue)
+		assertDecimal(t, 900000.0, budget.Revenue)
+	})
+
+	t.Run("正常: 契約形態の指定がなければ請負として扱う", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		budget, err := svc.UpdateRevenue(project.ID, &dto.UpdateRevenueRequest{Revenue: decimal.NewFromInt(1000000)})
+		require.NoError(t, err)
+		assert.Equal(t, models.ContractTypeFixedPrice, budget.ContractType)
+		assert.Nil(t, budget.Retainer)
+		assertDecimal(t, 1000000.0, budget.Revenue)
+	})
+}
+
+func TestBudgetService_GetBudgetComparison(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return d
+	}
+
+	setup := func(t *testing.T, budgetAmount *decimal.Decimal) (*gorm.DB, *models.Project) {
+		db := setupBudgetTestDB(t)
+		member := createTestMember(t, db)
+		startDate := date("2024-01-01")
+		endDate := date("2024-03-31")
+		project := &models.Project{
+			ID:           uuid.New(),
+			UserID:       uuid.New(),
+			Name:         "比較用プロジェクト",
+			Status:       "in_progress",
+			BudgetAmount: budgetAmount,
+			StartDate:    &startDate,
+			EndDate:      &endDate,
+		}
+		require.NoError(t, db.Create(project).Error)
+		task := createTestTask(t, db, project.ID)
+
+		// 2024-01-01〜2024-01-28 の毎日 4 時間 × 5,000円 = 20,000円/日
+		rate := decimal.NewFromInt(5000)
+		for d := date("2024-01-01"); !d.After(date("2024-01-28")); d = d.AddDate(0, 0, 1) {
+			require.NoError(t, db.Create(&models.TimeEntry{
+				ID:                 uuid.New(),
+				TaskID:             task.ID,
+				MemberID:           member.ID,
+				UserID:             uuid.New(),
+				WorkDate:           d,
+				Hours:              decimal.NewFromInt(4),
+				HourlyRateSnapshot: &rate,
+			}).Error)
+		}
+		return db, project
+	}
+
+	t.Run("正常: 予算内で消化ペースから枯渇日を予測できる", func(t *testing.T) {
+		budgetAmount := decimal.NewFromInt(1000000)
+		db, project := setup(t, &budgetAmount)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetBudgetComparison(project.ID, date("2024-01-28"))
+		require.NoError(t, err)
+
+		assertDecimal(t, 1000000.0, result.PlannedBudget)
+		assertDecimal(t, 560000.0, result.ActualCost)
+		assertDecimal(t, 440000.0, result.Variance)
+		assert.InDelta(t, 44.0, result.VarianceRate, 0.01)
+		assert.False(t, result.IsOverBudget)
+
+		assert.Equal(t, 28, result.BurnRate.WindowDays)
+		assertDecimal(t, 20000.0, result.BurnRate.Daily)
+		assertDecimal(t, 140000.0, result.BurnRate.Weekly)
+
+		// 440,000 / 20,000 = 22日後
+		require.NotNil(t, result.Projection.ExhaustionDate)
+		assert.Equal(t, "2024-02-19", *result.Projection.ExhaustionDate)
+		require.NotNil(t, result.Projection.DaysUntilEndDate)
+		assert.Equal(t, 63, *result.Projection.DaysUntilEndDate)
+		require.NotNil(t, result.Projection.ProjectedCostAtEnd)
+		assertDecimal(t, 560000.0+20000.0*63, *result.Projection.ProjectedCostAtEnd)
+		assert.True(t, result.Projection.ExhaustsBeforeEndDate)
+	})
+
+	t.Run("正常: 基準日以前の実績のみを集計する", func(t *testing.T) {
+		budgetAmount := decimal.NewFromInt(100000)
+		db, project := setup(t, &budgetAmount)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetBudgetComparison(project.ID, date("2024-01-07"))
+		require.NoError(t, err)
+
+		assertDecimal(t, 140000.0, result.ActualCost)
+		assert.True(t, result.IsOverBudget)
+		// プロジェクト開始日から基準日までをウィンドウとする
+		assert.Equal(t, 7, result.BurnRate.WindowDays)
+		assert.Equal(t, "2024-01-01", result.BurnRate.WindowStart)
+		require.NotNil(t, result.Projection.ExhaustionDate)
+		assert.Equal(t, "2024-01-07", *result.Projection.ExhaustionDate)
+	})
+
+	t.Run("正常: 予算未設定の場合は枯渇日を返さない", func(t *testing.T) {
+		db, project := setup(t, nil)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetBudgetComparison(project.ID, date("2024-01-28"))
+		require.NoError(t, err)
+
+		assertDecimal(t, 0.0, result.PlannedBudget)
+		assert.False(t, result.IsOverBudget)
+		assert.Nil(t, result.Projection.ExhaustionDate)
+		assert.False(t, result.Projection.ExhaustsBeforeEndDate)
+	})
+
+	t.Run("異常: 存在しないプロジェクトIDでエラー", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetBudgetComparison(uuid.New(), date("2024-01-28"))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBudgetService_GetCostForecast(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return d
+	}
+
+	setup := func(t *testing.T) (*gorm.DB, *models.Project) {
+		db := setupBudgetTestDB(t)
+		startDate := date("2024-01-01")
+		endDate := date("2024-01-31")
+		budgetAmount := decimal.NewFromInt(500000)
+		project := &models.Project{
+			ID:           uuid.New(),
+			UserID:       uuid.New(),
+			Name:         "見込用プロジェクト",
+			Status:       "in_progress",
+			BudgetAmount: &budgetAmount,
+			StartDate:    &startDate,
+			EndDate:      &endDate,
+		}
+		require.NoError(t, db.Create(project).Error)
+		require.NoError(t, db.Create(&models.Budget{ProjectID: project.ID, Revenue: decimal.NewFromInt(1000000), Currency: "JPY"}).Error)
+		task := createTestTask(t, db, project.ID)
+
+		// メンバーA: 稼働率50%、メンバー単価 5,000円
+		memberA := createTestMember(t, db)
+		require.NoError(t, db.Create(&models.ProjectMember{
+			ProjectID: project.ID, MemberID: memberA.ID, JoinedAt: date("2024-01-01"), AllocationRate: 0.5,
+		}).Error)
+
+		// メンバーB: 1/24 参画、稼働率100%、プロジェクト単価 6,000円
+		memberB := &models.Member{ID: uuid.New(), Name: "メンバーB", Email: "b@example.com", HourlyRate: decimal.NewFromInt(5000)}
+		require.NoError(t, db.Create(memberB).Error)
+		projectRate := decimal.NewFromInt(6000)
+		require.NoError(t, db.Create(&models.ProjectMember{
+			ProjectID: project.ID, MemberID: memberB.ID, JoinedAt: date("2024-01-24"), AllocationRate: 1.0, HourlyRateSnapshot: &projectRate,
+		}).Error)
+
+		// メンバーC: 1/10 離任済みのため見込に含めない
+		memberC := &models.Member{ID: uuid.New(), Name: "メンバーC", Email: "c@example.com", HourlyRate: decimal.NewFromInt(9000)}
+		require.NoError(t, db.Create(memberC).Error)
+		leftAt := date("2024-01-10")
+		require.NoError(t, db.Create(&models.ProjectMember{
+			ProjectID: project.ID, MemberID: memberC.ID, JoinedAt: date("2024-01-01"), LeftAt: &leftAt, AllocationRate: 1.0,
+		}).Error)
+
+		// 実績: 1/10 に 8時間 × 5,000円、基準日後の 1/15 の工数は実績に含めない
+		rate := decimal.NewFromInt(5000)
+		for _, workDate := range []string{"2024-01-10", "2024-01-15"} {
+			require.NoError(t, db.Create(&models.TimeEntry{
+				ID:                 uuid.New(),
+				TaskID:             task.ID,
+				MemberID:           memberA.ID,
+				UserID:             uuid.New(),
+				WorkDate:           date(workDate),
+				Hours:              decimal.NewFromInt(8),
+				HourlyRateSnapshot: &rate,
+				IsBillable:         true,
+			}).Error)
+		}
+
+		// 1/22 は休日
+		require.NoError(t, db.Create(&models.Holiday{Date: date("2024-01-22"), Name: "創立記念日"}).Error)
+		return db, project
+	}
+
+	t.Run("正常: 稼働率と単価から終了日までの人件費を見込む", func(t *testing.T) {
+		db, project := setup(t)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetCostForecast(project.ID, date("2024-01-12"))
+		require.NoError(t, err)
+
+		// 1/13〜1/31 の平日 13日から休日 1日を除く
+		assert.Equal(t, 12, result.RemainingWorkDays)
+		assertDecimal(t, 40000.0, result.ActualCost)
+		require.Len(t, result.Members, 2)
+
+		// A: 12日 × 8時間 × 0.5 = 48時間 × 5,000円
+		assert.Equal(t, "2024-01-13", result.Members[0].From)
+		assert.Equal(t, 12, result.Members[0].WorkDays)
+		assertDecimal(t, 48.0, result.Members[0].Hours)
+		assertDecimal(t, 240000.0, result.Members[0].Cost)
+		assert.Equal(t, models.RateSourceMember, result.Members[0].RateSource)
+
+		// B: 1/24〜1/31 の 6日 × 8時間 = 48時間 × 6,000円
+		assert.Equal(t, "2024-01-24", result.Members[1].From)
+		assert.Equal(t, 6, result.Members[1].WorkDays)
+		assertDecimal(t, 288000.0, result.Members[1].Cost)
+		assert.Equal(t, models.RateSourceProject, result.Members[1].RateSource)
+
+		assertDecimal(t, 528000.0, result.ForecastLaborCost)
+		assertDecimal(t, 568000.0, result.EstimatedTotalCost)
+		assertDecimal(t, 432000.0, result.EstimatedProfit)
+		assertDecimal(t, 43.2, result.EstimatedProfitRate)
+		require.NotNil(t, result.EstimatedVariance)
+		assertDecimal(t, -68000.0, *result.EstimatedVariance)
+	})
+
+	t.Run("正常: 見込の取得では予算とスナップショットを保存しない", func(t *testing.T) {
+		db, project := setup(t)
+		require.NoError(t, db.Where("project_id = ?", project.ID).Delete(&models.Budget{}).Error)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetCostForecast(project.ID, date("2024-01-12"))
+		require.NoError(t, err)
+		assert.Equal(t, "JPY", result.Currency)
+		assertDecimal(t, 568000.0, result.EstimatedTotalCost)
+
+		var budgetCount, snapshotCount int64
+		require.NoError(t, db.Model(&models.Budget{}).Where("project_id = ?", project.ID).Count(&budgetCount).Error)
+		require.NoError(t, db.Model(&models.BudgetSnapshot{}).Where("project_id = ?", project.ID).Count(&snapshotCount).Error)
+		assert.Equal(t, int64(0), budgetCount)
+		assert.Equal(t, int64(0), snapshotCount)
+	})
+
+	t.Run("正常: 終了日を過ぎている場合は実績のみとなる", func(t *testing.T) {
+		db, project := setup(t)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetCostForecast(project.ID, date("2024-02-15"))
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, result.RemainingWorkDays)
+		assert.Empty(t, result.Members)
+		assertDecimal(t, 0.0, result.ForecastLaborCost)
+		assertDecimal(t, 80000.0, result.EstimatedTotalCost)
+	})
+
+	t.Run("異常: 終了日が未設定の場合はエラー", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetCostForecast(project.ID, date("2024-01-12"))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("異常: 存在しないプロジェクトIDでエラー", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.GetCostForecast(uuid.New(), date("2024-01-12"))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBudgetService_GetBudgetHistory(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return d
+	}
+
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+
+	// 2024-01-03(水)・01-05(金)・01-08(月)・02-01 のスナップショット
+	for _, s := range []struct {
+		date    string
+		revenue int64
+		cost    int64
+	}{
+		{"2024-01-03", 100000, 120000},
+		{"2024-01-05", 200000, 150000},
+		{"2024-01-08", 200000, 180000},
+		{"2024-02-01", 300000, 250000},
+	} {
+		budget := &models.Budget{
+			ProjectID: project.ID,
+			Revenue:   decimal.NewFromInt(s.revenue),
+			TotalCost: decimal.NewFromInt(s.cost),
+		}
+		budget.CalculateProfit()
+		require.NoError(t, db.Create(&models.BudgetSnapshot{
+			ID:           uuid.New(),
+			ProjectID:    project.ID,
+			SnapshotDate: date(s.date),
+			Source:       models.BudgetSnapshotSourceDaily,
+			Revenue:      budget.Revenue,
+			TotalCost:    budget.TotalCost,
+			Profit:       budget.Profit,
+			ProfitRate:   budget.ProfitRate,
+			Currency:     "JPY",
+		}).Error)
+	}
+
+	svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+
+	t.Run("正常: 日次の推移を取得できる", func(t *testing.T) {
+		result, err := svc.GetBudgetHistory(project.ID, "", repository.DateRange{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "day", result.Granularity)
+		require.Len(t, result.Points, 4)
+		assert.Equal(t, "2024-01-03", result.Points[0].SnapshotDate)
+		assertDecimal(t, -20000.0, result.Points[0].Profit)
+		assert.True(t, result.Points[0].IsDeficit)
+		assert.False(t, result.Points[1].IsDeficit)
+	})
+
+	t.Run("正常: 週次は月曜始まりで週末時点の値を返す", func(t *testing.T) {
+		result, err := svc.GetBudgetHistory(project.ID, "week", repository.DateRange{})
+		require.NoError(t, err)
+
+		require.Len(t, result.Points, 3)
+		assert.Equal(t, "2024-01-01", result.Points[0].PeriodStart)
+		assert.Equal(t, "2024-01-05", result.Points[0].SnapshotDate)
+		assertDecimal(t, 50000.0, result.Points[0].Profit)
+		assert.Equal(t, "2024-01-08", result.Points[1].PeriodStart)
+		assert.Equal(t, "2024-01-29", result.Points[2].PeriodStart)
+	})
+
+	t.Run("正常: 月次で期間を絞り込める", func(t *testing.T) {
+		from := date("2024-01-04")
+		result, err := svc.GetBudgetHistory(project.ID, "month", repository.DateRange{From: &from})
+		require.NoError(t, err)
+
+		require.Len(t, result.Points, 2)
+		assert.Equal(t, "2024-01-01", result.Points[0].PeriodStart)
+		assert.Equal(t, "2024-01-08", result.Points[0].SnapshotDate)
+		assertDecimal(t, 20000.0, result.Points[0].Profit)
+		assert.Equal(t, "2024-02-01", result.Points[1].PeriodStart)
+	})
+
+	t.Run("正常: 予算の取得と売上変更でスナップショットを記録する", func(t *testing.T) {
+		other := createTestProject(t, db)
+
+		_, err := svc.GetBudget(other.ID)
+		require.NoError(t, err)
+		_, err = svc.GetBudget(other.ID)
+		require.NoError(t, err)
+		_, err = svc.UpdateRevenue(other.ID, &dto.UpdateRevenueRequest{Revenue: decimal.NewFromInt(500000)})
+		require.NoError(t, err)
+
+		var snapshots []models.BudgetSnapshot
+		require.NoError(t, db.Where("project_id = ?", other.ID).Order("source ASC").Find(&snapshots).Error)
+		// 日次スナップショットは1日1件に集約される
+		require.Len(t, snapshots, 2)
+		assert.Equal(t, models.BudgetSnapshotSourceDaily, snapshots[0].Source)
+		assert.Equal(t, models.BudgetSnapshotSourceRevenueChange, snapshots[1].Source)
+		assertDecimal(t, 500000.0, snapshots[1].Revenue)
+
+		result, err := svc.GetBudgetHistory(other.ID, "day", repository.DateRange{})
+		require.NoError(t, err)
+		require.Len(t, result.Points, 1)
+		assertDecimal(t, 500000.0, result.Points[0].Revenue)
+	})
+
+	t.Run("正常: 全プロジェクトの日次スナップショットを記録できる", func(t *testing.T) {
+		captured, err := svc.CaptureDailySnapshots()
+		require.NoError(t, err)
+		assert.Equal(t, 2, captured)
+	})
+
+	t.Run("異常: 無効な集計単位でエラー", func(t *testing.T) {
+		result, err := svc.GetBudgetHistory(project.ID, "year", repository.DateRange{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("異常: 存在しないプロジェクトIDでエラー", func(t *testing.T) {
+		result, err := svc.GetBudgetHistory(uuid.New(), "day", repository.DateRange{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
 func TestBudget_CalculateProfit(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -556,14 +2075,14 @@ func TestBudget_CalculateProfit(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			budget := &models.Budget{
-				Revenue:   tt.revenue,
-				TotalCost: tt.totalCost,
+				Revenue:   decimal.NewFromFloat(tt.revenue),
+				TotalCost: decimal.NewFromFloat(tt.totalCost),
 			}
 
 			budget.CalculateProfit()
 
-			assert.Equal(t, tt.expectedProfit, budget.Profit)
-			assert.InDelta(t, tt.expectedRate, budget.ProfitRate, 0.01)
+			assertDecimal(t, tt.expectedProfit, budget.Profit)
+			assert.InDelta(t, tt.expectedRate, budget.ProfitRate.InexactFloat64(), 0.01)
 		})
 	}
 }
@@ -572,13 +2091,13 @@ func TestTimeEntry_Cost(t *testing.T) {
 	tests := []struct {
 		name         string
 		hours        float64
-		hourlyRate   *float64
+		hourlyRate   *decimal.Decimal
 		expectedCost float64
 	}{
 		{
 			name:         "正常: コスト計算",
 			hours:        8,
-			hourlyRate:   func() *float64 { r := 5000.0; return &r }(),
+			hourlyRate:   func() *decimal.Decimal { r := decimal.NewFromInt(5000); return &r }(),
 			expectedCost: 40000,
 		},
 		{
@@ -590,13 +2109,13 @@ func TestTimeEntry_Cost(t *testing.T) {
 		{
 			name:         "正常: 0時間の場合",
 			hours:        0,
-			hourlyRate:   func() *float64 { r := 5000.0; return &r }(),
+			hourlyRate:   func() *decimal.Decimal { r := decimal.NewFromInt(5000); return &r }(),
 			expectedCost: 0,
 		},
 		{
 			name:         "正常: 小数時間の場合",
 			hours:        2.5,
-			hourlyRate:   func() *float64 { r := 4000.0; return &r }(),
+			hourlyRate:   func() *decimal.Decimal { r := decimal.NewFromInt(4000); return &r }(),
 			expectedCost: 10000,
 		},
 	}
@@ -604,13 +2123,13 @@ func TestTimeEntry_Cost(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			entry := &models.TimeEntry{
-				Hours:              tt.hours,
+				Hours:              decimal.NewFromFloat(tt.hours),
 				HourlyRateSnapshot: tt.hourlyRate,
 			}
 
 			cost := entry.Cost()
 
-			assert.Equal(t, tt.expectedCost, cost)
+			assertDecimal(t, tt.expectedCost, cost)
 		})
 	}
 }
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// createChangeOrderTestProject は予算額・終了日・売上を設定したテスト用プロジェクトを作成
+func createChangeOrderTestProject(t *testing.T, db *gorm.DB) *models.Project {
+	project := createTestProject(t, db)
+	budgetAmount := decimal.NewFromInt(1000000)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, db.Model(project).Updates(map[string]interface{}{
+		"budget_amount": budgetAmount,
+		"start_date":    start,
+		"end_date":      end,
+	}).Error)
+
+	_, err := service.NewBudgetService(db, service.DefaultDailyHoursLimit()).UpdateRevenue(project.ID, &dto.UpdateRevenueRequest{Revenue: decimal.NewFromInt(2000000)})
+	require.NoError(t, err)
+	return project
+}
+
+func TestChangeOrderService_CreateChangeOrder(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createChangeOrderTestProject(t, db)
+	svc := service.NewChangeOrderService(db, service.DefaultDailyHoursLimit())
+	userID := uuid.New()
+
+	t.Run("正常: 申請中で作成される", func(t *testing.T) {
+		budgetAmount := decimal.NewFromInt(1200000)
+		order, err := svc.CreateChangeOrder(project.ID, userID, &dto.CreateChangeOrderRequest{
+			Title:        "追加機能",
+			Reason:       "顧客要望による機能追加",
+			BudgetAmount: &budgetAmount,
+			CostImpact:   decimal.NewFromInt(200000),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.ChangeOrderStatusSubmitted, order.Status)
+		assert.Equal(t, userID, order.RequestedBy)
+		assert.Equal(t, "JPY", order.Currency)
+
+		// 承認されるまでプロジェクトは変わらない
+		var stored models.Project
+		require.NoError(t, db.First(&stored, "id = ?", project.ID).Error)
+		assertDecimal(t, 1000000.0, *stored.BudgetAmount)
+	})
+
+	t.Run("異常: 変更内容がない", func(t *testing.T) {
+		_, err := svc.CreateChangeOrder(project.ID, userID, &dto.CreateChangeOrderRequest{Title: "変更なし", Reason: "理由"})
+		require.Error(t, err)
+	})
+
+	t.Run("異常: 終了日が開始日より前", func(t *testing.T) {
+		endDate := "2023-12-31"
+		_, err := svc.CreateChangeOrder(project.ID, userID, &dto.CreateChangeOrderRequest{Title: "短縮", Reason: "理由", EndDate: &endDate})
+		require.Error(t, err)
+	})
+
+	t.Run("異常: 契約から売上が決まるプロジェクトの売上変更", func(t *testing.T) {
+		tm := createTestProject(t, db)
+		require.NoError(t, db.Model(tm).Update("contract_type", models.ContractTypeTimeAndMaterials).Error)
+
+		revenue := decimal.NewFromInt(3000000)
+		_, err := svc.CreateChangeOrder(tm.ID, userID, &dto.CreateChangeOrderRequest{Title: "増額", Reason: "理由", Revenue: &revenue})
+		require.Error(t, err)
+	})
+
+	t.Run("異常: 存在しないプロジェクト", func(t *testing.T) {
+		budgetAmount := decimal.NewFromInt(1200000)
+		_, err := svc.CreateChangeOrder(uuid.New(), userID, &dto.CreateChangeOrderRequest{Title: "追加", Reason: "理由", BudgetAmount: &budgetAmount})
+		require.Error(t, err)
+	})
+}
+
+func TestChangeOrderService_Review(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createChangeOrderTestProject(t, db)
+	svc := service.NewChangeOrderService(db, service.DefaultDailyHoursLimit())
+	requesterID := uuid.New()
+	reviewerID := uuid.New()
+
+	budgetAmount := decimal.NewFromInt(1200000)
+	revenue := decimal.NewFromInt(2300000)
+	scopeChange, err := svc.CreateChangeOrder(project.ID, requesterID, &dto.CreateChangeOrderRequest{
+		Title:        "追加機能",
+		Reason:       "顧客要望による機能追加",
+		BudgetAmount: &budgetAmount,
+		Revenue:      &revenue,
+		CostImpact:   decimal.NewFromInt(200000),
+	})
+	require.NoError(t, err)
+
+	endDate := "2024-09-30"
+	extension, err := svc.CreateChangeOrder(project.ID, requesterID, &dto.CreateChangeOrderRequest{
+		Title:      "期間延長",
+		Reason:     "追加機能に伴う延長",
+		EndDate:    &endDate,
+		CostImpact: decimal.NewFromInt(50000),
+	})
+	require.NoError(t, err)
+
+	t.Run("正常: 承認でプロジェクトと予算に反映され、変更前の値が残る", func(t *testing.T) {
+		comment := "承認します"
+		approved, err := svc.ApproveChangeOrder(project.ID, scopeChange.ID, reviewerID, &dto.ReviewChangeOrderRequest{Comment: &comment})
+		require.NoError(t, err)
+		assert.Equal(t, models.ChangeOrderStatusApproved, approved.Status)
+		assert.Equal(t, reviewerID, *approved.ReviewedBy)
+		assert.Equal(t, comment, *approved.ReviewComment)
+		assertDecimal(t, 1000000.0, *approved.PreviousBudgetAmount)
+		assertDecimal(t, 2000000.0, *approved.PreviousRevenue)
+		assert.Nil(t, approved.PreviousEndDate)
+
+		var stored models.Project
+		require.NoError(t, db.First(&stored, "id = ?", project.ID).Error)
+		assertDecimal(t, 1200000.0, *stored.BudgetAmount)
+
+		var budget models.Budget
+		require.NoError(t, db.First(&budget, "project_id = ?", project.ID).Error)
+		assertDecimal(t, 2300000.0, budget.Revenue)
+
+		var snapshots int64
+		require.NoError(t, db.Model(&models.BudgetSnapshot{}).
+			Where("project_id = ? AND source = ?", project.ID, models.BudgetSnapshotSourceChangeOrder).
+			Count(&snapshots).Error)
+		assert.Equal(t, int64(1), snapshots)
+	})
+
+	t.Run("異常: 審査済みの変更申請は再度承認できない", func(t *testing.T) {
+		_, err := svc.ApproveChangeOrder(project.ID, scopeChange.ID, reviewerID, nil)
+		require.Error(t, err)
+		_, err = svc.RejectChangeOrder(project.ID, scopeChange.ID, reviewerID, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("正常: 却下ではプロジェクトは変わらない", func(t *testing.T) {
+		shrink := decimal.NewFromInt(500000)
+		order, err := svc.CreateChangeOrder(project.ID, requesterID, &dto.CreateChangeOrderRequest{
+			Title: "減額", Reason: "範囲縮小", BudgetAmount: &shrink,
+		})
+		require.NoError(t, err)
+
+		rejected, err := svc.RejectChangeOrder(project.ID, order.ID, reviewerID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, models.ChangeOrderStatusRejected, rejected.Status)
+
+		var stored models.Project
+		require.NoError(t, db.First(&stored, "id = ?", project.ID).Error)
+		assertDecimal(t, 1200000.0, *stored.BudgetAmount)
+	})
+
+	t.Run("正常: 履歴でベースラインと現在の予算を比較できる", func(t *testing.T) {
+		_, err := svc.ApproveChangeOrder(project.ID, extension.ID, reviewerID, nil)
+		require.NoError(t, err)
+
+		history, err := service.NewBudgetService(db, service.DefaultDailyHoursLimit()).GetBudgetHistory(project.ID, "", repository.DateRange{})
+		require.NoError(t, err)
+
+		baseline := history.Baseline
+		assert.Equal(t, 2, baseline.ChangeOrderCount)
+		assertDecimal(t, 250000.0, baseline.CostImpact)
+		assertDecimal(t, 1000000.0, *baseline.Baseline.BudgetAmount)
+		assertDecimal(t, 2000000.0, baseline.Baseline.Revenue)
+		assert.Equal(t, "2024-06-30", *baseline.Baseline.EndDate)
+		assertDecimal(t, 1200000.0, *baseline.Current.BudgetAmount)
+		assertDecimal(t, 2300000.0, baseline.Current.Revenue)
+		assert.Equal(t, "2024-09-30", *baseline.Current.EndDate)
+
+		orders, err := svc.ListChangeOrders(project.ID, models.ChangeOrderStatusApproved)
+		require.NoError(t, err)
+		assert.Len(t, orders.ChangeOrders, 2)
+	})
+
+	t.Run("異常: 他プロジェクトの変更申請", func(t *testing.T) {
+		other := createTestProject(t, db)
+		_, err := svc.GetChangeOrder(other.ID, scopeChange.ID)
+		require.Error(t, err)
+	})
+}
+
+func TestProjectService_UpdateProject_ChangeOrderFields(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	svc := service.NewProjectServiceWithDB(db)
+
+	t.Run("正常: 計画中は予算額を直接変更できる", func(t *testing.T) {
+		project := createTestProject(t, db)
+		require.NoError(t, db.Model(project).Update("status", "planning").Error)
+
+		budgetAmount := decimal.NewFromInt(1500000)
+		result, err := svc.UpdateProject(project.ID.String(), project.UserID.String(), dto.UpdateProjectRequest{BudgetAmount: &budgetAmount})
+		require.NoError(t, err)
+		assertDecimal(t, 1500000.0, *result.BudgetAmount)
+	})
+
+	t.Run("異常: 進行中のプロジェクトの予算額・終了日は変更申請が必要", func(t *testing.T) {
+		project := createChangeOrderTestProject(t, db)
+
+		budgetAmount := decimal.NewFromInt(1500000)
+		_, err := svc.UpdateProject(project.ID.String(), project.UserID.String(), dto.UpdateProjectRequest{BudgetAmount: &budgetAmount})
+		require.Error(t, err)
+
+		endDate := "2024-12-31"
+		_, err = svc.UpdateProject(project.ID.String(), project.UserID.String(), dto.UpdateProjectRequest{EndDate: &endDate})
+		require.Error(t, err)
+
+		// 値が変わらなければ他の項目と一緒に送っても更新できる
+		sameAmount := decimal.NewFromInt(1000000)
+		sameEndDate := "2024-06-30"
+		name := "名称変更"
+		result, err := svc.UpdateProject(project.ID.String(), project.UserID.String(), dto.UpdateProjectRequest{
+			Name: &name, BudgetAmount: &sameAmount, EndDate: &sameEndDate,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, name, result.Name)
+	})
+	t.Run("異常: 進行中のプロジェクトは計画中に戻せない", func(t *testing.T) {
+		project := createChangeOrderTestProject(t, db)
+
+		planning := "planning"
+		_, err := svc.UpdateProject(project.ID.String(), project.UserID.String(), dto.UpdateProjectRequest{Status: &planning})
+		assertAppErrorCode(t, "CONFLICT", err)
+	})
+
+	t.Run("異常: ステータスと予算額・終了日は同時に変更できない", func(t *testing.T) {
+		project := createTestProject(t, db)
+		require.NoError(t, db.Model(project).Update("status", "planning").Error)
+
+		inProgress := "in_progress"
+		budgetAmount := decimal.NewFromInt(1500000)
+		_, err := svc.UpdateProject(project.ID.String(), project.UserID.String(), dto.UpdateProjectRequest{
+			Status: &inProgress, BudgetAmount: &budgetAmount,
+		})
+		assertAppErrorCode(t, "CONFLICT", err)
+
+		endDate := "2024-12-31"
+		_, err = svc.UpdateProject(project.ID.String(), project.UserID.String(), dto.UpdateProjectRequest{
+			Status: &inProgress, EndDate: &endDate,
+		})
+		assertAppErrorCode(t, "CONFLICT", err)
+
+		// ステータスだけなら変更できる
+		result, err := svc.UpdateProject(project.ID.String(), project.UserID.String(), dto.UpdateProjectRequest{Status: &inProgress})
+		require.NoError(t, err)
+		assert.Equal(t, inProgress, result.Status)
+	})
+}
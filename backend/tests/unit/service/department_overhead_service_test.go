@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+func TestDepartmentOverheadService(t *testing.T) {
+	t.Run("正常: 適用開始日ごとの間接費係数を作成・更新・削除できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewDepartmentOverheadService(db)
+
+		first, err := svc.CreateDepartmentOverhead(&dto.CreateDepartmentOverheadRequest{
+			Department:    "開発部",
+			Multiplier:    decimal.NewFromFloat(1.45),
+			EffectiveFrom: "2024-01-01",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-01", first.EffectiveFrom)
+		_, err = svc.CreateDepartmentOverhead(&dto.CreateDepartmentOverheadRequest{
+			Department:    "開発部",
+			Multiplier:    decimal.NewFromFloat(1.5),
+			EffectiveFrom: "2024-04-01",
+		})
+		require.NoError(t, err)
+		_, err = svc.CreateDepartmentOverhead(&dto.CreateDepartmentOverheadRequest{
+			Department:    "営業部",
+			Multiplier:    decimal.NewFromFloat(1.2),
+			EffectiveFrom: "2024-01-01",
+		})
+		require.NoError(t, err)
+
+		multiplier := decimal.NewFromFloat(1.4)
+		updated, err := svc.UpdateDepartmentOverhead(first.ID, &dto.UpdateDepartmentOverheadRequest{Multiplier: &multiplier})
+		require.NoError(t, err)
+		assertDecimal(t, 1.4, updated.Multiplier)
+
+		department := "開発部"
+		list, err := svc.ListDepartmentOverheads(&department)
+		require.NoError(t, err)
+		require.Len(t, list.Overheads, 2)
+		assert.Equal(t, "2024-04-01", list.Overheads[1].EffectiveFrom)
+
+		require.NoError(t, svc.DeleteDepartmentOverhead(first.ID))
+		list, err = svc.ListDepartmentOverheads(nil)
+		require.NoError(t, err)
+		assert.Len(t, list.Overheads, 2)
+	})
+
+	t.Run("異常: 同じ部署・同じ適用開始日の係数は重複して作成できない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewDepartmentOverheadService(db)
+
+		req := &dto.CreateDepartmentOverheadRequest{Department: "開発部", Multiplier: decimal.NewFromFloat(1.5), EffectiveFrom: "2024-01-01"}
+		_, err := svc.CreateDepartmentOverhead(req)
+		require.NoError(t, err)
+		_, err = svc.CreateDepartmentOverhead(req)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常: 存在しない係数は更新できない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewDepartmentOverheadService(db)
+
+		multiplier := decimal.NewFromFloat(1.5)
+		_, err := svc.UpdateDepartmentOverhead(uuid.New(), &dto.UpdateDepartmentOverheadRequest{Multiplier: &multiplier})
+		assert.Error(t, err)
+	})
+}
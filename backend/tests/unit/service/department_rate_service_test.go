@@ -0,0 +1,61 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+func TestDepartmentRateService(t *testing.T) {
+	t.Run("正常: 部署の既定単価を作成・更新・削除できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewDepartmentRateService(db)
+
+		created, err := svc.CreateDepartmentRate(&dto.CreateDepartmentRateRequest{
+			Department: "開発部",
+			HourlyRate: decimal.NewFromInt(4000),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "JPY", created.Currency)
+
+		rate := decimal.NewFromInt(4500)
+		updated, err := svc.UpdateDepartmentRate(created.ID, &dto.UpdateDepartmentRateRequest{HourlyRate: &rate})
+		require.NoError(t, err)
+		assertDecimal(t, 4500.0, updated.HourlyRate)
+
+		list, err := svc.ListDepartmentRates()
+		require.NoError(t, err)
+		require.Len(t, list.Rates, 1)
+
+		require.NoError(t, svc.DeleteDepartmentRate(created.ID))
+		list, err = svc.ListDepartmentRates()
+		require.NoError(t, err)
+		assert.Empty(t, list.Rates)
+	})
+
+	t.Run("異常: 同じ部署の単価は重複して作成できない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewDepartmentRateService(db)
+
+		req := &dto.CreateDepartmentRateRequest{Department: "開発部", HourlyRate: decimal.NewFromInt(4000)}
+		_, err := svc.CreateDepartmentRate(req)
+		require.NoError(t, err)
+		_, err = svc.CreateDepartmentRate(req)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常: 存在しない単価は更新できない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewDepartmentRateService(db)
+
+		rate := decimal.NewFromInt(4500)
+		_, err := svc.UpdateDepartmentRate(uuid.New(), &dto.UpdateDepartmentRateRequest{HourlyRate: &rate})
+		assert.Error(t, err)
+	})
+}
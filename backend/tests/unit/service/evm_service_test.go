@@ -0,0 +1,138 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+func TestEVMService_GetEVM(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return d
+	}
+
+	db := setupBudgetTestDB(t)
+	member := createTestMember(t, db)
+
+	budgetAmount := decimal.NewFromInt(1000000)
+	projectStart, projectEnd := date("2024-01-01"), date("2024-01-10")
+	project := &models.Project{
+		ID:           uuid.New(),
+		UserID:       uuid.New(),
+		Name:         "EVMプロジェクト",
+		Status:       "in_progress",
+		BudgetAmount: &budgetAmount,
+		StartDate:    &projectStart,
+		EndDate:      &projectEnd,
+	}
+	require.NoError(t, db.Create(project).Error)
+
+	// 設計: 60h（完了）、実装: 40h（進行中）→ BAC を 600,000 / 400,000 に按分
+	designStart, designEnd := date("2024-01-01"), date("2024-01-05")
+	design := &models.Task{
+		ID: uuid.New(), ProjectID: project.ID, Name: "設計", Status: "completed",
+		PlannedHours: decimal.NewFromInt(60), StartDate: &designStart, EndDate: &designEnd,
+	}
+	buildStart, buildEnd := date("2024-01-06"), date("2024-01-10")
+	build := &models.Task{
+		ID: uuid.New(), ProjectID: project.ID, Name: "実装", Status: "in_progress",
+		PlannedHours: decimal.NewFromInt(40), StartDate: &buildStart, EndDate: &buildEnd,
+	}
+	require.NoError(t, db.Create(design).Error)
+	require.NoError(t, db.Create(build).Error)
+
+	rate := decimal.NewFromInt(5000)
+	for _, e := range []struct {
+		taskID uuid.UUID
+		date   string
+		hours  float64
+	}{
+		{design.ID, "2024-01-02", 20},
+		{design.ID, "2024-01-04", 20},
+		{build.ID, "2024-01-06", 10},
+	} {
+		require.NoError(t, db.Create(&models.TimeEntry{
+			ID:                 uuid.New(),
+			TaskID:             e.taskID,
+			MemberID:           member.ID,
+			UserID:             uuid.New(),
+			WorkDate:           date(e.date),
+			Hours:              decimal.NewFromFloat(e.hours),
+			HourlyRateSnapshot: &rate,
+		}).Error)
+	}
+	expense := createTestExpense(t, db, project.ID, "license", 50000)
+	expense.IncurredDate = date("2024-01-03")
+	require.NoError(t, db.Save(expense).Error)
+
+	svc := service.NewEVMService(db)
+
+	t.Run("正常: 基準日時点のEVM指標を算出できる", func(t *testing.T) {
+		result, err := svc.GetEVM(project.ID, date("2024-01-06"))
+		require.NoError(t, err)
+
+		assertDecimal(t, 1000000.0, result.BudgetAtCompletion)
+		// PV: 設計 600,000 + 実装 400,000 × 1/5
+		assertDecimal(t, 680000.0, result.PlannedValue)
+		// EV: 設計 600,000 + 実装 400,000 × 10/40
+		assertDecimal(t, 700000.0, result.EarnedValue)
+		// AC: 人件費 250,000 + 経費 50,000
+		assertDecimal(t, 300000.0, result.ActualCost)
+		assertDecimal(t, 400000.0, result.CostVariance)
+		assertDecimal(t, 20000.0, result.ScheduleVariance)
+
+		require.NotNil(t, result.CPI)
+		require.NotNil(t, result.SPI)
+		assert.InDelta(t, 700000.0/300000.0, *result.CPI, 0.0001)
+		assert.InDelta(t, 700000.0/680000.0, *result.SPI, 0.0001)
+		// EAC: 1,000,000 ÷ CPI を円単位に丸める
+		assertDecimal(t, 428571.0, result.EstimateAtCompletion)
+		assertDecimal(t, 128571.0, result.EstimateToComplete)
+		assertDecimal(t, 571429.0, result.VarianceAtCompletion)
+		assert.InDelta(t, 70.0, result.PercentComplete, 0.01)
+	})
+
+	t.Run("正常: 日次の累積系列（Sカーブ）を返す", func(t *testing.T) {
+		result, err := svc.GetEVM(project.ID, date("2024-01-06"))
+		require.NoError(t, err)
+
+		require.Len(t, result.Series, 6)
+		assert.Equal(t, "2024-01-01", result.Series[0].Date)
+		assert.Equal(t, "2024-01-06", result.Series[5].Date)
+
+		// 2024-01-02: 設計の計画 2/5、実績 20/40 時間
+		day2 := result.Series[1]
+		assertDecimal(t, 240000.0, day2.PlannedValue)
+		assertDecimal(t, 300000.0, day2.EarnedValue)
+		assertDecimal(t, 100000.0, day2.ActualCost)
+
+		// 累積値は単調増加
+		for i := 1; i < len(result.Series); i++ {
+			assert.True(t, result.Series[i].PlannedValue.GreaterThanOrEqual(result.Series[i-1].PlannedValue))
+			assert.True(t, result.Series[i].ActualCost.GreaterThanOrEqual(result.Series[i-1].ActualCost))
+		}
+	})
+
+	t.Run("正常: 過去の基準日では完了タスクも部分的な出来高になる", func(t *testing.T) {
+		result, err := svc.GetEVM(project.ID, date("2024-01-03"))
+		require.NoError(t, err)
+
+		assertDecimal(t, 300000.0, result.EarnedValue)
+		assertDecimal(t, 150000.0, result.ActualCost)
+	})
+
+	t.Run("異常: 存在しないプロジェクトIDでエラー", func(t *testing.T) {
+		result, err := svc.GetEVM(uuid.New(), date("2024-01-06"))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
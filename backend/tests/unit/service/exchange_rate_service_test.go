@@ -0,0 +1,222 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+func TestExchangeRateService_CreateExchangeRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *dto.CreateExchangeRateRequest
+		wantErr bool
+	}{
+		{
+			name:    "正常: 為替レートを登録できる",
+			req:     &dto.CreateExchangeRateRequest{RateDate: "2024-01-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(150)},
+			wantErr: false,
+		},
+		{
+			name:    "異常: 同一通貨ペアでエラー",
+			req:     &dto.CreateExchangeRateRequest{RateDate: "2024-01-01", FromCurrency: "JPY", ToCurrency: "JPY", Rate: decimal.NewFromInt(1)},
+			wantErr: true,
+		},
+		{
+			name:    "異常: 無効な日付形式でエラー",
+			req:     &dto.CreateExchangeRateRequest{RateDate: "invalid-date", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(150)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupBudgetTestDB(t)
+			svc := service.NewExchangeRateService(db)
+
+			result, err := svc.CreateExchangeRate(tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.req.RateDate, result.RateDate)
+				assert.Equal(t, tt.req.Rate, result.Rate)
+			}
+		})
+	}
+
+	t.Run("異常: 同一日付・通貨ペアの重複登録でエラー", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewExchangeRateService(db)
+		req := &dto.CreateExchangeRateRequest{RateDate: "2024-01-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(150)}
+
+		_, err := svc.CreateExchangeRate(req)
+		require.NoError(t, err)
+
+		_, err = svc.CreateExchangeRate(req)
+		require.Error(t, err)
+		appErr, ok := err.(*apperrors.AppError)
+		require.True(t, ok)
+		assert.Equal(t, "ALREADY_EXISTS", appErr.Code)
+	})
+}
+
+func TestExchangeRateService_ImportExchangeRates(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	svc := service.NewExchangeRateService(db)
+
+	result, err := svc.ImportExchangeRates(&dto.ImportExchangeRatesRequest{
+		Rates: []dto.CreateExchangeRateRequest{
+			{RateDate: "2024-01-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(145)},
+			{RateDate: "2024-01-01", FromCurrency: "EUR", ToCurrency: "JPY", Rate: decimal.NewFromInt(158)},
+			{RateDate: "2024-01-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(148)},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Imported)
+
+	t.Run("正常: 既存の日付・通貨ペアは上書きされる", func(t *testing.T) {
+		_, err := svc.ImportExchangeRates(&dto.ImportExchangeRatesRequest{
+			Rates: []dto.CreateExchangeRateRequest{
+				{RateDate: "2024-01-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(150)},
+			},
+		})
+		require.NoError(t, err)
+
+		list, err := svc.ListExchangeRates(repository.ExchangeRateListParams{FromCurrency: "USD"})
+		require.NoError(t, err)
+		require.Len(t, list.ExchangeRates, 1)
+		assertDecimal(t, 150.0, list.ExchangeRates[0].Rate)
+		assert.Equal(t, int64(1), list.Pagination.Total)
+	})
+
+	t.Run("異常: 不正な行があれば何も取り込まない", func(t *testing.T) {
+		_, err := svc.ImportExchangeRates(&dto.ImportExchangeRatesRequest{
+			Rates: []dto.CreateExchangeRateRequest{
+				{RateDate: "2024-02-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(149)},
+				{RateDate: "invalid-date", FromCurrency: "EUR", ToCurrency: "JPY", Rate: decimal.NewFromInt(160)},
+			},
+		})
+		assert.Error(t, err)
+
+		list, err := svc.ListExchangeRates(repository.ExchangeRateListParams{})
+		require.NoError(t, err)
+		assert.Len(t, list.ExchangeRates, 2)
+	})
+}
+
+func TestBudgetService_GetBudgetSummary_ConvertsCurrencies(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+
+	member := &models.Member{
+		ID:         uuid.New(),
+		Name:       "海外メンバー",
+		Email:      "overseas@example.com",
+		HourlyRate: decimal.NewFromInt(50),
+		Currency:   "USD",
+	}
+	require.NoError(t, db.Create(member).Error)
+
+	// 10時間 × 50 USD = 500 USD
+	rate := member.HourlyRate
+	require.NoError(t, db.Create(&models.TimeEntry{
+		ID:                 uuid.New(),
+		TaskID:             task.ID,
+		MemberID:           member.ID,
+		UserID:             uuid.New(),
+		WorkDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		Hours:              decimal.NewFromInt(10),
+		HourlyRateSnapshot: &rate,
+		Currency:           "USD",
+	}).Error)
+
+	// 100 EUR の経費
+	expense := createTestExpense(t, db, project.ID, "license", 100)
+	expense.Currency = "EUR"
+	require.NoError(t, db.Save(expense).Error)
+
+	rateSvc := service.NewExchangeRateService(db)
+	budgetSvc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("異常: 為替レートが未登録の場合はエラー", func(t *testing.T) {
+		_, err := budgetSvc.GetBudgetSummary(project.ID, repository.DateRange{To: &to})
+		require.Error(t, err)
+		appErr, ok := err.(*apperrors.AppError)
+		require.True(t, ok)
+		assert.Equal(t, "EXCHANGE_RATE_NOT_FOUND", appErr.Code)
+	})
+
+	_, err := rateSvc.ImportExchangeRates(&dto.ImportExchangeRatesRequest{
+		Rates: []dto.CreateExchangeRateRequest{
+			{RateDate: "2024-01-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(150)},
+			// 基準日より後のレートは使用しない
+			{RateDate: "2024-02-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(200)},
+			// 逆方向のレートから換算できる
+			{RateDate: "2024-01-01", FromCurrency: "JPY", ToCurrency: "EUR", Rate: decimal.RequireFromString("0.00625")},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("正常: 予算通貨に換算して集計する", func(t *testing.T) {
+		summary, err := budgetSvc.GetBudgetSummary(project.ID, repository.DateRange{To: &to})
+		require.NoError(t, err)
+
+		assert.Equal(t, "JPY", summary.Budget.Currency)
+		// 500 USD × 150 = 75,000 JPY
+		assertDecimal(t, 75000.0, summary.CostBreakdown.LaborCost)
+		// 100 EUR ÷ 0.00625 = 16,000 JPY
+		assertDecimal(t, 16000.0, summary.CostBreakdown.ExpenseCost)
+		assertDecimal(t, 91000.0, summary.CostBreakdown.TotalCost)
+		require.Len(t, summary.MemberCosts, 1)
+		assertDecimal(t, 7500.0, summary.MemberCosts[0].HourlyRate)
+	})
+	t.Run("正常: 経費一覧の合計は予算通貨に換算する", func(t *testing.T) {
+		result, err := service.NewExpenseService(db, service.DefaultDailyHoursLimit()).ListExpenses(repository.ExpenseListParams{ProjectID: project.ID})
+		require.NoError(t, err)
+		assert.Equal(t, "JPY", result.Summary.Currency)
+		assertDecimal(t, 16000.0, result.Summary.TotalAmount)
+	})
+
+	t.Run("正常: 工数一覧の原価はプロジェクト指定時は換算し、未指定時は通貨ごとに集計する", func(t *testing.T) {
+		// 2時間 × 5,000 JPY = 10,000 JPY
+		jpyMember := createTestMember(t, db)
+		_, err := budgetSvc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: jpyMember.ID, WorkDate: "2024-01-16", Hours: decimal.NewFromInt(2),
+		})
+		require.NoError(t, err)
+
+		// 一覧は本日時点のレートで換算する: 500 USD × 200 + 10,000 JPY
+		result, err := budgetSvc.ListTimeEntries(repository.TimeEntryListParams{ProjectID: &project.ID})
+		require.NoError(t, err)
+		assert.Equal(t, "JPY", result.Summary.Currency)
+		require.NotNil(t, result.Summary.TotalCost)
+		assertDecimal(t, 110000.0, *result.Summary.TotalCost)
+		assert.Empty(t, result.Summary.Costs)
+
+		result, err = budgetSvc.ListTimeEntries(repository.TimeEntryListParams{})
+		require.NoError(t, err)
+		assert.Nil(t, result.Summary.TotalCost)
+		assertDecimal(t, 12.0, result.Summary.TotalHours)
+		require.Len(t, result.Summary.Costs, 2)
+		assert.Equal(t, "JPY", result.Summary.Costs[0].Currency)
+		assertDecimal(t, 10000.0, result.Summary.Costs[0].Cost)
+		assert.Equal(t, "USD", result.Summary.Costs[1].Currency)
+		assertDecimal(t, 500.0, result.Summary.Costs[1].Cost)
+	})
+}
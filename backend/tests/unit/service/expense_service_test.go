@@ -0,0 +1,172 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// createTestExpense はテスト用経費を作成
+func createTestExpense(t *testing.T, db *gorm.DB, projectID uuid.UUID, category string, amount float64) *models.Expense {
+	expense := &models.Expense{
+		ID:           uuid.New(),
+		ProjectID:    projectID,
+		UserID:       uuid.New(),
+		Category:     category,
+		Amount:       decimal.NewFromFloat(amount),
+		IncurredDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, db.Create(expense).Error)
+	return expense
+}
+
+func TestExpenseService_CreateExpense(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *dto.CreateExpenseRequest
+		existing bool
+		wantErr  bool
+	}{
+		{
+			name: "正常: 経費を登録できる",
+			req: &dto.CreateExpenseRequest{
+				Category:     "license",
+				Amount:       decimal.NewFromInt(120000),
+				IncurredDate: "2024-01-15",
+			},
+			existing: true,
+			wantErr:  false,
+		},
+		{
+			name: "異常: 存在しないプロジェクトIDでエラー",
+			req: &dto.CreateExpenseRequest{
+				Category:     "travel",
+				Amount:       decimal.NewFromInt(30000),
+				IncurredDate: "2024-01-15",
+			},
+			existing: false,
+			wantErr:  true,
+		},
+		{
+			name: "異常: 無効な日付形式でエラー",
+			req: &dto.CreateExpenseRequest{
+				Category:     "hardware",
+				Amount:       decimal.NewFromInt(50000),
+				IncurredDate: "invalid-date",
+			},
+			existing: true,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupBudgetTestDB(t)
+			projectID := uuid.New()
+			if tt.existing {
+				projectID = createTestProject(t, db).ID
+			}
+
+			svc := service.NewExpenseService(db, service.DefaultDailyHoursLimit())
+			result, err := svc.CreateExpense(projectID, uuid.New(), tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.req.Amount, result.Amount)
+				assert.Equal(t, tt.req.Category, result.Category)
+				assert.Equal(t, tt.req.IncurredDate, result.IncurredDate)
+			}
+		})
+	}
+}
+
+func TestExpenseService_ProjectScope(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	expense := createTestExpense(t, db, project.ID, "travel", 30000)
+
+	svc := service.NewExpenseService(db, service.DefaultDailyHoursLimit())
+
+	t.Run("異常: 別プロジェクトの経費は取得できない", func(t *testing.T) {
+		result, err := svc.GetExpense(uuid.New(), expense.ID)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("正常: 経費を更新できる", func(t *testing.T) {
+		amount := decimal.NewFromInt(45000)
+		result, err := svc.UpdateExpense(project.ID, expense.ID, &dto.UpdateExpenseRequest{Amount: &amount})
+		require.NoError(t, err)
+		assert.True(t, amount.Equal(result.Amount))
+	})
+
+	t.Run("正常: 経費一覧を取得できる", func(t *testing.T) {
+		result, err := svc.ListExpenses(repository.ExpenseListParams{ProjectID: project.ID})
+		require.NoError(t, err)
+		assert.Len(t, result.Expenses, 1)
+		assert.Equal(t, int64(1), result.Pagination.Total)
+	})
+
+	t.Run("正常: 経費を削除できる", func(t *testing.T) {
+		require.NoError(t, svc.DeleteExpense(project.ID, expense.ID))
+		_, err := svc.GetExpense(project.ID, expense.ID)
+		assert.Error(t, err)
+	})
+}
+
+func TestBudgetService_GetBudgetSummary_IncludesExpenses(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	member := createTestMember(t, db)
+	task := createTestTask(t, db, project.ID)
+
+	rate := decimal.NewFromInt(5000)
+	entry := &models.TimeEntry{
+		ID:                 uuid.New(),
+		TaskID:             task.ID,
+		MemberID:           member.ID,
+		UserID:             uuid.New(),
+		WorkDate:           time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		Hours:              decimal.NewFromInt(8),
+		HourlyRateSnapshot: &rate,
+	}
+	require.NoError(t, db.Create(entry).Error)
+
+	createTestExpense(t, db, project.ID, "subcontractor", 300000)
+	createTestExpense(t, db, project.ID, "license", 60000)
+	createTestExpense(t, db, project.ID, "license", 40000)
+
+	svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	summary, err := svc.GetBudgetSummary(project.ID, repository.DateRange{})
+	require.NoError(t, err)
+
+	// 人件費 40,000 + 経費 400,000
+	assertDecimal(t, 440000.0, summary.Budget.TotalCost)
+	assertDecimal(t, 40000.0, summary.CostBreakdown.LaborCost)
+	assertDecimal(t, 400000.0, summary.CostBreakdown.ExpenseCost)
+	assertDecimal(t, 440000.0, summary.CostBreakdown.TotalCost)
+	require.Len(t, summary.CostBreakdown.ExpenseCosts, 2)
+
+	byCategory := map[string]dto.ExpenseCostResponse{}
+	for _, ec := range summary.CostBreakdown.ExpenseCosts {
+		byCategory[ec.Category] = ec
+	}
+	assertDecimal(t, 100000.0, byCategory["license"].Amount)
+	assert.Equal(t, 2, byCategory["license"].Count)
+	assert.InDelta(t, 75.0, byCategory["subcontractor"].Percentage, 0.01)
+	assert.True(t, summary.Budget.IsDeficit)
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+func TestHolidayService(t *testing.T) {
+	t.Run("正常: 休日を登録し期間で絞り込んで取得・削除できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewHolidayService(db)
+
+		created, err := svc.CreateHoliday(&dto.CreateHolidayRequest{Date: "2024-01-08", Name: "成人の日"})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-08", created.Date)
+		_, err = svc.CreateHoliday(&dto.CreateHolidayRequest{Date: "2024-02-12", Name: "振替休日"})
+		require.NoError(t, err)
+
+		from, _ := time.Parse("2006-01-02", "2024-01-01")
+		to, _ := time.Parse("2006-01-02", "2024-01-31")
+		list, err := svc.ListHolidays(repository.DateRange{From: &from, To: &to})
+		require.NoError(t, err)
+		require.Len(t, list.Holidays, 1)
+		assert.Equal(t, "成人の日", list.Holidays[0].Name)
+
+		require.NoError(t, svc.DeleteHoliday(created.ID))
+		list, err = svc.ListHolidays(repository.DateRange{})
+		require.NoError(t, err)
+		assert.Len(t, list.Holidays, 1)
+	})
+
+	t.Run("異常: 同じ日付の休日は重複して登録できない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewHolidayService(db)
+
+		req := &dto.CreateHolidayRequest{Date: "2024-01-08", Name: "成人の日"}
+		_, err := svc.CreateHoliday(req)
+		require.NoError(t, err)
+		_, err = svc.CreateHoliday(req)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常: 不正な日付はエラー", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewHolidayService(db)
+
+		_, err := svc.CreateHoliday(&dto.CreateHolidayRequest{Date: "2024/01/08", Name: "成人の日"})
+		assert.Error(t, err)
+	})
+
+	t.Run("異常: 存在しない休日は削除できない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		svc := service.NewHolidayService(db)
+
+		assert.Error(t, svc.DeleteHoliday(uuid.New()))
+	})
+}
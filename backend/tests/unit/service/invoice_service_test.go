@@ -0,0 +1,311 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// createInvoiceTestEntry は指定した単価のテスト用工数を作成
+func createInvoiceTestEntry(t *testing.T, db *gorm.DB, task *models.Task, member *models.Member, workDate string, hours, rate int64) *models.TimeEntry {
+	date, err := time.Parse("2006-01-02", workDate)
+	require.NoError(t, err)
+	hourlyRate := decimal.NewFromInt(rate)
+	entry := &models.TimeEntry{
+		ID:                 uuid.New(),
+		TaskID:             task.ID,
+		MemberID:           member.ID,
+		UserID:             uuid.New(),
+		WorkDate:           date,
+		Hours:              decimal.NewFromInt(hours),
+		HourlyRateSnapshot: &hourlyRate,
+		BillRateSnapshot:   &hourlyRate,
+		IsBillable:         true,
+		Currency:           "JPY",
+	}
+	require.NoError(t, db.Create(entry).Error)
+	return entry
+}
+
+// setupInvoiceTestData は2名のメンバーが2つのタスクに記録した工数を作成
+//
+//	メンバーA: 設計 8h + 実装 4h（5,000円/h）
+//	メンバーB: 実装 6h（8,000円/h）
+func setupInvoiceTestData(t *testing.T, db *gorm.DB) (*models.Project, []*models.TimeEntry) {
+	project := createTestProject(t, db)
+	design := &models.Task{ID: uuid.New(), ProjectID: project.ID, Name: "設計", Status: "in_progress"}
+	build := &models.Task{ID: uuid.New(), ProjectID: project.ID, Name: "実装", Status: "in_progress"}
+	require.NoError(t, db.Create(design).Error)
+	require.NoError(t, db.Create(build).Error)
+
+	memberA := &models.Member{ID: uuid.New(), Name: "メンバーA", Email: "a@example.com", HourlyRate: decimal.NewFromInt(5000)}
+	memberB := &models.Member{ID: uuid.New(), Name: "メンバーB", Email: "b@example.com", HourlyRate: decimal.NewFromInt(8000)}
+	require.NoError(t, db.Create(memberA).Error)
+	require.NoError(t, db.Create(memberB).Error)
+
+	entries := []*models.TimeEntry{
+		createInvoiceTestEntry(t, db, design, memberA, "2024-01-10", 8, 5000),
+		createInvoiceTestEntry(t, db, build, memberA, "2024-01-20", 4, 5000),
+		createInvoiceTestEntry(t, db, build, memberB, "2024-01-25", 6, 8000),
+	}
+	return project, entries
+}
+
+func TestInvoiceService_CreateInvoice(t *testing.T) {
+	t.Run("正常: メンバー単位で明細を作成できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, entries := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		invoice, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		require.NoError(t, err)
+
+		assert.Equal(t, models.InvoiceStatusDraft, invoice.Status)
+		assert.Nil(t, invoice.InvoiceNumber)
+		assert.Equal(t, models.InvoiceGroupByMember, invoice.GroupBy)
+		require.Len(t, invoice.Lines, 2)
+		assert.Equal(t, "メンバーA", invoice.Lines[0].Description)
+		assertDecimal(t, 12.0, invoice.Lines[0].Hours)
+		assertDecimal(t, 5000.0, invoice.Lines[0].UnitPrice)
+		assertDecimal(t, 60000.0, invoice.Lines[0].Amount)
+		assert.Equal(t, "メンバーB", invoice.Lines[1].Description)
+		assertDecimal(t, 48000.0, invoice.Lines[1].Amount)
+		assertDecimal(t, 18.0, invoice.TotalHours)
+		assertDecimal(t, 108000.0, invoice.TotalAmount)
+
+		// 工数は請求書に紐づく
+		var entry models.TimeEntry
+		require.NoError(t, db.First(&entry, "id = ?", entries[0].ID).Error)
+		require.NotNil(t, entry.InvoiceID)
+		assert.Equal(t, invoice.ID, *entry.InvoiceID)
+
+		// 請求済みの工数は別の請求書に含まれない
+		_, err = svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		assert.Error(t, err)
+	})
+
+	t.Run("正常: タスク単位で期間内の工数のみ集計する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, _ := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		invoice, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{
+			From:    "2024-01-15",
+			To:      "2024-01-31",
+			GroupBy: models.InvoiceGroupByTask,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, invoice.Lines, 1)
+		assert.Equal(t, "実装", invoice.Lines[0].Description)
+		require.NotNil(t, invoice.Lines[0].TaskID)
+		assertDecimal(t, 10.0, invoice.Lines[0].Hours)
+		// (4h × 5,000円 + 6h × 8,000円) ÷ 10h
+		assertDecimal(t, 6800.0, invoice.Lines[0].UnitPrice)
+		assertDecimal(t, 68000.0, invoice.TotalAmount)
+	})
+
+	t.Run("正常: 請求対象外の工数は含めず、請求単価で集計する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, entries := setupInvoiceTestData(t, db)
+
+		// メンバーBの工数を請求対象外にし、メンバーAの請求単価を原価より高くする
+		require.NoError(t, db.Model(entries[2]).Update("is_billable", false).Error)
+		require.NoError(t, db.Model(&models.TimeEntry{}).
+			Where("member_id = ?", entries[0].MemberID).
+			Update("bill_rate_snapshot", 7000).Error)
+
+		svc := service.NewInvoiceService(db)
+		invoice, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		require.NoError(t, err)
+
+		require.Len(t, invoice.Lines, 1)
+		assert.Equal(t, "メンバーA", invoice.Lines[0].Description)
+		assertDecimal(t, 7000.0, invoice.Lines[0].UnitPrice)
+		assertDecimal(t, 84000.0, invoice.TotalAmount)
+
+		var entry models.TimeEntry
+		require.NoError(t, db.First(&entry, "id = ?", entries[2].ID).Error)
+		assert.Nil(t, entry.InvoiceID)
+	})
+
+	t.Run("異常: 開始日が終了日より後", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, _ := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		_, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-02-01", To: "2024-01-01"})
+		assert.Error(t, err)
+	})
+
+	t.Run("異常: 期間内に未請求の工数がない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, _ := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		_, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-02-01", To: "2024-02-29"})
+		assert.Error(t, err)
+	})
+}
+
+func TestInvoiceService_Lifecycle(t *testing.T) {
+	t.Run("正常: 発行時に連番を採番し、工数をロックする", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, entries := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+
+		first, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-15"})
+		require.NoError(t, err)
+		second, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-16", To: "2024-01-31"})
+		require.NoError(t, err)
+
+		// 下書きの間は工数を変更でき、発行時に明細へ反映される
+		hours := decimal.NewFromInt(10)
+		_, err = budgetService.UpdateTimeEntry(entries[0].ID, &dto.UpdateTimeEntryRequest{Hours: &hours})
+		require.NoError(t, err)
+
+		issued, err := svc.IssueInvoice(project.ID, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.InvoiceStatusIssued, issued.Status)
+		require.NotNil(t, issued.InvoiceNumber)
+		assert.Equal(t, "INV-000001", *issued.InvoiceNumber)
+		assert.NotNil(t, issued.IssuedAt)
+		assertDecimal(t, 50000.0, issued.TotalAmount)
+
+		issuedSecond, err := svc.IssueInvoice(project.ID, second.ID)
+		require.NoError(t, err)
+		require.NotNil(t, issuedSecond.InvoiceNumber)
+		assert.Equal(t, "INV-000002", *issuedSecond.InvoiceNumber)
+
+		// 発行済みの請求書の工数は更新・削除できない
+		_, err = budgetService.UpdateTimeEntry(entries[0].ID, &dto.UpdateTimeEntryRequest{Hours: &hours})
+		require.Error(t, err)
+		appErr, ok := err.(*apperrors.AppError)
+		require.True(t, ok)
+		assert.Equal(t, "CONFLICT", appErr.Code)
+		assert.Error(t, budgetService.DeleteTimeEntry(entries[1].ID))
+
+		// 発行済みの請求書は再発行・削除できず、番号も変わらない
+		_, err = svc.IssueInvoice(project.ID, first.ID)
+		assertAppErrorCode(t, "CONFLICT", err)
+		assert.Error(t, svc.DeleteInvoice(project.ID, first.ID))
+		reissued, err := svc.GetInvoice(project.ID, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "INV-000001", *reissued.InvoiceNumber)
+	})
+
+	t.Run("正常: 連番のカウンターがなければ発行済みの番号から続けて採番する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, _ := setupInvoiceTestData(t, db)
+		require.NoError(t, db.Exec("DELETE FROM invoice_sequences").Error)
+
+		sequence, number := 41, "INV-000041"
+		require.NoError(t, db.Create(&models.Invoice{
+			ProjectID: project.ID, SequenceNumber: &sequence, InvoiceNumber: &number, Status: models.InvoiceStatusIssued,
+			PeriodStart: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), PeriodEnd: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		}).Error)
+
+		svc := service.NewInvoiceService(db)
+		invoice, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		require.NoError(t, err)
+		issued, err := svc.IssueInvoice(project.ID, invoice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "INV-000042", *issued.InvoiceNumber)
+	})
+
+	t.Run("正常: 発行済みの請求書を入金済みにできる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, _ := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		invoice, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		require.NoError(t, err)
+
+		// 下書きは入金済みにできない
+		_, err = svc.PayInvoice(project.ID, invoice.ID)
+		assert.Error(t, err)
+
+		_, err = svc.IssueInvoice(project.ID, invoice.ID)
+		require.NoError(t, err)
+
+		paid, err := svc.PayInvoice(project.ID, invoice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.InvoiceStatusPaid, paid.Status)
+		assert.NotNil(t, paid.PaidAt)
+	})
+
+	t.Run("正常: 下書きを削除すると工数を再請求できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, entries := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		invoice, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		require.NoError(t, err)
+
+		require.NoError(t, svc.DeleteInvoice(project.ID, invoice.ID))
+
+		var entry models.TimeEntry
+		require.NoError(t, db.First(&entry, "id = ?", entries[0].ID).Error)
+		assert.Nil(t, entry.InvoiceID)
+
+		_, err = svc.GetInvoice(project.ID, invoice.ID)
+		assert.Error(t, err)
+
+		_, err = svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常: 下書きの工数を請求対象外にすると請求書を再計算する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, entries := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		invoice, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		require.NoError(t, err)
+		assertDecimal(t, 18, invoice.TotalHours)
+
+		budgetSvc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+		notBillable := false
+		_, err = budgetSvc.UpdateTimeEntry(entries[2].ID, &dto.UpdateTimeEntryRequest{IsBillable: &notBillable})
+		require.NoError(t, err)
+
+		var updated models.Invoice
+		require.NoError(t, db.First(&updated, "id = ?", invoice.ID).Error)
+		assertDecimal(t, 12, updated.TotalHours)
+		assertDecimal(t, 60000, updated.TotalAmount)
+
+		// 下書きに含まれる工数を削除しても再計算する
+		require.NoError(t, budgetSvc.DeleteTimeEntry(entries[0].ID))
+		require.NoError(t, db.First(&updated, "id = ?", invoice.ID).Error)
+		assertDecimal(t, 4, updated.TotalHours)
+		assertDecimal(t, 20000, updated.TotalAmount)
+
+		var lineCount int64
+		require.NoError(t, db.Model(&models.InvoiceLine{}).Where("invoice_id = ?", invoice.ID).Count(&lineCount).Error)
+		assert.Equal(t, int64(1), lineCount)
+	})
+
+	t.Run("異常: 別プロジェクトの請求書は見つからない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project, _ := setupInvoiceTestData(t, db)
+
+		svc := service.NewInvoiceService(db)
+		invoice, err := svc.CreateInvoice(project.ID, &dto.CreateInvoiceRequest{From: "2024-01-01", To: "2024-01-31"})
+		require.NoError(t, err)
+
+		_, err = svc.GetInvoice(uuid.New(), invoice.ID)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+func TestMemberRateService_CreateMemberRate(t *testing.T) {
+	t.Run("正常: 適用期間が連続するように前後の単価を区切る", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		member := createTestMember(t, db)
+
+		svc := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+		for _, req := range []*dto.CreateMemberRateRequest{
+			{HourlyRate: decimal.NewFromInt(5000), EffectiveFrom: "2024-01-01"},
+			{HourlyRate: decimal.NewFromInt(6000), EffectiveFrom: "2024-04-01"},
+			// 遡って間に挟む
+			{HourlyRate: decimal.NewFromInt(5500), EffectiveFrom: "2024-02-01"},
+		} {
+			_, err := svc.CreateMemberRate(member.ID, req)
+			require.NoError(t, err)
+		}
+
+		list, err := svc.ListMemberRates(member.ID)
+		require.NoError(t, err)
+		require.Len(t, list.Rates, 3)
+
+		assert.Equal(t, "2024-01-01", list.Rates[0].EffectiveFrom)
+		require.NotNil(t, list.Rates[0].EffectiveTo)
+		assert.Equal(t, "2024-01-31", *list.Rates[0].EffectiveTo)
+
+		assert.Equal(t, "2024-02-01", list.Rates[1].EffectiveFrom)
+		assertDecimal(t, 5500.0, list.Rates[1].HourlyRate)
+		require.NotNil(t, list.Rates[1].EffectiveTo)
+		assert.Equal(t, "2024-03-31", *list.Rates[1].EffectiveTo)
+
+		assert.Equal(t, "2024-04-01", list.Rates[2].EffectiveFrom)
+		assert.Nil(t, list.Rates[2].EffectiveTo)
+
+		// メンバーの単価は現在有効な単価に揃う
+		var reloaded models.Member
+		require.NoError(t, db.First(&reloaded, "id = ?", member.ID).Error)
+		assertDecimal(t, 6000.0, reloaded.HourlyRate)
+	})
+
+	t.Run("正常: 同じ適用開始日の単価は上書きされる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		member := createTestMember(t, db)
+
+		svc := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+		_, err := svc.CreateMemberRate(member.ID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(5000), EffectiveFrom: "2024-01-01"})
+		require.NoError(t, err)
+		_, err = svc.CreateMemberRate(member.ID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(5200), EffectiveFrom: "2024-01-01"})
+		require.NoError(t, err)
+
+		list, err := svc.ListMemberRates(member.ID)
+		require.NoError(t, err)
+		require.Len(t, list.Rates, 1)
+		assertDecimal(t, 5200.0, list.Rates[0].HourlyRate)
+	})
+
+	t.Run("異常: 存在しないメンバー", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+
+		svc := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+		_, err := svc.CreateMemberRate(uuid.New(), &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(5000), EffectiveFrom: "2024-01-01"})
+		assert.Error(t, err)
+	})
+}
+
+func TestMemberService_UpdateMember_RecordsRateHistory(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	member := createTestMember(t, db)
+	// 単価履歴の導入前から登録されているメンバー
+	require.NoError(t, db.Model(member).Update("created_at", time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)).Error)
+
+	memberService := service.NewMemberService(db)
+	newRate := decimal.NewFromInt(6000)
+	updated, err := memberService.UpdateMember(member.ID, &dto.UpdateMemberRequest{HourlyRate: &newRate})
+	require.NoError(t, err)
+	assertDecimal(t, 6000.0, updated.HourlyRate)
+
+	list, err := service.NewMemberRateService(db, service.DefaultDailyHoursLimit()).ListMemberRates(member.ID)
+	require.NoError(t, err)
+	require.Len(t, list.Rates, 2)
+	assert.Equal(t, "2023-04-01", list.Rates[0].EffectiveFrom)
+	assertDecimal(t, 5000.0, list.Rates[0].HourlyRate)
+	require.NotNil(t, list.Rates[0].EffectiveTo)
+	assert.Nil(t, list.Rates[1].EffectiveTo)
+
+	// 過去の作業日の工数は変更前の単価で記録される
+	task := createTestTask(t, db, createTestProject(t, db).ID)
+	entry, err := service.NewBudgetService(db, service.DefaultDailyHoursLimit()).CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+		TaskID:   task.ID,
+		MemberID: member.ID,
+		WorkDate: "2024-01-15",
+		Hours:    decimal.NewFromInt(8),
+	})
+	require.NoError(t, err)
+	assertDecimal(t, 5000.0, *entry.HourlyRateSnapshot)
+}
+
+func TestBudgetService_CreateTimeEntry_UsesRateOnWorkDate(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+	member := createTestMember(t, db)
+
+	rateService := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+	_, err := rateService.CreateMemberRate(member.ID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(5000), EffectiveFrom: "2024-01-01"})
+	require.NoError(t, err)
+	_, err = rateService.CreateMemberRate(member.ID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(6000), EffectiveFrom: "2024-04-01", Currency: "USD"})
+	require.NoError(t, err)
+
+	svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	tests := []struct {
+		workDate string
+		rate     float64
+		currency string
+	}{
+		{"2023-12-01", 5000, "JPY"}, // 履歴より前は最初の単価
+		{"2024-03-31", 5000, "JPY"},
+		{"2024-04-01", 6000, "USD"},
+	}
+	for _, tt := range tests {
+		entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID:   task.ID,
+			MemberID: member.ID,
+			WorkDate: tt.workDate,
+			Hours:    decimal.NewFromInt(1),
+		})
+		require.NoError(t, err)
+		assertDecimal(t, tt.rate, *entry.HourlyRateSnapshot)
+		assert.Equal(t, tt.currency, entry.Currency)
+	}
+}
+
+func TestBudgetService_UpdateTimeEntry_ResolvesRateOnNewWorkDate(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+	member := createTestMember(t, db)
+
+	rateService := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+	_, err := rateService.CreateMemberRate(member.ID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(5000), EffectiveFrom: "2024-01-01"})
+	require.NoError(t, err)
+	_, err = rateService.CreateMemberRate(member.ID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(6000), EffectiveFrom: "2024-04-01"})
+	require.NoError(t, err)
+
+	svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	create := func(override *decimal.Decimal) *dto.TimeEntryResponse {
+		entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID:     task.ID,
+			MemberID:   member.ID,
+			WorkDate:   "2024-03-29",
+			Hours:      decimal.NewFromInt(2),
+			HourlyRate: override,
+		})
+		require.NoError(t, err)
+		return entry
+	}
+
+	t.Run("正常: 単価改定をまたいで移動すると移動先の単価で原価を計算する", func(t *testing.T) {
+		entry := create(nil)
+		assertDecimal(t, 5000, *entry.HourlyRateSnapshot)
+
+		workDate := "2024-04-01"
+		updated, err := svc.UpdateTimeEntry(entry.ID, &dto.UpdateTimeEntryRequest{WorkDate: &workDate})
+		require.NoError(t, err)
+		assertDecimal(t, 6000, *updated.HourlyRateSnapshot)
+		assertDecimal(t, 12000, updated.Cost)
+		assert.Equal(t, models.RateSourceMember, updated.RateSource)
+	})
+
+	t.Run("正常: 工数ごとに指定した単価は移動しても変えない", func(t *testing.T) {
+		override := decimal.NewFromInt(7000)
+		entry := create(&override)
+
+		workDate := "2024-04-02"
+		updated, err := svc.UpdateTimeEntry(entry.ID, &dto.UpdateTimeEntryRequest{WorkDate: &workDate})
+		require.NoError(t, err)
+		assertDecimal(t, 7000, *updated.HourlyRateSnapshot)
+		assert.Equal(t, models.RateSourceEntry, updated.RateSource)
+	})
+}
+
+func TestMemberRateService_RecomputeRateSnapshots(t *testing.T) {
+	// setup はメンバーAの単価を1月15日に遡って引き上げた状態を作成
+	//
+	//	メンバーA: 1/10 8h・1/20 4h（記録時 5,000円/h → 1/15以降 5,500円/h）
+	//	メンバーB: 1/25 6h（発行済みの請求書に含まれる、8,000円/h → 9,000円/h）
+	setup := func(t *testing.T) (*gorm.DB, *models.Project, []*models.TimeEntry) {
+		db := setupBudgetTestDB(t)
+		project, entries := setupInvoiceTestData(t, db)
+
+		invoice := &models.Invoice{
+			ProjectID:   project.ID,
+			Status:      models.InvoiceStatusIssued,
+			PeriodStart: time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC),
+			PeriodEnd:   time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC),
+			GroupBy:     models.InvoiceGroupByMember,
+			Currency:    "JPY",
+		}
+		require.NoError(t, db.Create(invoice).Error)
+		require.NoError(t, db.Model(entries[2]).Update("invoice_id", invoice.ID).Error)
+
+		svc := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+		for _, rate := range []struct {
+			memberID uuid.UUID
+			req      *dto.CreateMemberRateRequest
+		}{
+			{entries[0].MemberID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(5000), EffectiveFrom: "2024-01-01"}},
+			{entries[0].MemberID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(5500), EffectiveFrom: "2024-01-15"}},
+			{entries[2].MemberID, &dto.CreateMemberRateRequest{HourlyRate: decimal.NewFromInt(9000), EffectiveFrom: "2024-01-01"}},
+		} {
+			_, err := svc.CreateMemberRate(rate.memberID, rate.req)
+			require.NoError(t, err)
+		}
+
+		return db, project, entries
+	}
+
+	t.Run("正常: ドライランは差分のみ返し、工数を変更しない", func(t *testing.T) {
+		db, _, entries := setup(t)
+
+		svc := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.RecomputeRateSnapshots(&dto.RecomputeRatesRequest{From: "2024-01-01", To: "2024-01-31", DryRun: true})
+		require.NoError(t, err)
+
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 3, result.CheckedCount)
+		assert.Equal(t, 1, result.ChangedCount)
+		assert.Equal(t, 1, result.SkippedCount)
+		require.Len(t, result.Changes, 1)
+		change := result.Changes[0]
+		assert.Equal(t, entries[1].ID, change.TimeEntryID)
+		assert.Equal(t, "2024-01-20", change.WorkDate)
+		require.NotNil(t, change.OldRate)
+		assertDecimal(t, 5000.0, *change.OldRate)
+		assertDecimal(t, 5500.0, change.NewRate)
+		assertDecimal(t, 20000.0, change.OldCost)
+		assertDecimal(t, 22000.0, change.NewCost)
+
+		var entry models.TimeEntry
+		require.NoError(t, db.First(&entry, "id = ?", entries[1].ID).Error)
+		assertDecimal(t, 5000.0, *entry.HourlyRateSnapshot)
+	})
+
+	t.Run("正常: 単価を再計算し、予算に反映する", func(t *testing.T) {
+		db, project, entries := setup(t)
+
+		svc := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.RecomputeRateSnapshots(&dto.RecomputeRatesRequest{From: "2024-01-01", To: "2024-01-31"})
+		require.NoError(t, err)
+		assert.False(t, result.DryRun)
+		assert.Equal(t, 1, result.ChangedCount)
+
+		var entry models.TimeEntry
+		require.NoError(t, db.First(&entry, "id = ?", entries[1].ID).Error)
+		assertDecimal(t, 5500.0, *entry.HourlyRateSnapshot)
+
+		// 発行済みの請求書の工数は変更しない
+		var locked models.TimeEntry
+		require.NoError(t, db.First(&locked, "id = ?", entries[2].ID).Error)
+		assertDecimal(t, 8000.0, *locked.HourlyRateSnapshot)
+
+		// 8h × 5,000円 + 4h × 5,500円 + 6h × 8,000円
+		budget, err := service.NewBudgetService(db, service.DefaultDailyHoursLimit()).GetBudget(project.ID)
+		require.NoError(t, err)
+		assertDecimal(t, 110000.0, budget.TotalCost)
+	})
+
+	t.Run("正常: メンバーで絞り込める", func(t *testing.T) {
+		db, _, entries := setup(t)
+
+		svc := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.RecomputeRateSnapshots(&dto.RecomputeRatesRequest{
+			From:     "2024-01-01",
+			To:       "2024-01-31",
+			MemberID: &entries[2].MemberID,
+			DryRun:   true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.CheckedCount)
+		assert.Empty(t, result.Changes)
+		assert.Equal(t, 1, result.SkippedCount)
+	})
+
+	t.Run("異常: 開始日が終了日より後", func(t *testing.T) {
+		db, _, _ := setup(t)
+
+		svc := service.NewMemberRateService(db, service.DefaultDailyHoursLimit())
+		_, err := svc.RecomputeRateSnapshots(&dto.RecomputeRatesRequest{From: "2024-02-01", To: "2024-01-01"})
+		assert.Error(t, err)
+	})
+}
+
+func TestBudgetService_CreateTimeEntry_RateResolution(t *testing.T) {
+	type fixture struct {
+		override   *decimal.Decimal
+		assignment *decimal.Decimal
+		memberRate int64
+		department *string
+	}
+	entryRate := decimal.NewFromInt(9000)
+	projectRate := decimal.NewFromInt(7000)
+	engineering := "開発部"
+	sales := "営業部"
+
+	tests := []struct {
+		name       string
+		fixture    fixture
+		wantRate   float64
+		wantSource string
+	}{
+		{
+			name:       "正常: 工数個別の単価が最優先",
+			fixture:    fixture{override: &entryRate, assignment: &projectRate, memberRate: 5000},
+			wantRate:   9000,
+			wantSource: models.RateSourceEntry,
+		},
+		{
+			name:       "正常: プロジェクト別の単価がメンバーの単価より優先",
+			fixture:    fixture{assignment: &projectRate, memberRate: 5000},
+			wantRate:   7000,
+			wantSource: models.RateSourceProject,
+		},
+		{
+			name:       "正常: メンバーの単価",
+			fixture:    fixture{memberRate: 5000, department: &engineering},
+			wantRate:   5000,
+			wantSource: models.RateSourceMember,
+		},
+		{
+			name:       "正常: 単価のないメンバーは部署の既定単価",
+			fixture:    fixture{department: &engineering},
+			wantRate:   4000,
+			wantSource: models.RateSourceDepartment,
+		},
+		{
+			name:       "正常: 部署の既定単価もない場合はメンバーの単価（0円）",
+			fixture:    fixture{department: &sales},
+			wantRate:   0,
+			wantSource: models.RateSourceMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupBudgetTestDB(t)
+			project := createTestProject(t, db)
+			task := createTestTask(t, db, project.ID)
+			member := &models.Member{
+				ID:         uuid.New(),
+				Name:       "メンバー",
+				Email:      "member@example.com",
+				HourlyRate: decimal.NewFromInt(tt.fixture.memberRate),
+				Department: tt.fixture.department,
+			}
+			require.NoError(t, db.Create(member).Error)
+			require.NoError(t, db.Create(&models.DepartmentRate{
+				Department: engineering,
+				HourlyRate: decimal.NewFromInt(4000),
+				Currency:   "JPY",
+			}).Error)
+			if tt.fixture.assignment != nil {
+				require.NoError(t, db.Create(&models.ProjectMember{
+					ProjectID:          project.ID,
+					MemberID:           member.ID,
+					JoinedAt:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					HourlyRateSnapshot: tt.fixture.assignment,
+				}).Error)
+			}
+
+			svc := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+			entry, err := svc.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+				TaskID:     task.ID,
+				MemberID:   member.ID,
+				WorkDate:   "2024-01-15",
+				Hours:      decimal.NewFromInt(2),
+				HourlyRate: tt.fixture.override,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, entry.HourlyRateSnapshot)
+			assertDecimal(t, tt.wantRate, *entry.HourlyRateSnapshot)
+			assert.Equal(t, tt.wantSource, entry.RateSource)
+		})
+	}
+}
+
+func TestMemberRateService_RecomputeRateSnapshots_KeepsEntryOverrides(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+	member := createTestMember(t, db)
+
+	budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	override := decimal.NewFromInt(9000)
+	overridden, err := budgetService.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+		TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-10", Hours: decimal.NewFromInt(1), HourlyRate: &override,
+	})
+	require.NoError(t, err)
+	regular, err := budgetService.CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+		TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-11", Hours: decimal.NewFromInt(1),
+	})
+	require.NoError(t, err)
+
+	// 後からプロジェクト別の単価を設定
+	projectRate := decimal.NewFromInt(7000)
+	require.NoError(t, db.Create(&models.ProjectMember{
+		ProjectID:          project.ID,
+		MemberID:           member.ID,
+		JoinedAt:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		HourlyRateSnapshot: &projectRate,
+	}).Error)
+
+	result, err := service.NewMemberRateService(db, service.DefaultDailyHoursLimit()).RecomputeRateSnapshots(&dto.RecomputeRatesRequest{From: "2024-01-01", To: "2024-01-31"})
+	require.NoError(t, err)
+	require.Len(t, result.Changes, 1)
+	assert.Equal(t, regular.ID, result.Changes[0].TimeEntryID)
+	assert.Equal(t, models.RateSourceMember, result.Changes[0].OldSource)
+	assert.Equal(t, models.RateSourceProject, result.Changes[0].NewSource)
+
+	entry, err := budgetService.GetTimeEntry(overridden.ID)
+	require.NoError(t, err)
+	assertDecimal(t, 9000.0, *entry.HourlyRateSnapshot)
+	entry, err = budgetService.GetTimeEntry(regular.ID)
+	require.NoError(t, err)
+	assertDecimal(t, 7000.0, *entry.HourlyRateSnapshot)
+	assert.Equal(t, models.RateSourceProject, entry.RateSource)
+}
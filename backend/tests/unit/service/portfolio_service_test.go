@@ -0,0 +1,187 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// createPortfolioProject はテスト用に予算の数値を持つプロジェクトを作成
+func createPortfolioProject(t *testing.T, db *gorm.DB, ownerID uuid.UUID, name, status, currency string, budgetAmount *decimal.Decimal, revenue, cost int64) *models.Project {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	project := &models.Project{
+		ID:           uuid.New(),
+		UserID:       ownerID,
+		Name:         name,
+		Status:       status,
+		BudgetAmount: budgetAmount,
+		StartDate:    &start,
+		EndDate:      &end,
+	}
+	require.NoError(t, db.Create(project).Error)
+
+	budget := &models.Budget{
+		ProjectID: project.ID,
+		Revenue:   decimal.NewFromInt(revenue),
+		TotalCost: decimal.NewFromInt(cost),
+		Currency:  currency,
+	}
+	budget.CalculateProfit()
+	require.NoError(t, db.Create(budget).Error)
+	return project
+}
+
+func TestPortfolioService_GetPortfolioSummary(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	svc := service.NewPortfolioService(db)
+	ownerID := uuid.New()
+	budgetAmount := decimal.NewFromInt(1000000)
+
+	healthy := createPortfolioProject(t, db, ownerID, "A: 順調", "in_progress", "JPY", &budgetAmount, 2000000, 500000)
+	deficit := createPortfolioProject(t, db, ownerID, "B: 赤字", "in_progress", "JPY", nil, 1000000, 1200000)
+	nearBudget := createPortfolioProject(t, db, ownerID, "C: 予算逼迫", "on_hold", "JPY", &budgetAmount, 2000000, 850000)
+	alerted := createPortfolioProject(t, db, ownerID, "D: アラートあり", "completed", "USD", nil, 10000, 4000)
+	// 他のオーナーのプロジェクトは含まれない
+	createPortfolioProject(t, db, uuid.New(), "E: 他オーナー", "in_progress", "JPY", nil, 100, 1000)
+
+	require.NoError(t, db.Create(&models.Alert{
+		ProjectID: alerted.ID, Metric: models.AlertMetricProfitRate, Threshold: decimal.NewFromInt(70),
+		Value: decimal.NewFromInt(60), Currency: "USD", Message: "利益率が閾値を下回りました",
+		Status: models.AlertStatusAcknowledged, TriggeredAt: time.Now(),
+	}).Error)
+	// 解決済みのアラートはリスクに含めない
+	require.NoError(t, db.Create(&models.Alert{
+		ProjectID: healthy.ID, Metric: models.AlertMetricCostRatio, Threshold: decimal.NewFromInt(50),
+		Value: decimal.NewFromInt(55), Currency: "JPY", Message: "原価率が閾値を超えました",
+		Status: models.AlertStatusResolved, TriggeredAt: time.Now(),
+	}).Error)
+
+	t.Run("正常: 通貨別合計・赤字・リスク・ステータス別件数を返す", func(t *testing.T) {
+		summary, err := svc.GetPortfolioSummary(repository.PortfolioParams{OwnerID: ownerID}, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, summary.ProjectCount)
+		assert.Equal(t, map[string]int{"in_progress": 2, "on_hold": 1, "completed": 1}, summary.StatusCounts)
+
+		require.Len(t, summary.Totals, 2)
+		jpy := summary.Totals[0]
+		assert.Equal(t, "JPY", jpy.Currency)
+		assert.Equal(t, 3, jpy.ProjectCount)
+		assertDecimal(t, 5000000.0, jpy.Revenue)
+		assertDecimal(t, 2550000.0, jpy.TotalCost)
+		assertDecimal(t, 2450000.0, jpy.Profit)
+		assertDecimal(t, 49.0, jpy.ProfitRate)
+		assert.Equal(t, "USD", summary.Totals[1].Currency)
+		assertDecimal(t, 6000.0, summary.Totals[1].Profit)
+
+		require.Len(t, summary.DeficitProjects, 1)
+		assert.Equal(t, deficit.ID, summary.DeficitProjects[0].ID)
+		assertDecimal(t, -200000.0, summary.DeficitProjects[0].Profit)
+
+		require.Len(t, summary.AtRiskProjects, 2)
+		assert.Equal(t, nearBudget.ID, summary.AtRiskProjects[0].ID)
+		assert.Equal(t, []string{service.PortfolioRiskCostRatio}, summary.AtRiskProjects[0].RiskReasons)
+		require.NotNil(t, summary.AtRiskProjects[0].CostRatio)
+		assertDecimal(t, 85.0, *summary.AtRiskProjects[0].CostRatio)
+		assert.Equal(t, alerted.ID, summary.AtRiskProjects[1].ID)
+		assert.Equal(t, []string{service.PortfolioRiskActiveAlerts}, summary.AtRiskProjects[1].RiskReasons)
+		assert.Equal(t, 1, summary.AtRiskProjects[1].ActiveAlerts)
+	})
+
+	t.Run("正常: ステータスで絞り込める", func(t *testing.T) {
+		summary, err := svc.GetPortfolioSummary(repository.PortfolioParams{
+			OwnerID:  ownerID,
+			Statuses: []string{"on_hold", "completed"},
+		}, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, summary.ProjectCount)
+		assert.Empty(t, summary.DeficitProjects)
+		assert.Len(t, summary.AtRiskProjects, 2)
+	})
+
+	t.Run("正常: 期間と重なるプロジェクトに絞り込める", func(t *testing.T) {
+		from := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+		summary, err := svc.GetPortfolioSummary(repository.PortfolioParams{
+			OwnerID: ownerID,
+			Period:  repository.DateRange{From: &from},
+		}, "")
+		require.NoError(t, err)
+		assert.Equal(t, 0, summary.ProjectCount)
+		assert.Empty(t, summary.Totals)
+
+		to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+		summary, err = svc.GetPortfolioSummary(repository.PortfolioParams{
+			OwnerID: ownerID,
+			Period:  repository.DateRange{To: &to},
+		}, "")
+		require.NoError(t, err)
+		assert.Equal(t, 4, summary.ProjectCount)
+	})
+}
+
+func TestPortfolioService_GetPortfolioSummary_ReportingCurrency(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	svc := service.NewPortfolioService(db)
+	ownerID := uuid.New()
+
+	jpyDeficit := createPortfolioProject(t, db, ownerID, "円建て赤字", "in_progress", "JPY", nil, 1000000, 1200000)
+	usdDeficit := createPortfolioProject(t, db, ownerID, "ドル建て赤字", "in_progress", "USD", nil, 10000, 12000)
+	createPortfolioProject(t, db, ownerID, "ドル建て黒字", "in_progress", "USD", nil, 20000, 10000)
+
+	t.Run("正常: 報告通貨がなければ赤字は通貨ごとに並べる", func(t *testing.T) {
+		summary, err := svc.GetPortfolioSummary(repository.PortfolioParams{OwnerID: ownerID}, "")
+		require.NoError(t, err)
+		assert.Nil(t, summary.ReportingTotal)
+
+		require.Len(t, summary.DeficitProjects, 2)
+		assert.Equal(t, jpyDeficit.ID, summary.DeficitProjects[0].ID)
+		assert.Nil(t, summary.DeficitProjects[0].ReportingProfit)
+	})
+
+	t.Run("異常: 報告通貨への為替レートがない", func(t *testing.T) {
+		_, err := svc.GetPortfolioSummary(repository.PortfolioParams{OwnerID: ownerID}, "JPY")
+		assertAppErrorCode(t, "EXCHANGE_RATE_NOT_FOUND", err)
+	})
+
+	t.Run("正常: 報告通貨に換算して合計し、換算後の赤字額で並べる", func(t *testing.T) {
+		_, err := service.NewExchangeRateService(db).CreateExchangeRate(&dto.CreateExchangeRateRequest{
+			RateDate: "2024-01-01", FromCurrency: "USD", ToCurrency: "JPY", Rate: decimal.NewFromInt(150),
+		})
+		require.NoError(t, err)
+
+		summary, err := svc.GetPortfolioSummary(repository.PortfolioParams{OwnerID: ownerID}, "JPY")
+		require.NoError(t, err)
+
+		// 通貨別の合計はそのまま返す
+		assert.Len(t, summary.Totals, 2)
+
+		require.NotNil(t, summary.ReportingTotal)
+		assert.Equal(t, "JPY", summary.ReportingTotal.Currency)
+		assert.Equal(t, 3, summary.ReportingTotal.ProjectCount)
+		// 1,000,000 + (10,000 + 20,000) × 150
+		assertDecimal(t, 5500000.0, summary.ReportingTotal.Revenue)
+		// 1,200,000 + (12,000 + 10,000) × 150
+		assertDecimal(t, 4500000.0, summary.ReportingTotal.TotalCost)
+		assertDecimal(t, 1000000.0, summary.ReportingTotal.Profit)
+
+		// ドル建ての赤字 -2,000 USD は -300,000 円で、円建ての -200,000 円より大きい
+		require.Len(t, summary.DeficitProjects, 2)
+		assert.Equal(t, usdDeficit.ID, summary.DeficitProjects[0].ID)
+		require.NotNil(t, summary.DeficitProjects[0].ReportingProfit)
+		assertDecimal(t, -300000.0, *summary.DeficitProjects[0].ReportingProfit)
+		assertDecimal(t, -2000.0, summary.DeficitProjects[0].Profit)
+		assert.Equal(t, jpyDeficit.ID, summary.DeficitProjects[1].ID)
+	})
+}
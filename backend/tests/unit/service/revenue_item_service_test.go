@@ -0,0 +1,193 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+func TestRevenueItemService_CreateRevenueItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *dto.CreateRevenueItemRequest
+		existing bool
+		wantErr  bool
+	}{
+		{
+			name: "正常: 予定ステータスで作成される",
+			req: &dto.CreateRevenueItemRequest{
+				Name:        "キックオフ",
+				PlannedDate: "2024-01-31",
+				Amount:      decimal.NewFromInt(300000),
+			},
+			existing: true,
+		},
+		{
+			name: "異常: 金額が0",
+			req: &dto.CreateRevenueItemRequest{
+				Name:        "キックオフ",
+				PlannedDate: "2024-01-31",
+				Amount:      decimal.Zero,
+			},
+			existing: true,
+			wantErr:  true,
+		},
+		{
+			name: "異常: 不正な日付形式",
+			req: &dto.CreateRevenueItemRequest{
+				Name:        "キックオフ",
+				PlannedDate: "2024/01/31",
+				Amount:      decimal.NewFromInt(300000),
+			},
+			existing: true,
+			wantErr:  true,
+		},
+		{
+			name: "異常: 存在しないプロジェクト",
+			req: &dto.CreateRevenueItemRequest{
+				Name:        "キックオフ",
+				PlannedDate: "2024-01-31",
+				Amount:      decimal.NewFromInt(300000),
+			},
+			existing: false,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupBudgetTestDB(t)
+			projectID := uuid.New()
+			if tt.existing {
+				projectID = createTestProject(t, db).ID
+			}
+
+			svc := service.NewRevenueItemService(db, service.DefaultDailyHoursLimit())
+			result, err := svc.CreateRevenueItem(projectID, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.req.Name, result.Name)
+			assert.Equal(t, tt.req.PlannedDate, result.PlannedDate)
+			assert.Equal(t, models.RevenueStatusPlanned, result.Status)
+			assert.Equal(t, "JPY", result.Currency)
+		})
+	}
+}
+
+func TestRevenueItemService_TaskLink(t *testing.T) {
+	t.Run("正常: 同じプロジェクトのタスクに紐づけられる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+
+		svc := service.NewRevenueItemService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.CreateRevenueItem(project.ID, &dto.CreateRevenueItemRequest{
+			Name:        "検収",
+			PlannedDate: "2024-03-31",
+			Amount:      decimal.NewFromInt(700000),
+			TaskID:      &task.ID,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.TaskID)
+		assert.Equal(t, task.ID, *result.TaskID)
+		require.NotNil(t, result.TaskName)
+		assert.Equal(t, task.Name, *result.TaskName)
+
+		// nil UUID で紐づけを解除できる
+		unlink := uuid.Nil
+		updated, err := svc.UpdateRevenueItem(project.ID, result.ID, &dto.UpdateRevenueItemRequest{TaskID: &unlink})
+		require.NoError(t, err)
+		assert.Nil(t, updated.TaskID)
+		assert.Nil(t, updated.TaskName)
+	})
+
+	t.Run("異常: 別プロジェクトのタスクには紐づけられない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		otherTask := createTestTask(t, db, createTestProject(t, db).ID)
+
+		svc := service.NewRevenueItemService(db, service.DefaultDailyHoursLimit())
+		result, err := svc.CreateRevenueItem(project.ID, &dto.CreateRevenueItemRequest{
+			Name:        "検収",
+			PlannedDate: "2024-03-31",
+			Amount:      decimal.NewFromInt(700000),
+			TaskID:      &otherTask.ID,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestRevenueItemService_DerivesBudgetRevenue(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	createTestExpense(t, db, project.ID, "license", 400000)
+
+	svc := service.NewRevenueItemService(db, service.DefaultDailyHoursLimit())
+	budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+
+	// 着手時 30% / 検収時 70%
+	kickoff, err := svc.CreateRevenueItem(project.ID, &dto.CreateRevenueItemRequest{
+		Name:        "着手金",
+		PlannedDate: "2024-01-31",
+		Amount:      decimal.NewFromInt(300000),
+	})
+	require.NoError(t, err)
+	_, err = svc.CreateRevenueItem(project.ID, &dto.CreateRevenueItemRequest{
+		Name:        "検収",
+		PlannedDate: "2024-03-31",
+		Amount:      decimal.NewFromInt(700000),
+	})
+	require.NoError(t, err)
+
+	budget, err := budgetService.GetBudget(project.ID)
+	require.NoError(t, err)
+	assertDecimal(t, 1000000.0, budget.Revenue)
+	assertDecimal(t, 0.0, budget.RecognizedRevenue)
+	assertDecimal(t, 1000000.0, budget.PlannedRevenue)
+	assertDecimal(t, 600000.0, budget.Profit)
+	assertDecimal(t, 60.0, budget.ProfitRate)
+
+	// 着手金を請求済みにすると計上済み売上に移る
+	invoiced := models.RevenueStatusInvoiced
+	_, err = svc.UpdateRevenueItem(project.ID, kickoff.ID, &dto.UpdateRevenueItemRequest{Status: &invoiced})
+	require.NoError(t, err)
+
+	budget, err = budgetService.GetBudget(project.ID)
+	require.NoError(t, err)
+	assertDecimal(t, 1000000.0, budget.Revenue)
+	assertDecimal(t, 300000.0, budget.RecognizedRevenue)
+	assertDecimal(t, 700000.0, budget.PlannedRevenue)
+
+	list, err := svc.ListRevenueItems(project.ID, models.RevenueStatusInvoiced)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, kickoff.ID, list.Items[0].ID)
+	assertDecimal(t, 1000000.0, list.TotalRevenue)
+	assertDecimal(t, 300000.0, list.RecognizedRevenue)
+
+	// 売上明細がある間は売上金額を直接更新できない
+	_, err = budgetService.UpdateRevenue(project.ID, &dto.UpdateRevenueRequest{Revenue: decimal.NewFromInt(500000)})
+	assert.Error(t, err)
+
+	// 明細の削除は予算に反映される
+	require.NoError(t, svc.DeleteRevenueItem(project.ID, kickoff.ID))
+	budget, err = budgetService.GetBudget(project.ID)
+	require.NoError(t, err)
+	assertDecimal(t, 700000.0, budget.Revenue)
+	assertDecimal(t, 0.0, budget.RecognizedRevenue)
+	assertDecimal(t, 300000.0, budget.Profit)
+}
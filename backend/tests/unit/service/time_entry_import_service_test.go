@@ -0,0 +1,211 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// countTimeEntries は登録済みの工数件数を返す
+func countTimeEntries(t *testing.T, db *gorm.DB) int64 {
+	var count int64
+	require.NoError(t, db.Model(&models.TimeEntry{}).Count(&count).Error)
+	return count
+}
+
+// findImportError は指定した行・列のエラーを探す
+func findImportError(report *dto.TimeEntryImportResponse, row int, column string) *dto.TimeEntryImportRowError {
+	for i := range report.Errors {
+		if report.Errors[i].Row == row && report.Errors[i].Column == column {
+			return &report.Errors[i]
+		}
+	}
+	return nil
+}
+
+func TestTimeEntryImportService_ImportTimeEntries(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	design := createTestTask(t, db, project.ID)
+	build := &models.Task{ID: uuid.New(), ProjectID: project.ID, Name: "実装", Status: "in_progress"}
+	require.NoError(t, db.Create(build).Error)
+	createTestMember(t, db)
+	svc := service.NewTimeEntryImportService(db, service.DefaultDailyHoursLimit())
+	userID := uuid.New()
+
+	csv := "task,member_email,date,hours,comment\n" +
+		"テストタスク,member@example.com,2024-03-04,3.5,要件整理\n" +
+		"テストタスク,MEMBER@example.com,2024-03-05,2,\n" +
+		build.ID.String() + ",member@example.com,2024-03-05,6,\"画面実装, API連携\"\n"
+
+	t.Run("正常: ドライランでは検証のみで登録しない", func(t *testing.T) {
+		report, err := svc.ImportTimeEntries(userID, strings.NewReader(csv), true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 3, report.TotalRows)
+		assert.Equal(t, 3, report.ValidRows)
+		assert.Equal(t, 0, report.ImportedRows)
+		assertDecimal(t, 11.5, report.TotalHours)
+		assert.Empty(t, report.Errors)
+		assert.Equal(t, int64(0), countTimeEntries(t, db))
+	})
+
+	t.Run("正常: すべての行を登録しタスクの実績工数を更新する", func(t *testing.T) {
+		report, err := svc.ImportTimeEntries(userID, strings.NewReader(csv), false)
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.ImportedRows)
+		assert.Equal(t, int64(3), countTimeEntries(t, db))
+
+		var updatedDesign, updatedBuild models.Task
+		require.NoError(t, db.First(&updatedDesign, "id = ?", design.ID).Error)
+		require.NoError(t, db.First(&updatedBuild, "id = ?", build.ID).Error)
+		assertDecimal(t, 5.5, updatedDesign.ActualHours)
+		assertDecimal(t, 6, updatedBuild.ActualHours)
+
+		var entry models.TimeEntry
+		require.NoError(t, db.First(&entry, "task_id = ?", build.ID).Error)
+		require.NotNil(t, entry.Comment)
+		assert.Equal(t, "画面実装, API連携", *entry.Comment)
+		assert.Equal(t, userID, entry.UserID)
+		assertDecimal(t, 5000, *entry.HourlyRateSnapshot)
+	})
+}
+
+func TestTimeEntryImportService_RowErrors(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	createTestTask(t, db, project.ID)
+	createTestMember(t, db)
+	svc := service.NewTimeEntryImportService(db, service.DefaultDailyHoursLimit())
+
+	_, err := service.NewAccountingPeriodService(db).CloseAccountingPeriod("2024-01", uuid.New())
+	require.NoError(t, err)
+
+	csv := "task,member_email,date,hours,comment\n" +
+		"テストタスク,member@example.com,2024-03-04,3,\n" + // 2: 正常
+		"存在しないタスク,member@example.com,2024-03-04,3,\n" + // 3
+		"テストタスク,nobody@example.com,2024-03-04,3,\n" + // 4
+		"テストタスク,member@example.com,2024/03/04,3,\n" + // 5
+		"テストタスク,member@example.com,2024-03-04,25,\n" + // 6
+		"テストタスク,member@example.com,2024-03-04,abc,\n" + // 7
+		"テストタスク,member@example.com,2024-03-04,,\n" + // 8
+		"テストタスク,member@example.com,2024-01-10,3,\n" // 9: 締め済み
+
+	t.Run("正常: ドライランで行ごとのエラーを返す", func(t *testing.T) {
+		report, err := svc.ImportTimeEntries(uuid.New(), strings.NewReader(csv), true)
+		require.NoError(t, err)
+		assert.Equal(t, 8, report.TotalRows)
+		assert.Equal(t, 1, report.ValidRows)
+		assert.Len(t, report.Errors, 7)
+
+		assert.NotNil(t, findImportError(report, 3, "task"))
+		assert.NotNil(t, findImportError(report, 4, "member_email"))
+		assert.NotNil(t, findImportError(report, 5, "date"))
+		if rowError := findImportError(report, 6, "hours"); assert.NotNil(t, rowError) {
+			assert.Equal(t, "must be at most 24", rowError.Message)
+		}
+		if rowError := findImportError(report, 7, "hours"); assert.NotNil(t, rowError) {
+			assert.Equal(t, "must be a number", rowError.Message)
+		}
+		if rowError := findImportError(report, 8, "hours"); assert.NotNil(t, rowError) {
+			assert.Equal(t, "is required", rowError.Message)
+		}
+		if rowError := findImportError(report, 9, "date"); assert.NotNil(t, rowError) {
+			assert.Contains(t, rowError.Message, "2024-01")
+		}
+	})
+
+	t.Run("異常: エラーのある行があれば1件も登録しない", func(t *testing.T) {
+		report, err := svc.ImportTimeEntries(uuid.New(), strings.NewReader(csv), false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, report.ImportedRows)
+		assert.Len(t, report.Errors, 7)
+		assert.Equal(t, int64(0), countTimeEntries(t, db))
+	})
+}
+
+func TestTimeEntryImportService_ResolveTasks(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	first := createTestProject(t, db)
+	second := &models.Project{ID: uuid.New(), UserID: uuid.New(), Name: "別プロジェクト", Status: "in_progress"}
+	require.NoError(t, db.Create(second).Error)
+	createTestTask(t, db, first.ID)
+	secondTask := createTestTask(t, db, second.ID)
+	createTestMember(t, db)
+	svc := service.NewTimeEntryImportService(db, service.DefaultDailyHoursLimit())
+
+	t.Run("異常: 複数のプロジェクトにある同名タスクは特定できない", func(t *testing.T) {
+		csv := "task,member_email,date,hours\nテストタスク,member@example.com,2024-03-04,1\n"
+		report, err := svc.ImportTimeEntries(uuid.New(), strings.NewReader(csv), true)
+		require.NoError(t, err)
+		require.Len(t, report.Errors, 1)
+		assert.Equal(t, "task", report.Errors[0].Column)
+		assert.Contains(t, report.Errors[0].Message, "more than one project")
+	})
+
+	t.Run("正常: プロジェクト列で同名タスクを区別できる", func(t *testing.T) {
+		csv := "project,task,member_email,date,hours\n別プロジェクト,テストタスク,member@example.com,2024-03-04,1\n"
+		report, err := svc.ImportTimeEntries(uuid.New(), strings.NewReader(csv), false)
+		require.NoError(t, err)
+		assert.Empty(t, report.Errors)
+		assert.Equal(t, 1, report.ImportedRows)
+
+		var entry models.TimeEntry
+		require.NoError(t, db.First(&entry).Error)
+		assert.Equal(t, secondTask.ID, entry.TaskID)
+	})
+}
+
+func TestTimeEntryImportService_InvalidFile(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	svc := service.NewTimeEntryImportService(db, service.DefaultDailyHoursLimit())
+
+	tests := []struct {
+		name string
+		csv  string
+	}{
+		{name: "異常: 空のファイル", csv: ""},
+		{name: "異常: 必須列がない", csv: "task,member_email,hours\nテストタスク,member@example.com,1\n"},
+		{name: "異常: データ行がない", csv: "task,member_email,date,hours\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ImportTimeEntries(uuid.New(), strings.NewReader(tt.csv), true)
+			assertAppErrorCode(t, "VALIDATION_FAILED", err)
+		})
+	}
+}
+
+func TestTimeEntryImportService_DailyHoursLimit(t *testing.T) {
+	limit, err := service.ParseDailyHoursLimit("10", service.OverAllocationWarn)
+	require.NoError(t, err)
+
+	db := setupBudgetTestDB(t)
+	createTestTask(t, db, createTestProject(t, db).ID)
+	createTestMember(t, db)
+	svc := service.NewTimeEntryImportService(db, limit)
+
+	// 同じファイルの先の行も1日の工数に数える
+	csv := "task,member_email,date,hours\n" +
+		"テストタスク,member@example.com,2024-03-04,6\n" +
+		"テストタスク,member@example.com,2024-03-04,3\n" +
+		"テストタスク,member@example.com,2024-03-04,2\n" +
+		"テストタスク,member@example.com,2024-03-05,2\n"
+
+	report, err := svc.ImportTimeEntries(uuid.New(), strings.NewReader(csv), true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.ValidRows)
+	require.Len(t, report.Errors, 1)
+	assert.Equal(t, 4, report.Errors[0].Row)
+	assert.Equal(t, "hours", report.Errors[0].Column)
+	assert.Contains(t, report.Errors[0].Message, "max_hours")
+}
@@ -0,0 +1,351 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// timerTestSettings はテスト用のタイマー設定（UTCで日付を区切る）
+func timerTestSettings(roundingMinutes int, roundingMode string, maxDuration time.Duration) service.TimerSettings {
+	return service.TimerSettings{
+		RoundingMinutes: roundingMinutes,
+		RoundingMode:    roundingMode,
+		MaxDuration:     maxDuration,
+		Location:        time.UTC,
+	}
+}
+
+// createRunningTimer は指定時刻に開始した計測中のタイマーを作成
+func createRunningTimer(t *testing.T, db *gorm.DB, taskID, memberID uuid.UUID, startedAt time.Time) *models.Timer {
+	timer := &models.Timer{
+		TaskID:    taskID,
+		MemberID:  memberID,
+		UserID:    uuid.New(),
+		StartedAt: startedAt,
+	}
+	require.NoError(t, db.Omit("Task", "Member").Create(timer).Error)
+	return timer
+}
+
+// listMemberTimeEntries はメンバーの工数を稼働日順に取得
+func listMemberTimeEntries(t *testing.T, db *gorm.DB, memberID uuid.UUID) []models.TimeEntry {
+	var entries []models.TimeEntry
+	require.NoError(t, db.Where("member_id = ?", memberID).Order("work_date ASC").Find(&entries).Error)
+	return entries
+}
+
+func TestTimerService_StartAndStop(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+	member := createTestMember(t, db)
+	svc := service.NewTimerService(db, timerTestSettings(15, service.TimerRoundingNearest, 12*time.Hour), service.DefaultDailyHoursLimit())
+	userID := uuid.New()
+
+	t.Run("正常: タイマーを開始できる", func(t *testing.T) {
+		timer, err := svc.StartTimer(userID, &dto.StartTimerRequest{TaskID: task.ID, MemberID: member.ID})
+		require.NoError(t, err)
+		assert.Nil(t, timer.StoppedAt)
+		assert.Equal(t, "テストタスク", timer.TaskName)
+
+		running, err := svc.ListRunningTimers(&member.ID)
+		require.NoError(t, err)
+		require.Len(t, running.Timers, 1)
+		assert.Equal(t, timer.ID, running.Timers[0].ID)
+	})
+
+	t.Run("異常: 計測中のタイマーがあるメンバーは開始できない", func(t *testing.T) {
+		_, err := svc.StartTimer(userID, &dto.StartTimerRequest{TaskID: task.ID, MemberID: member.ID})
+		require.Error(t, err)
+		appErr, ok := err.(*apperrors.AppError)
+		require.True(t, ok)
+		assert.Equal(t, "CONFLICT", appErr.Code)
+	})
+
+	t.Run("正常: 停止するとタイマーが終了する", func(t *testing.T) {
+		comment := "設計レビュー"
+		timer, err := svc.StopTimer(&dto.StopTimerRequest{MemberID: member.ID, Comment: &comment})
+		require.NoError(t, err)
+		require.NotNil(t, timer.StoppedAt)
+		assert.False(t, timer.AutoStopped)
+		assert.Equal(t, comment, *timer.Comment)
+		// 数秒の計測は15分単位に丸めると0時間のため工数は登録されない
+		assert.Empty(t, timer.TimeEntries)
+
+		running, err := svc.ListRunningTimers(&member.ID)
+		require.NoError(t, err)
+		assert.Empty(t, running.Timers)
+	})
+
+	t.Run("異常: 計測中のタイマーがなければ停止できない", func(t *testing.T) {
+		_, err := svc.StopTimer(&dto.StopTimerRequest{MemberID: member.ID})
+		require.Error(t, err)
+		appErr, ok := err.(*apperrors.AppError)
+		require.True(t, ok)
+		assert.Equal(t, "NOT_FOUND", appErr.Code)
+	})
+
+	t.Run("異常: 存在しないタスクでは開始できない", func(t *testing.T) {
+		_, err := svc.StartTimer(userID, &dto.StartTimerRequest{TaskID: uuid.New(), MemberID: member.ID})
+		require.Error(t, err)
+		appErr, ok := err.(*apperrors.AppError)
+		require.True(t, ok)
+		assert.Equal(t, "NOT_FOUND", appErr.Code)
+	})
+
+	t.Run("正常: 稼働日が締め済み期間でもタイマーは停止し登録できなかった工数を返す", func(t *testing.T) {
+		// 上限の12時間で停止し、稼働日はすべて2024-01-15になる
+		timer := createRunningTimer(t, db, task.ID, member.ID, time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC))
+		_, err := service.NewAccountingPeriodService(db).CloseAccountingPeriod("2024-01", uuid.New())
+		require.NoError(t, err)
+
+		stopped, err := svc.StopTimer(&dto.StopTimerRequest{MemberID: member.ID})
+		require.NoError(t, err)
+		assert.Equal(t, timer.ID, stopped.ID)
+		require.NotNil(t, stopped.StoppedAt)
+		assert.True(t, stopped.AutoStopped)
+		assert.Empty(t, stopped.TimeEntries)
+		require.Len(t, stopped.SkippedEntries, 1)
+		assert.Equal(t, "2024-01-15", stopped.SkippedEntries[0].WorkDate)
+		assertDecimal(t, 12, stopped.SkippedEntries[0].Hours)
+		assert.Equal(t, "PERIOD_CLOSED", stopped.SkippedEntries[0].Code)
+
+		// 停止済みのため新しいタイマーを開始できる
+		_, err = svc.StartTimer(userID, &dto.StartTimerRequest{TaskID: task.ID, MemberID: member.ID})
+		require.NoError(t, err)
+	})
+}
+
+func TestTimerService_Rounding(t *testing.T) {
+	startedAt := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		mode     string
+		minutes  int
+		expected float64
+	}{
+		{name: "正常: 最も近い15分単位に丸める", mode: service.TimerRoundingNearest, minutes: 15, expected: 0.75},
+		{name: "正常: 15分単位に切り上げる", mode: service.TimerRoundingUp, minutes: 15, expected: 1},
+		{name: "正常: 30分単位に切り捨てる", mode: service.TimerRoundingDown, minutes: 30, expected: 0.5},
+		{name: "正常: 丸めなし", mode: service.TimerRoundingNearest, minutes: 0, expected: 0.87},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupBudgetTestDB(t)
+			project := createTestProject(t, db)
+			task := createTestTask(t, db, project.ID)
+			member := createTestMember(t, db)
+			// 52分で上限に達して停止する
+			svc := service.NewTimerService(db, timerTestSettings(tt.minutes, tt.mode, 52*time.Minute), service.DefaultDailyHoursLimit())
+			createRunningTimer(t, db, task.ID, member.ID, startedAt)
+
+			stopped, err := svc.AutoStopExpiredTimers(startedAt.Add(time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, 1, stopped)
+
+			entries := listMemberTimeEntries(t, db, member.ID)
+			require.Len(t, entries, 1)
+			assertDecimal(t, tt.expected, entries[0].Hours)
+		})
+	}
+}
+
+func TestTimerService_AutoStopExpiredTimers(t *testing.T) {
+	t.Run("正常: 上限を超えたタイマーを停止し稼働日ごとに工数を分割する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+		member := createTestMember(t, db)
+		svc := service.NewTimerService(db, timerTestSettings(15, service.TimerRoundingNearest, 12*time.Hour), service.DefaultDailyHoursLimit())
+		timer := createRunningTimer(t, db, task.ID, member.ID, time.Date(2024, 1, 15, 20, 0, 0, 0, time.UTC))
+
+		stopped, err := svc.AutoStopExpiredTimers(time.Date(2024, 1, 16, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, 1, stopped)
+
+		entries := listMemberTimeEntries(t, db, member.ID)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "2024-01-15", entries[0].WorkDate.Format("2006-01-02"))
+		assertDecimal(t, 4, entries[0].Hours)
+		assert.Equal(t, "2024-01-16", entries[1].WorkDate.Format("2006-01-02"))
+		assertDecimal(t, 8, entries[1].Hours)
+		assert.Equal(t, timer.UserID, entries[0].UserID)
+
+		var saved models.Timer
+		require.NoError(t, db.First(&saved, "id = ?", timer.ID).Error)
+		require.NotNil(t, saved.StoppedAt)
+		assert.True(t, saved.AutoStopped)
+		assert.True(t, saved.StoppedAt.Equal(time.Date(2024, 1, 16, 8, 0, 0, 0, time.UTC)))
+
+		// タスクの実績工数にも反映される
+		var updatedTask models.Task
+		require.NoError(t, db.First(&updatedTask, "id = ?", task.ID).Error)
+		assertDecimal(t, 12, updatedTask.ActualHours)
+	})
+
+	t.Run("正常: 上限に達していないタイマーは停止しない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+		member := createTestMember(t, db)
+		svc := service.NewTimerService(db, timerTestSettings(15, service.TimerRoundingNearest, 12*time.Hour), service.DefaultDailyHoursLimit())
+		createRunningTimer(t, db, task.ID, member.ID, time.Date(2024, 1, 15, 20, 0, 0, 0, time.UTC))
+
+		stopped, err := svc.AutoStopExpiredTimers(time.Date(2024, 1, 16, 7, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, 0, stopped)
+		assert.Empty(t, listMemberTimeEntries(t, db, member.ID))
+	})
+
+	t.Run("正常: 稼働日はタイムゾーンで区切る", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+		member := createTestMember(t, db)
+		settings := timerTestSettings(15, service.TimerRoundingNearest, 2*time.Hour)
+		location, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		settings.Location = location
+		svc := service.NewTimerService(db, settings, service.DefaultDailyHoursLimit())
+		// 日本時間 2024-01-15 23:00 開始
+		createRunningTimer(t, db, task.ID, member.ID, time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC))
+
+		_, err = svc.AutoStopExpiredTimers(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		entries := listMemberTimeEntries(t, db, member.ID)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "2024-01-15", entries[0].WorkDate.Format("2006-01-02"))
+		assertDecimal(t, 1, entries[0].Hours)
+		assert.Equal(t, "Asia/Tokyo", *entries[0].Timezone)
+		assert.Equal(t, "23:00", *entries[0].LocalStartTime)
+		assert.Equal(t, "00:00", *entries[0].LocalEndTime)
+		assert.Equal(t, "2024-01-16", entries[1].WorkDate.Format("2006-01-02"))
+		assertDecimal(t, 1, entries[1].Hours)
+	})
+
+	t.Run("正常: 締め済み期間の稼働日は工数を登録せずにタイマーを停止する", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+		member := createTestMember(t, db)
+		svc := service.NewTimerService(db, timerTestSettings(15, service.TimerRoundingNearest, 12*time.Hour), service.DefaultDailyHoursLimit())
+		// 1月31日から2月1日にかけて計測
+		timer := createRunningTimer(t, db, task.ID, member.ID, time.Date(2024, 1, 31, 20, 0, 0, 0, time.UTC))
+
+		_, err := service.NewAccountingPeriodService(db).CloseAccountingPeriod("2024-02", uuid.New())
+		require.NoError(t, err)
+
+		stopped, err := svc.AutoStopExpiredTimers(time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Equal(t, 1, stopped)
+
+		entries := listMemberTimeEntries(t, db, member.ID)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "2024-01-31", entries[0].WorkDate.Format("2006-01-02"))
+		assertDecimal(t, 4, entries[0].Hours)
+
+		var saved models.Timer
+		require.NoError(t, db.First(&saved, "id = ?", timer.ID).Error)
+		assert.NotNil(t, saved.StoppedAt)
+	})
+
+	t.Run("正常: 上限を超えたタイマーがあっても新しいタイマーを開始できる", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		project := createTestProject(t, db)
+		task := createTestTask(t, db, project.ID)
+		member := createTestMember(t, db)
+		svc := service.NewTimerService(db, timerTestSettings(15, service.TimerRoundingNearest, 12*time.Hour), service.DefaultDailyHoursLimit())
+		expired := createRunningTimer(t, db, task.ID, member.ID, time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC))
+
+		timer, err := svc.StartTimer(uuid.New(), &dto.StartTimerRequest{TaskID: task.ID, MemberID: member.ID})
+		require.NoError(t, err)
+		assert.NotEqual(t, expired.ID, timer.ID)
+
+		var saved models.Timer
+		require.NoError(t, db.First(&saved, "id = ?", expired.ID).Error)
+		assert.True(t, saved.AutoStopped)
+
+		entries := listMemberTimeEntries(t, db, member.ID)
+		require.Len(t, entries, 1)
+		assertDecimal(t, 12, entries[0].Hours)
+	})
+}
+
+func TestTimerService_TimeRange(t *testing.T) {
+	t.Run("正常: タイマーの工数は計測した時間帯を持ち丸めた工数で登録される", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		task := createTestTask(t, db, createTestProject(t, db).ID)
+		member := createTestMember(t, db)
+		// 2時間50分で上限に達して停止し、15分単位に切り上げて3時間
+		svc := service.NewTimerService(db, timerTestSettings(15, service.TimerRoundingUp, 170*time.Minute), service.DefaultDailyHoursLimit())
+		createRunningTimer(t, db, task.ID, member.ID, time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC))
+
+		_, err := svc.AutoStopExpiredTimers(time.Date(2024, 1, 15, 13, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		entries := listMemberTimeEntries(t, db, member.ID)
+		require.Len(t, entries, 1)
+		assertDecimal(t, 3, entries[0].Hours)
+		require.NotNil(t, entries[0].StartedAt)
+		require.NotNil(t, entries[0].EndedAt)
+		assert.True(t, entries[0].StartedAt.Equal(time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)))
+		assert.True(t, entries[0].EndedAt.Equal(time.Date(2024, 1, 15, 11, 50, 0, 0, time.UTC)))
+	})
+
+	t.Run("異常: タイマーの工数と時間帯が重なる工数は登録できない", func(t *testing.T) {
+		db := setupBudgetTestDB(t)
+		task := createTestTask(t, db, createTestProject(t, db).ID)
+		member := createTestMember(t, db)
+		svc := service.NewTimerService(db, timerTestSettings(15, service.TimerRoundingNearest, 3*time.Hour), service.DefaultDailyHoursLimit())
+		createRunningTimer(t, db, task.ID, member.ID, time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC))
+
+		// 09:00から上限の12:00まで計測
+		_, err := svc.AutoStopExpiredTimers(time.Date(2024, 1, 15, 13, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, listMemberTimeEntries(t, db, member.ID), 1)
+
+		startedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+		endedAt := time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)
+		timezone := "UTC"
+		_, err = service.NewBudgetService(db, service.DefaultDailyHoursLimit()).CreateTimeEntry(uuid.New(), &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID,
+			StartedAt: &startedAt, EndedAt: &endedAt, Timezone: &timezone,
+		})
+		assertAppErrorCode(t, "TIME_ENTRY_OVERLAP", err)
+	})
+}
+
+func TestParseTimerSettings(t *testing.T) {
+	t.Run("正常: 設定値を読み込める", func(t *testing.T) {
+		settings, err := service.ParseTimerSettings("6", "up", "10h", "UTC")
+		require.NoError(t, err)
+		assert.Equal(t, 6, settings.RoundingMinutes)
+		assert.Equal(t, service.TimerRoundingUp, settings.RoundingMode)
+		assert.Equal(t, 10*time.Hour, settings.MaxDuration)
+		assert.Equal(t, time.UTC, settings.Location)
+	})
+
+	t.Run("異常: 不正な設定値はエラー", func(t *testing.T) {
+		_, err := service.ParseTimerSettings("-1", "up", "10h", "UTC")
+		assert.Error(t, err)
+		_, err = service.ParseTimerSettings("15", "ceil", "10h", "UTC")
+		assert.Error(t, err)
+		_, err = service.ParseTimerSettings("15", "up", "0s", "UTC")
+		assert.Error(t, err)
+		_, err = service.ParseTimerSettings("15", "up", "10h", "Nowhere/City")
+		assert.Error(t, err)
+	})
+}
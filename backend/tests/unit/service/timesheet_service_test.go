@@ -0,0 +1,256 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/your-org/project-budget-tracker/backend/internal/dto"
+	apperrors "github.com/your-org/project-budget-tracker/backend/internal/errors"
+	"github.com/your-org/project-budget-tracker/backend/internal/models"
+	"github.com/your-org/project-budget-tracker/backend/internal/repository"
+	"github.com/your-org/project-budget-tracker/backend/internal/service"
+)
+
+// assertAppErrorCode は指定したエラーコードのAppErrorであることを確認する
+func assertAppErrorCode(t *testing.T, code string, err error) {
+	t.Helper()
+	require.Error(t, err)
+	appErr, ok := err.(*apperrors.AppError)
+	require.True(t, ok)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestTimesheetService_Workflow(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+	member := createTestMember(t, db)
+	budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	svc := service.NewTimesheetService(db, service.DefaultDailyHoursLimit())
+	userID := uuid.New()
+	adminID := uuid.New()
+
+	// 2024-W03 は 2024-01-15（月）から 2024-01-21（日）
+	monday, err := budgetService.CreateTimeEntry(userID, &dto.CreateTimeEntryRequest{
+		TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-15", Hours: decimal.NewFromInt(8),
+	})
+	require.NoError(t, err)
+	_, err = budgetService.CreateTimeEntry(userID, &dto.CreateTimeEntryRequest{
+		TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-21", Hours: decimal.NewFromFloat(1.5),
+	})
+	require.NoError(t, err)
+	// 翌週の工数は含まれない
+	_, err = budgetService.CreateTimeEntry(userID, &dto.CreateTimeEntryRequest{
+		TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-22", Hours: decimal.NewFromInt(4),
+	})
+	require.NoError(t, err)
+
+	t.Run("正常: 未提出の週は下書きとして取得できる", func(t *testing.T) {
+		timesheet, err := svc.GetTimesheet(member.ID, "2024-W03")
+		require.NoError(t, err)
+		assert.Nil(t, timesheet.ID)
+		assert.Equal(t, models.TimesheetStatusDraft, timesheet.Status)
+		assert.Equal(t, "2024-01-15", timesheet.WeekStart)
+		assert.Equal(t, "2024-01-21", timesheet.WeekEnd)
+		require.NotNil(t, timesheet.TotalHours)
+		assertDecimal(t, 9.5, *timesheet.TotalHours)
+		assert.Len(t, timesheet.TimeEntries, 2)
+	})
+
+	t.Run("正常: 提出すると工数が変更できなくなる", func(t *testing.T) {
+		timesheet, err := svc.SubmitTimesheet(member.ID, "2024-W03", userID)
+		require.NoError(t, err)
+		require.NotNil(t, timesheet.ID)
+		assert.Equal(t, models.TimesheetStatusSubmitted, timesheet.Status)
+		assert.Equal(t, userID, *timesheet.SubmittedBy)
+
+		_, err = budgetService.CreateTimeEntry(userID, &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-17", Hours: decimal.NewFromInt(1),
+		})
+		assertAppErrorCode(t, "TIMESHEET_LOCKED", err)
+
+		hours := decimal.NewFromInt(6)
+		_, err = budgetService.UpdateTimeEntry(monday.ID, &dto.UpdateTimeEntryRequest{Hours: &hours})
+		assertAppErrorCode(t, "TIMESHEET_LOCKED", err)
+
+		assertAppErrorCode(t, "TIMESHEET_LOCKED", budgetService.DeleteTimeEntry(monday.ID))
+
+		// 提出済みの週への移動もできない
+		other, err := budgetService.CreateTimeEntry(userID, &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: "2024-01-23", Hours: decimal.NewFromInt(1),
+		})
+		require.NoError(t, err)
+		workDate := "2024-01-16"
+		_, err = budgetService.UpdateTimeEntry(other.ID, &dto.UpdateTimeEntryRequest{WorkDate: &workDate})
+		assertAppErrorCode(t, "TIMESHEET_LOCKED", err)
+	})
+
+	t.Run("正常: 他のメンバーの工数は登録できる", func(t *testing.T) {
+		otherMember := &models.Member{ID: uuid.New(), Name: "別メンバー", Email: "other@example.com", HourlyRate: decimal.NewFromInt(4000)}
+		require.NoError(t, db.Create(otherMember).Error)
+
+		_, err := budgetService.CreateTimeEntry(userID, &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: otherMember.ID, WorkDate: "2024-01-17", Hours: decimal.NewFromInt(2),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("異常: 提出済みの工数表は再提出できない", func(t *testing.T) {
+		_, err := svc.SubmitTimesheet(member.ID, "2024-W03", userID)
+		assertAppErrorCode(t, "CONFLICT", err)
+	})
+
+	t.Run("異常: 差戻しにはコメントが必要", func(t *testing.T) {
+		_, err := svc.RejectTimesheet(member.ID, "2024-W03", adminID, &dto.ReviewTimesheetRequest{})
+		assertAppErrorCode(t, "VALIDATION_FAILED", err)
+	})
+
+	t.Run("正常: 差し戻すと修正して再提出できる", func(t *testing.T) {
+		comment := "日曜日の作業内容を記入してください"
+		timesheet, err := svc.RejectTimesheet(member.ID, "2024-W03", adminID, &dto.ReviewTimesheetRequest{Comment: &comment})
+		require.NoError(t, err)
+		assert.Equal(t, models.TimesheetStatusRejected, timesheet.Status)
+		assert.Equal(t, comment, *timesheet.ReviewComment)
+		assert.Equal(t, adminID, *timesheet.ReviewedBy)
+
+		hours := decimal.NewFromInt(7)
+		_, err = budgetService.UpdateTimeEntry(monday.ID, &dto.UpdateTimeEntryRequest{Hours: &hours})
+		require.NoError(t, err)
+
+		timesheet, err = svc.SubmitTimesheet(member.ID, "2024-W03", userID)
+		require.NoError(t, err)
+		assert.Equal(t, models.TimesheetStatusSubmitted, timesheet.Status)
+		assert.Nil(t, timesheet.ReviewComment)
+		assert.Nil(t, timesheet.ReviewedBy)
+	})
+
+	t.Run("正常: 承認後も工数は変更できない", func(t *testing.T) {
+		comment := "確認しました"
+		timesheet, err := svc.ApproveTimesheet(member.ID, "2024-W03", adminID, &dto.ReviewTimesheetRequest{Comment: &comment})
+		require.NoError(t, err)
+		assert.Equal(t, models.TimesheetStatusApproved, timesheet.Status)
+		assert.Equal(t, comment, *timesheet.ReviewComment)
+
+		assertAppErrorCode(t, "TIMESHEET_LOCKED", budgetService.DeleteTimeEntry(monday.ID))
+
+		_, err = svc.ApproveTimesheet(member.ID, "2024-W03", adminID, nil)
+		assertAppErrorCode(t, "CONFLICT", err)
+	})
+
+	t.Run("異常: 未提出の工数表は承認できない", func(t *testing.T) {
+		_, err := svc.ApproveTimesheet(member.ID, "2024-W04", adminID, nil)
+		assertAppErrorCode(t, "CONFLICT", err)
+	})
+
+	t.Run("正常: 工数表をステータスで絞り込める", func(t *testing.T) {
+		list, err := svc.ListTimesheets(&member.ID, models.TimesheetStatusApproved, "")
+		require.NoError(t, err)
+		require.Len(t, list.Timesheets, 1)
+		assert.Equal(t, "2024-W03", list.Timesheets[0].Week)
+		assert.Equal(t, "テストメンバー", list.Timesheets[0].MemberName)
+
+		list, err = svc.ListTimesheets(nil, models.TimesheetStatusSubmitted, "")
+		require.NoError(t, err)
+		assert.Empty(t, list.Timesheets)
+	})
+}
+
+func TestTimesheetService_ISOWeek(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	member := createTestMember(t, db)
+	svc := service.NewTimesheetService(db, service.DefaultDailyHoursLimit())
+
+	t.Run("正常: 53週目のある年の最終週", func(t *testing.T) {
+		timesheet, err := svc.GetTimesheet(member.ID, "2020-W53")
+		require.NoError(t, err)
+		assert.Equal(t, "2020-12-28", timesheet.WeekStart)
+		assert.Equal(t, "2021-01-03", timesheet.WeekEnd)
+	})
+
+	t.Run("正常: 年をまたぐ第1週", func(t *testing.T) {
+		timesheet, err := svc.GetTimesheet(member.ID, "2025-W01")
+		require.NoError(t, err)
+		assert.Equal(t, "2024-12-30", timesheet.WeekStart)
+	})
+
+	for _, week := range []string{"2024-W53", "2024-W00", "2024-W3", "2024-01", "W03"} {
+		t.Run("異常: 不正な週 "+week, func(t *testing.T) {
+			_, err := svc.GetTimesheet(member.ID, week)
+			assertAppErrorCode(t, "VALIDATION_FAILED", err)
+		})
+	}
+
+	t.Run("異常: 存在しないメンバー", func(t *testing.T) {
+		_, err := svc.GetTimesheet(uuid.New(), "2024-W03")
+		assertAppErrorCode(t, "NOT_FOUND", err)
+	})
+}
+
+func TestBudgetService_GetBudgetSummary_HoursBasis(t *testing.T) {
+	db := setupBudgetTestDB(t)
+	project := createTestProject(t, db)
+	task := createTestTask(t, db, project.ID)
+	member := createTestMember(t, db)
+	budgetService := service.NewBudgetService(db, service.DefaultDailyHoursLimit())
+	timesheetService := service.NewTimesheetService(db, service.DefaultDailyHoursLimit())
+	userID := uuid.New()
+
+	// W03 は承認済み、W04 は提出のみ
+	for _, entry := range []struct {
+		workDate string
+		hours    int64
+	}{{"2024-01-16", 8}, {"2024-01-23", 4}} {
+		_, err := budgetService.CreateTimeEntry(userID, &dto.CreateTimeEntryRequest{
+			TaskID: task.ID, MemberID: member.ID, WorkDate: entry.workDate, Hours: decimal.NewFromInt(entry.hours),
+		})
+		require.NoError(t, err)
+	}
+	_, err := timesheetService.SubmitTimesheet(member.ID, "2024-W03", userID)
+	require.NoError(t, err)
+	_, err = timesheetService.ApproveTimesheet(member.ID, "2024-W03", uuid.New(), nil)
+	require.NoError(t, err)
+	_, err = timesheetService.SubmitTimesheet(member.ID, "2024-W04", userID)
+	require.NoError(t, err)
+
+	t.Run("正常: 既定ではすべての工数を集計する", func(t *testing.T) {
+		summary, err := budgetService.GetBudgetSummary(project.ID, repository.DateRange{})
+		require.NoError(t, err)
+		assert.Equal(t, models.BudgetHoursBasisAll, summary.Budget.HoursBasis)
+		assertDecimal(t, 12, summary.CostBreakdown.TotalHours)
+		assertDecimal(t, 60000, summary.CostBreakdown.LaborCost)
+		assertDecimal(t, 60000, summary.Budget.TotalCost)
+	})
+
+	t.Run("正常: 承認済みの工数のみを集計できる", func(t *testing.T) {
+		budget, err := budgetService.UpdateHoursBasis(project.ID, &dto.UpdateHoursBasisRequest{HoursBasis: models.BudgetHoursBasisApproved})
+		require.NoError(t, err)
+		assert.Equal(t, models.BudgetHoursBasisApproved, budget.HoursBasis)
+		// 未承認の工数は予算の原価と利益にも含めない
+		assertDecimal(t, 40000, budget.TotalCost)
+		assertDecimal(t, -40000, budget.Profit)
+
+		summary, err := budgetService.GetBudgetSummary(project.ID, repository.DateRange{})
+		require.NoError(t, err)
+		assert.Equal(t, models.BudgetHoursBasisApproved, summary.Budget.HoursBasis)
+		assertDecimal(t, 8, summary.CostBreakdown.TotalHours)
+		assertDecimal(t, 40000, summary.CostBreakdown.LaborCost)
+		require.Len(t, summary.MemberCosts, 1)
+		assertDecimal(t, 8, summary.MemberCosts[0].Hours)
+		require.Len(t, summary.TaskCosts, 1)
+		assertDecimal(t, 8, summary.TaskCosts[0].Hours)
+	})
+
+	t.Run("正常: 承認されると集計に含まれる", func(t *testing.T) {
+		_, err := timesheetService.ApproveTimesheet(member.ID, "2024-W04", uuid.New(), nil)
+		require.NoError(t, err)
+
+		summary, err := budgetService.GetBudgetSummary(project.ID, repository.DateRange{})
+		require.NoError(t, err)
+		assertDecimal(t, 12, summary.CostBreakdown.TotalHours)
+		assertDecimal(t, 60000, summary.Budget.TotalCost)
+	})
+}